@@ -5,10 +5,14 @@ import (
 
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 )
 
 func AddToScheme(scheme *machineryruntime.Scheme) error {
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		return fmt.Errorf("failed to add scheme on v1beta1 api: %w", err)
+	}
 	if err := v1beta2.AddToScheme(scheme); err != nil {
 		return fmt.Errorf("failed to add scheme on v1beta2 api: %w", err)
 	}
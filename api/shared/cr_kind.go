@@ -8,6 +8,7 @@ const (
 	WatcherKind           Kind = "Watcher"
 	ManifestKind          Kind = "Manifest"
 	ModuleReleaseMetaKind Kind = "ModuleReleaseMeta"
+	UpgradeApprovalKind   Kind = "UpgradeApproval"
 )
 
 type Kind string
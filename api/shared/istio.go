@@ -3,5 +3,6 @@ package shared
 const (
 	IstioNamespace           = "istio-system"
 	GatewaySecretName        = "klm-istio-gateway" //nolint:gosec // It is just a name
+	RootCASecretName         = "klm-watcher"       //nolint:gosec // It is just a name
 	LastModifiedAtAnnotation = "lastModifiedAt"
 )
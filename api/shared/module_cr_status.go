@@ -0,0 +1,24 @@
+package shared
+
+import (
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ModuleCRStatus is a compact copy of the status of the module CR in the SKR cluster.
+// +k8s:deepcopy-gen=true
+type ModuleCRStatus struct {
+	// State is the state of the module CR, mapped to a state of Lifecycle Manager.
+	// It is empty if the state of the module CR is unknown.
+	// +optional
+	State State `json:"state,omitempty"`
+
+	// Message is the message of the module CR.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Conditions are the conditions of the module CR selected in the ModuleTemplate.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []apimetav1.Condition `json:"conditions,omitempty"`
+}
@@ -12,4 +12,31 @@ const (
 	CustomStateCheckAnnotation = OperatorGroup + Separator + "custom-state-check"
 	ModuleVersionAnnotation    = OperatorGroup + Separator + "module-version"
 	UnmanagedAnnotation        = OperatorGroup + Separator + "is-unmanaged"
+	// ProvisionerSpecAnnotation holds the fields of the runtime Kyma spec controlled by the provisioner as they were
+	// last synchronized. It is used to tell changes of the provisioner from changes in the runtime.
+	ProvisionerSpecAnnotation = OperatorGroup + Separator + "provisioner-spec"
+	// TraceParentAnnotation holds the W3C trace context of the Kyma reconciliation that last changed a Manifest,
+	// so that the Manifest reconciliation continues the trace.
+	TraceParentAnnotation = OperatorGroup + Separator + "traceparent"
+	// ReadoptionAnnotation marks a Manifest of a module which becomes managed again. Its existing resources are
+	// only adopted if they do not differ from the desired state, or if the value is set to ReadoptionConfirmed.
+	ReadoptionAnnotation = OperatorGroup + Separator + "readoption"
+	ReadoptionRequired   = "required"
+	ReadoptionConfirmed  = "confirmed"
+	// ConversionDataAnnotation holds the fields of an object which cannot be represented in the API version it is
+	// converted to, so that they are restored when the object is converted back.
+	ConversionDataAnnotation = OperatorGroup + Separator + "conversion-data"
+	// HookAnnotation marks a Job of the hooks layer of a module as run before or after an upgrade of the module.
+	HookAnnotation  = OperatorGroup + Separator + "hook"
+	HookPreUpgrade  = "pre-upgrade"
+	HookPostUpgrade = "post-upgrade"
+	// HookTimeoutAnnotation overrides the time a hook Job has to complete, e.g. "15m".
+	HookTimeoutAnnotation = OperatorGroup + Separator + "hook-timeout"
+	// SKREventAnnotation is set to the time of the last SKR event received for a Kyma by a replica not owning the
+	// shard of the Kyma, so that the event reaches the owning replica through its Kyma informer.
+	SKREventAnnotation = OperatorGroup + Separator + "skr-event"
+	// SyncedCertificateNotBeforeAnnotation is set on the certificate secret of a Kyma to the notBefore time of the
+	// certificate last synced to the SKR cluster, so that a previous CA is only dropped once the SKR uses a
+	// certificate of the rotated CA.
+	SyncedCertificateNotBeforeAnnotation = OperatorGroup + Separator + "synced-certificate-not-before"
 )
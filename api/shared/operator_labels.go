@@ -31,6 +31,8 @@ const (
 	// PurposeLabel defines the purpose of the resource, i.e. Secrets which will be used to certificate management.
 	PurposeLabel = OperatorGroup + Separator + "purpose"
 	CertManager  = "klm-watcher-cert-manager"
+	// BuiltinSigner is the purpose of the certificate Secrets signed by Lifecycle Manager without cert-manager.
+	BuiltinSigner = "klm-watcher-builtin-signer"
 	// SkipReconcileLabel indicates this specific resource will be skipped during reconciliation.
 	SkipReconcileLabel     = OperatorGroup + Separator + "skip-reconciliation"
 	UnmanagedKyma          = "unmanaged-kyma"
@@ -44,9 +46,31 @@ const (
 	// If put on a single ModuleTemplate, allows to disable sync just for this object.
 	SyncLabel = OperatorGroup + Separator + "sync"
 
+	// TenantNamespaceLabel makes a Kyma a tenant of a shared SKR cluster. Its remote Kyma, module catalog and
+	// module CRs are put into the given namespace of the SKR cluster instead of kyma-system.
+	TenantNamespaceLabel = OperatorGroup + Separator + "tenant-namespace"
+	// NamespacedModuleLabel marks a ModuleTemplate of a module whose installation is confined to a namespace,
+	// so that several tenants of one SKR cluster can enable it. All other modules are cluster-scoped.
+	NamespacedModuleLabel = OperatorGroup + Separator + "namespaced-module"
+
+	// RevisionLabel marks the workloads of a module with a BlueGreen upgrade strategy with the version they belong
+	// to, so that the workloads of two versions can run side by side.
+	RevisionLabel = OperatorGroup + Separator + "revision"
+
+	// UpgradeApprovalLabel gates the module upgrades of a Kyma: with the value "true", a module is only upgraded to a
+	// new version once an UpgradeApproval for this version exists.
+	UpgradeApprovalLabel = OperatorGroup + Separator + "upgrade-approval"
+
+	// ShardLabel assigns a Kyma and its Manifests to the shard of the Lifecycle Manager replica reconciling them.
+	ShardLabel = OperatorGroup + Separator + "shard"
+	// PinnedShardLabel pins a Kyma to a shard, so that it is not moved when the shards are rebalanced.
+	PinnedShardLabel = OperatorGroup + Separator + "pinned-shard"
+
 	GlobalAccountIDLabel = KymaGroup + Separator + "global-account-id"
-	RegionLabel          = KymaGroup + Separator + "region"
-	PlatformRegionLabel  = KymaGroup + Separator + "platform-region"
+	// RuntimeIDLabel identifies the SKR cluster of a Kyma, tenant Kymas with the same runtime ID share the cluster.
+	RuntimeIDLabel      = KymaGroup + Separator + "runtime-id"
+	RegionLabel         = KymaGroup + Separator + "region"
+	PlatformRegionLabel = KymaGroup + Separator + "platform-region"
 	// to be confirmed https://github.com/kyma-project/kyma/issues/18611#issuecomment-2441158676
 	PlanLabel = KymaGroup + Separator + "broker-plan-name"
 
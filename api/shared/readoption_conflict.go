@@ -0,0 +1,10 @@
+package shared
+
+// ReadoptionConflict is an existing resource of a module whose fields differ from the desired state.
+// +k8s:deepcopy-gen=true
+type ReadoptionConflict struct {
+	Resource Resource `json:"resource"`
+	// Fields are the paths of the fields which are overwritten, such as .spec.replicas.
+	// +listType=atomic
+	Fields []string `json:"fields"`
+}
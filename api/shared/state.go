@@ -1,6 +1,6 @@
 package shared
 
-// +kubebuilder:validation:Enum=Processing;Deleting;Ready;Error;"";Warning;Unmanaged
+// +kubebuilder:validation:Enum=Processing;Deleting;Ready;Error;"";Warning;Unmanaged;ReadoptionPending
 type State string
 
 // Valid States.
@@ -23,6 +23,10 @@ const (
 	StateWarning State = "Warning"
 
 	StateUnmanaged State = "Unmanaged"
+
+	// StateReadoptionPending signifies that the resources of a module which becomes managed again already exist and
+	// differ from the desired state. The module is only reconciled again once the re-adoption is confirmed.
+	StateReadoptionPending State = "ReadoptionPending"
 )
 
 // IsSupportedState These states will be used by module CR.
@@ -26,6 +26,23 @@ type Status struct {
 	// +listType=atomic
 	Synced        []Resource `json:"synced,omitempty"`
 	LastOperation `json:"lastOperation,omitempty"`
+
+	// ModuleCR is a copy of the status of the module CR, mapped as declared in the ModuleTemplate.
+	// +optional
+	ModuleCR *ModuleCRStatus `json:"moduleCR,omitempty"`
+
+	// ReadoptionConflicts lists the existing resources whose fields are overwritten when the module is re-adopted.
+	// +optional
+	// +listType=atomic
+	ReadoptionConflicts []ReadoptionConflict `json:"readoptionConflicts,omitempty"`
+
+	// Upgrade reports the latest BlueGreen upgrade of the module.
+	// +optional
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+
+	// HooksRef is the OCI ref of the version the conditions of the upgrade hooks are reported for.
+	// +optional
+	HooksRef string `json:"hooksRef,omitempty"`
 }
 
 func (s Status) WithState(state State) Status {
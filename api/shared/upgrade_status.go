@@ -0,0 +1,43 @@
+package shared
+
+import (
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpgradePhase is the phase of a BlueGreen upgrade of a module.
+type UpgradePhase string
+
+const (
+	// UpgradePhaseProgressing is the phase in which the new version is installed alongside the previous one.
+	UpgradePhaseProgressing UpgradePhase = "Progressing"
+	// UpgradePhaseCompleted is the phase after the new version became Ready and the previous one was removed.
+	UpgradePhaseCompleted UpgradePhase = "Completed"
+	// UpgradePhaseAborted is the phase after the new version did not become Ready in time and was removed.
+	UpgradePhaseAborted UpgradePhase = "Aborted"
+)
+
+// UpgradeStatus reports the BlueGreen upgrade of a module.
+// +k8s:deepcopy-gen=true
+type UpgradeStatus struct {
+	// Version is the version the module is upgraded to.
+	Version string `json:"version"`
+
+	// Ref is the reference of the installation layer of the version.
+	Ref string `json:"ref"`
+
+	// Phase is the phase of the upgrade.
+	Phase UpgradePhase `json:"phase"`
+
+	// Added lists the resources which the version adds alongside the resources of the previous version.
+	// They are removed if the upgrade is aborted.
+	// +optional
+	// +listType=atomic
+	Added []Resource `json:"added,omitempty"`
+
+	// StartedAt is the time the upgrade started.
+	StartedAt apimetav1.Time `json:"startedAt"`
+
+	// FinishedAt is the time the upgrade completed or was aborted.
+	// +optional
+	FinishedAt *apimetav1.Time `json:"finishedAt,omitempty"`
+}
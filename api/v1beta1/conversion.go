@@ -0,0 +1,266 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+var ErrUnexpectedHub = errors.New("unexpected hub type")
+
+// kymaConversionData holds the fields of the v1beta1 Kyma which do not exist in v1beta2.
+type kymaConversionData struct {
+	Sync Sync `json:"sync"`
+}
+
+// moduleTemplateConversionData holds the fields of the v1beta1 ModuleTemplate which do not exist in v1beta2.
+// The Target is only kept if it differs from TargetRemote, which is the Target of ModuleTemplates without one.
+type moduleTemplateConversionData struct {
+	Target *Target `json:"target,omitempty"`
+}
+
+// moduleTemplateHubConversionData holds the fields of the v1beta2 ModuleTemplate which do not exist in v1beta1.
+type moduleTemplateHubConversionData struct {
+	Version             string                         `json:"version,omitempty"`
+	ModuleName          string                         `json:"moduleName,omitempty"`
+	Resources           []v1beta2.Resource             `json:"resources,omitempty"`
+	Info                *v1beta2.ModuleInfo            `json:"info,omitempty"`
+	AssociatedResources []apimetav1.GroupVersionKind   `json:"associatedResources,omitempty"`
+	Manager             *v1beta2.Manager               `json:"manager,omitempty"`
+	Criticality         v1beta2.ModuleCriticality      `json:"criticality,omitempty"`
+	ModuleCRStatus      *v1beta2.ModuleCRStatusMapping `json:"moduleCRStatus,omitempty"`
+	UpgradeStrategy     *v1beta2.UpgradeStrategy       `json:"upgradeStrategy,omitempty"`
+}
+
+// ConvertTo converts the Kyma to the v1beta2 hub. The Sync settings are kept in the conversion data annotation.
+func (src *Kyma) ConvertTo(hub conversion.Hub) error {
+	dst, ok := hub.(*v1beta2.Kyma)
+	if !ok {
+		return fmt.Errorf("%w: expected a v1beta2 Kyma but got %T", ErrUnexpectedHub, hub)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = v1beta2.KymaSpec{
+		Channel:                src.Spec.Channel,
+		SkipMaintenanceWindows: src.Spec.SkipMaintenanceWindows,
+		Modules:                copyModules(src.Spec.Modules),
+		DeletionPolicy:         src.Spec.DeletionPolicy,
+		ChannelControlledBy:    src.Spec.ChannelControlledBy,
+	}
+	dst.Status = *src.Status.DeepCopy()
+	return storeConversionData(dst, kymaConversionData{Sync: src.Spec.Sync})
+}
+
+// ConvertFrom converts the v1beta2 hub to the Kyma, restoring the Sync settings kept by ConvertTo.
+func (dst *Kyma) ConvertFrom(hub conversion.Hub) error {
+	src, ok := hub.(*v1beta2.Kyma)
+	if !ok {
+		return fmt.Errorf("%w: expected a v1beta2 Kyma but got %T", ErrUnexpectedHub, hub)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	data := kymaConversionData{}
+	if err := restoreConversionData(dst, &data); err != nil {
+		return err
+	}
+	dst.Spec = KymaSpec{
+		Channel:                src.Spec.Channel,
+		SkipMaintenanceWindows: src.Spec.SkipMaintenanceWindows,
+		Modules:                copyModules(src.Spec.Modules),
+		DeletionPolicy:         src.Spec.DeletionPolicy,
+		ChannelControlledBy:    src.Spec.ChannelControlledBy,
+		Sync:                   data.Sync,
+	}
+	dst.Status = *src.Status.DeepCopy()
+	return nil
+}
+
+// ConvertTo converts the Manifest to the v1beta2 hub. Both versions share the same spec and status.
+func (src *Manifest) ConvertTo(hub conversion.Hub) error {
+	dst, ok := hub.(*v1beta2.Manifest)
+	if !ok {
+		return fmt.Errorf("%w: expected a v1beta2 Manifest but got %T", ErrUnexpectedHub, hub)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = *src.Spec.DeepCopy()
+	dst.Status = *src.Status.DeepCopy()
+	return nil
+}
+
+// ConvertFrom converts the v1beta2 hub to the Manifest. Both versions share the same spec and status.
+func (dst *Manifest) ConvertFrom(hub conversion.Hub) error {
+	src, ok := hub.(*v1beta2.Manifest)
+	if !ok {
+		return fmt.Errorf("%w: expected a v1beta2 Manifest but got %T", ErrUnexpectedHub, hub)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = *src.Spec.DeepCopy()
+	dst.Status = *src.Status.DeepCopy()
+	return nil
+}
+
+// ConvertTo converts the ModuleTemplate to the v1beta2 hub. The Target is kept in the conversion data annotation,
+// the fields which only exist in v1beta2 are restored from it.
+func (src *ModuleTemplate) ConvertTo(hub conversion.Hub) error {
+	dst, ok := hub.(*v1beta2.ModuleTemplate)
+	if !ok {
+		return fmt.Errorf("%w: expected a v1beta2 ModuleTemplate but got %T", ErrUnexpectedHub, hub)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	hubData := moduleTemplateHubConversionData{}
+	if err := restoreConversionData(dst, &hubData); err != nil {
+		return err
+	}
+	dst.Spec = v1beta2.ModuleTemplateSpec{
+		Channel:             src.Spec.Channel,
+		Version:             hubData.Version,
+		ModuleName:          hubData.ModuleName,
+		Mandatory:           src.Spec.Mandatory,
+		Data:                src.Spec.Data.DeepCopy(),
+		Descriptor:          *src.Spec.Descriptor.DeepCopy(),
+		CustomStateCheck:    copyCustomStateChecks(src.Spec.CustomStateCheck),
+		Resources:           hubData.Resources,
+		Info:                hubData.Info,
+		AssociatedResources: hubData.AssociatedResources,
+		Manager:             hubData.Manager,
+		RequiresDowntime:    src.Spec.RequiresDowntime,
+		Criticality:         hubData.Criticality,
+		ModuleCRStatus:      hubData.ModuleCRStatus,
+		UpgradeStrategy:     hubData.UpgradeStrategy,
+	}
+	data := moduleTemplateConversionData{}
+	if src.Spec.Target != TargetRemote {
+		target := src.Spec.Target
+		data.Target = &target
+	}
+	return storeConversionData(dst, data)
+}
+
+// ConvertFrom converts the v1beta2 hub to the ModuleTemplate. The fields which only exist in v1beta2 are kept in the
+// conversion data annotation, the Target is restored from it and defaults to TargetRemote.
+func (dst *ModuleTemplate) ConvertFrom(hub conversion.Hub) error {
+	src, ok := hub.(*v1beta2.ModuleTemplate)
+	if !ok {
+		return fmt.Errorf("%w: expected a v1beta2 ModuleTemplate but got %T", ErrUnexpectedHub, hub)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	data := moduleTemplateConversionData{}
+	if err := restoreConversionData(dst, &data); err != nil {
+		return err
+	}
+	target := TargetRemote
+	if data.Target != nil {
+		target = *data.Target
+	}
+	dst.Spec = ModuleTemplateSpec{
+		Channel:          src.Spec.Channel,
+		Mandatory:        src.Spec.Mandatory,
+		Data:             src.Spec.Data.DeepCopy(),
+		Descriptor:       *src.Spec.Descriptor.DeepCopy(),
+		Target:           target,
+		CustomStateCheck: copyCustomStateChecks(src.Spec.CustomStateCheck),
+		RequiresDowntime: src.Spec.RequiresDowntime,
+	}
+	spec := src.Spec.DeepCopy()
+	return storeConversionData(dst, moduleTemplateHubConversionData{
+		Version:             spec.Version,
+		ModuleName:          spec.ModuleName,
+		Resources:           spec.Resources,
+		Info:                spec.Info,
+		AssociatedResources: spec.AssociatedResources,
+		Manager:             spec.Manager,
+		Criticality:         spec.Criticality,
+		ModuleCRStatus:      spec.ModuleCRStatus,
+		UpgradeStrategy:     spec.UpgradeStrategy,
+	})
+}
+
+// ConvertTo converts the Watcher to the v1beta2 hub. Both versions share the same spec and status.
+func (src *Watcher) ConvertTo(hub conversion.Hub) error {
+	dst, ok := hub.(*v1beta2.Watcher)
+	if !ok {
+		return fmt.Errorf("%w: expected a v1beta2 Watcher but got %T", ErrUnexpectedHub, hub)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = *src.Spec.DeepCopy()
+	dst.Status = *src.Status.DeepCopy()
+	return nil
+}
+
+// ConvertFrom converts the v1beta2 hub to the Watcher. Both versions share the same spec and status.
+func (dst *Watcher) ConvertFrom(hub conversion.Hub) error {
+	src, ok := hub.(*v1beta2.Watcher)
+	if !ok {
+		return fmt.Errorf("%w: expected a v1beta2 Watcher but got %T", ErrUnexpectedHub, hub)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = *src.Spec.DeepCopy()
+	dst.Status = *src.Status.DeepCopy()
+	return nil
+}
+
+// storeConversionData stores the data in the conversion data annotation of the object, unless the data is empty.
+func storeConversionData[T any](obj apimetav1.Object, data T) error {
+	var empty T
+	if equality.Semantic.DeepEqual(data, empty) {
+		return nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to serialize conversion data of %s: %w", obj.GetName(), err)
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[shared.ConversionDataAnnotation] = string(raw)
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// restoreConversionData reads the conversion data annotation of the object into the data and removes it.
+func restoreConversionData(obj apimetav1.Object, data any) error {
+	annotations := obj.GetAnnotations()
+	raw, found := annotations[shared.ConversionDataAnnotation]
+	if !found {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(raw), data); err != nil {
+		return fmt.Errorf("failed to parse conversion data of %s: %w", obj.GetName(), err)
+	}
+	delete(annotations, shared.ConversionDataAnnotation)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+func copyModules(modules []v1beta2.Module) []v1beta2.Module {
+	if modules == nil {
+		return nil
+	}
+	copied := make([]v1beta2.Module, len(modules))
+	for i := range modules {
+		modules[i].DeepCopyInto(&copied[i])
+	}
+	return copied
+}
+
+func copyCustomStateChecks(checks []*v1beta2.CustomStateCheck) []*v1beta2.CustomStateCheck {
+	if checks == nil {
+		return nil
+	}
+	copied := make([]*v1beta2.CustomStateCheck, len(checks))
+	for i := range checks {
+		if checks[i] != nil {
+			copied[i] = checks[i].DeepCopy()
+		}
+	}
+	return copied
+}
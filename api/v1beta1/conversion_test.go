@@ -0,0 +1,141 @@
+package v1beta1_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+const fuzzIterations = 1000
+
+func TestConversion_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		hub   func() conversion.Hub
+		spoke func() conversion.Convertible
+	}{
+		{
+			name:  "Kyma",
+			hub:   func() conversion.Hub { return &v1beta2.Kyma{} },
+			spoke: func() conversion.Convertible { return &v1beta1.Kyma{} },
+		},
+		{
+			name:  "Manifest",
+			hub:   func() conversion.Hub { return &v1beta2.Manifest{} },
+			spoke: func() conversion.Convertible { return &v1beta1.Manifest{} },
+		},
+		{
+			name:  "ModuleTemplate",
+			hub:   func() conversion.Hub { return &v1beta2.ModuleTemplate{} },
+			spoke: func() conversion.Convertible { return &v1beta1.ModuleTemplate{} },
+		},
+		{
+			name:  "Watcher",
+			hub:   func() conversion.Hub { return &v1beta2.Watcher{} },
+			spoke: func() conversion.Convertible { return &v1beta1.Watcher{} },
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name+" spoke-hub-spoke", func(t *testing.T) {
+			fuzzer := newFuzzer(t)
+			for range fuzzIterations {
+				spokeBefore := testCase.spoke()
+				fuzzer.Fuzz(spokeBefore)
+
+				hub := testCase.hub()
+				require.NoError(t, spokeBefore.ConvertTo(hub))
+				spokeAfter := testCase.spoke()
+				require.NoError(t, spokeAfter.ConvertFrom(hub))
+
+				assert.True(t, equality.Semantic.DeepEqual(spokeBefore, spokeAfter),
+					"%s changed in round trip:\n%#v\n%#v", testCase.name, spokeBefore, spokeAfter)
+			}
+		})
+		t.Run(testCase.name+" hub-spoke-hub", func(t *testing.T) {
+			fuzzer := newFuzzer(t)
+			for range fuzzIterations {
+				hubBefore := testCase.hub()
+				fuzzer.Fuzz(hubBefore)
+
+				spoke := testCase.spoke()
+				require.NoError(t, spoke.ConvertFrom(hubBefore))
+				hubAfter := testCase.hub()
+				require.NoError(t, spoke.ConvertTo(hubAfter))
+
+				assert.True(t, equality.Semantic.DeepEqual(hubBefore, hubAfter),
+					"%s changed in round trip:\n%#v\n%#v", testCase.name, hubBefore, hubAfter)
+			}
+		})
+	}
+}
+
+func TestModuleTemplateConversion_DefaultsTargetToRemote(t *testing.T) {
+	hub := &v1beta2.ModuleTemplate{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "template"},
+		Spec:       v1beta2.ModuleTemplateSpec{ModuleName: "template-operator", Version: "1.0.1"},
+	}
+
+	spoke := &v1beta1.ModuleTemplate{}
+	require.NoError(t, spoke.ConvertFrom(hub))
+
+	assert.Equal(t, v1beta1.TargetRemote, spoke.Spec.Target)
+	assert.JSONEq(t, `{"version":"1.0.1","moduleName":"template-operator"}`,
+		spoke.GetAnnotations()[shared.ConversionDataAnnotation])
+	assert.Empty(t, hub.GetAnnotations())
+}
+
+func TestKymaConversion_KeepsSyncInAnnotation(t *testing.T) {
+	spoke := &v1beta1.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "kyma", Annotations: map[string]string{"owner": "team"}},
+		Spec: v1beta1.KymaSpec{
+			Channel: "regular",
+			Sync:    v1beta1.Sync{Enabled: true, Strategy: v1beta2.SyncStrategy("secret")},
+		},
+	}
+
+	hub := &v1beta2.Kyma{}
+	require.NoError(t, spoke.ConvertTo(hub))
+
+	assert.Equal(t, "regular", hub.Spec.Channel)
+	assert.JSONEq(t, `{"sync":{"enabled":true,"strategy":"secret"}}`,
+		hub.GetAnnotations()[shared.ConversionDataAnnotation])
+	assert.Equal(t, map[string]string{"owner": "team"}, spoke.GetAnnotations())
+}
+
+func newFuzzer(t *testing.T) *fuzz.Fuzzer {
+	t.Helper()
+	seed := rand.Int63() //nolint:gosec // the seed of the fuzzer does not need to be secure
+	t.Logf("fuzzer seed: %d", seed)
+	funcs := fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, conversionFuzzerFuncs)
+	return fuzzer.FuzzerFor(funcs, rand.NewSource(seed), serializer.NewCodecFactory(machineryruntime.NewScheme()))
+}
+
+// conversionFuzzerFuncs fuzzes the fields which gofuzz cannot fill, as they hold arbitrary content.
+func conversionFuzzerFuncs(_ serializer.CodecFactory) []any {
+	return []any{
+		func(obj *unstructured.Unstructured, c fuzz.Continue) {
+			obj.SetAPIVersion("v1")
+			obj.SetKind("ConfigMap")
+			obj.SetName(c.RandString())
+		},
+		func(raw *machineryruntime.RawExtension, c fuzz.Continue) {
+			raw.Raw, _ = json.Marshal(map[string]string{"name": c.RandString()})
+		},
+	}
+}
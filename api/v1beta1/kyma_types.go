@@ -28,7 +28,6 @@ import (
 // +kubebuilder:printcolumn:name="State",type=string,JSONPath=".status.state"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:deprecatedversion:warning="kyma-project.io/v1beta1 Kyma is deprecated. Use v1beta2 instead."
-// +kubebuilder:unservedversion
 
 // Kyma is the Schema for the kymas API.
 type Kyma struct {
@@ -82,6 +81,16 @@ type KymaSpec struct {
 	// Modules specifies the list of modules to be installed
 	Modules []v1beta2.Module `json:"modules,omitempty"`
 
+	// DeletionPolicy determines how the modules are handled when the Kyma is deleted.
+	// It can be overridden per module. An empty DeletionPolicy is treated as Cascade.
+	// +optional
+	DeletionPolicy v1beta2.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ChannelControlledBy determines who controls the Channel. If it is controlled by the Provisioner,
+	// changes of the Channel in the runtime Kyma are rejected. An empty value is treated as Customer.
+	// +optional
+	ChannelControlledBy v1beta2.SpecOwner `json:"channelControlledBy,omitempty"`
+
 	// Active Synchronization Settings
 	// +optional
 	Sync Sync `json:"sync,omitempty"`
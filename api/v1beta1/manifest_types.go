@@ -28,7 +28,6 @@ import (
 // +kubebuilder:printcolumn:name="State",type=string,JSONPath=".status.state"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:deprecatedversion:warning="kyma-project.io/v1beta1 Manifest is deprecated. Use v1beta2 instead."
-// +kubebuilder:unservedversion
 
 // Manifest is the Schema for the manifests API.
 type Manifest struct {
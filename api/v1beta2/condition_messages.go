@@ -11,19 +11,35 @@ const (
 	ConditionTypeModules         KymaConditionType = "Modules"
 	ConditionTypeModuleCatalog   KymaConditionType = "ModuleCatalog"
 	ConditionTypeSKRWebhook      KymaConditionType = "SKRWebhook"
+	// ConditionTypeSKRWebhookCertificate indicates whether the SKR webhook uses a client certificate that is
+	// issued by the current CA and renewed in time.
+	ConditionTypeSKRWebhookCertificate KymaConditionType = "SKRWebhookCertificate"
+	// ConditionTypeOptionalModules indicates whether all optional modules are ready. It is only set if the Kyma
+	// contains optional modules and does not influence the state of the Kyma.
+	ConditionTypeOptionalModules KymaConditionType = "OptionalModulesReady"
+	// ConditionTypeSpecConflict indicates whether changes of the runtime Kyma to fields controlled by the
+	// provisioner were rejected. It is only set once a conflict occurred and does not influence the state of the Kyma.
+	ConditionTypeSpecConflict KymaConditionType = "SpecConflict"
 
 	// ConditionReason will be set to `Ready` on all Conditions. If the Condition is actual ready,
 	// can be determined by the state.
 	ConditionReason KymaConditionReason = "Ready"
+	// ConditionReasonSpecConflict is set on the SpecConflict condition if a conflict was detected.
+	ConditionReasonSpecConflict KymaConditionReason = "SpecConflict"
 
-	ConditionMessageModuleInReadyState        = "all modules are in ready state"
-	ConditionMessageModuleNotInReadyState     = "not all modules are in ready state"
-	ConditionMessageModuleCatalogIsSynced     = "module templates are synchronized"
-	ConditionMessageModuleCatalogIsOutOfSync  = "module templates are out of sync and need to be resynchronized"
-	ConditionMessageSKRWebhookIsSynced        = "skrwebhook is synchronized"
-	ConditionMessageSKRWebhookIsOutOfSync     = "skrwebhook is out of sync and needs to be resynchronized"
-	ConditionMessageModuleStateUnknown        = "modules state is unknown"
-	ConditionMessageModuleCatalogStateUnknown = "module templates synchronization state is unknown"
+	ConditionMessageModuleInReadyState            = "all modules are in ready state"
+	ConditionMessageModuleNotInReadyState         = "not all modules are in ready state"
+	ConditionMessageModuleCatalogIsSynced         = "module templates are synchronized"
+	ConditionMessageModuleCatalogIsOutOfSync      = "module templates are out of sync and need to be resynchronized"
+	ConditionMessageSKRWebhookIsSynced            = "skrwebhook is synchronized"
+	ConditionMessageSKRWebhookIsOutOfSync         = "skrwebhook is out of sync and needs to be resynchronized"
+	ConditionMessageSKRWebhookCertIsCurrent       = "skrwebhook certificate is issued by the current CA"
+	ConditionMessageSKRWebhookCertIsRotating      = "skrwebhook certificate is being rotated or was not renewed in time"
+	ConditionMessageOptionalModuleInReadyState    = "all optional modules are in ready state"
+	ConditionMessageOptionalModuleNotInReadyState = "not all optional modules are in ready state"
+	ConditionMessageNoSpecConflict                = "runtime kyma spec respects the fields controlled by the provisioner"
+	ConditionMessageModuleStateUnknown            = "modules state is unknown"
+	ConditionMessageModuleCatalogStateUnknown     = "module templates synchronization state is unknown"
 )
 
 func GenerateMessage(conditionType KymaConditionType, status apimetav1.ConditionStatus) string {
@@ -57,6 +73,29 @@ func GenerateMessage(conditionType KymaConditionType, status apimetav1.Condition
 		}
 
 		return ConditionMessageSKRWebhookIsOutOfSync
+	case ConditionTypeSKRWebhookCertificate:
+		switch status {
+		case apimetav1.ConditionTrue:
+			return ConditionMessageSKRWebhookCertIsCurrent
+		case apimetav1.ConditionUnknown:
+		case apimetav1.ConditionFalse:
+		}
+
+		return ConditionMessageSKRWebhookCertIsRotating
+	case ConditionTypeOptionalModules:
+		switch status {
+		case apimetav1.ConditionTrue:
+			return ConditionMessageOptionalModuleInReadyState
+		case apimetav1.ConditionUnknown:
+			return ConditionMessageModuleStateUnknown
+		case apimetav1.ConditionFalse:
+		}
+
+		return ConditionMessageOptionalModuleNotInReadyState
+	case ConditionTypeSpecConflict:
+		if status == apimetav1.ConditionFalse {
+			return ConditionMessageNoSpecConflict
+		}
 	case DeprecatedConditionTypeReady:
 	}
 
@@ -70,7 +109,7 @@ func GetRequiredConditionTypes(syncEnabled, watcherEnabled bool) []KymaCondition
 		requiredConditions = append(requiredConditions, ConditionTypeModuleCatalog)
 	}
 	if watcherEnabled {
-		requiredConditions = append(requiredConditions, ConditionTypeSKRWebhook)
+		requiredConditions = append(requiredConditions, ConditionTypeSKRWebhook, ConditionTypeSKRWebhookCertificate)
 	}
 	return requiredConditions
 }
@@ -0,0 +1,13 @@
+package v1beta2
+
+// Hub marks v1beta2 as the version all other versions of the Kyma are converted to and from.
+func (*Kyma) Hub() {}
+
+// Hub marks v1beta2 as the version all other versions of the Manifest are converted to and from.
+func (*Manifest) Hub() {}
+
+// Hub marks v1beta2 as the version all other versions of the ModuleTemplate are converted to and from.
+func (*ModuleTemplate) Hub() {}
+
+// Hub marks v1beta2 as the version all other versions of the Watcher are converted to and from.
+func (*Watcher) Hub() {}
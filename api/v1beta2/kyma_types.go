@@ -17,6 +17,9 @@ limitations under the License.
 package v1beta2
 
 import (
+	"slices"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/api/meta"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -57,6 +60,16 @@ type KymaSpec struct {
 	// +listType=map
 	// +listMapKey=name
 	Modules []Module `json:"modules,omitempty"`
+
+	// DeletionPolicy determines how the modules are handled when the Kyma is deleted.
+	// It can be overridden per module. An empty DeletionPolicy is treated as Cascade.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ChannelControlledBy determines who controls the Channel. If it is controlled by the Provisioner,
+	// changes of the Channel in the runtime Kyma are rejected. An empty value is treated as Customer.
+	// +optional
+	ChannelControlledBy SpecOwner `json:"channelControlledBy,omitempty"`
 }
 
 // Module defines the components to be installed.
@@ -98,6 +111,25 @@ type Module struct {
 	// for the lifecycle of the module.
 	// +kubebuilder:default:=true
 	Managed bool `json:"managed"`
+
+	// Criticality overrides the criticality of the module declared in the ModuleTemplate.
+	// Optional modules do not influence the state of the Kyma, but only the OptionalModulesReady condition.
+	// +optional
+	Criticality ModuleCriticality `json:"criticality,omitempty"`
+
+	// DeletionPolicy overrides the DeletionPolicy of the Kyma for this module.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ControlledBy determines who controls the module. A module controlled by the Provisioner is taken from the
+	// control plane Kyma and cannot be removed or changed in the runtime Kyma. An empty value is treated as Customer.
+	// +optional
+	ControlledBy SpecOwner `json:"controlledBy,omitempty"`
+}
+
+// IsControlledByProvisioner reports whether the module can only be changed in the control plane Kyma.
+func (m Module) IsControlledByProvisioner() bool {
+	return m.ControlledBy == SpecOwnerProvisioner
 }
 
 // CustomResourcePolicy determines how a ModuleTemplate should be parsed. When CustomResourcePolicy is set to
@@ -117,6 +149,43 @@ const (
 	CustomResourcePolicyIgnore = "Ignore"
 )
 
+// DeletionPolicy determines how the resources of a module are handled when the Kyma is deleted.
+// +kubebuilder:validation:Enum=Cascade;Orphan;BlockIfCustomResourcesExist
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyCascade deletes the module together with all its resources. It is the default.
+	DeletionPolicyCascade DeletionPolicy = "Cascade"
+	// DeletionPolicyOrphan removes the module from the management of the Lifecycle Manager,
+	// keeping its workloads and custom resources in the cluster.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+	// DeletionPolicyBlockIfCustomResourcesExist blocks the deletion of the Kyma as long as user-created
+	// custom resources of the module exist in the cluster. Afterwards, the module is deleted like with Cascade.
+	DeletionPolicyBlockIfCustomResourcesExist DeletionPolicy = "BlockIfCustomResourcesExist"
+)
+
+// SpecOwner determines who controls a part of the Kyma spec when the Kyma is synchronized with the runtime.
+// +kubebuilder:validation:Enum=Provisioner;Customer
+type SpecOwner string
+
+const (
+	// SpecOwnerProvisioner is the provisioning system, which controls the Kyma in the control plane.
+	SpecOwnerProvisioner SpecOwner = "Provisioner"
+	// SpecOwnerCustomer is the user of the runtime, who controls the runtime Kyma. It is the default.
+	SpecOwnerCustomer SpecOwner = "Customer"
+)
+
+// ModuleCriticality determines whether the state of a module contributes to the state of the Kyma.
+// +kubebuilder:validation:Enum=critical;optional
+type ModuleCriticality string
+
+const (
+	// ModuleCriticalityCritical modules determine the state of the Kyma. It is the default if no criticality is set.
+	ModuleCriticalityCritical ModuleCriticality = "critical"
+	// ModuleCriticalityOptional modules only contribute to the OptionalModulesReady condition of the Kyma.
+	ModuleCriticalityOptional ModuleCriticality = "optional"
+)
+
 // SyncStrategy determines how the Remote Cluster is synchronized with the Control Plane. This can influence secret
 // lookup, or other behavioral patterns when interacting with the remote cluster.
 type SyncStrategy string
@@ -149,9 +218,129 @@ type KymaStatus struct {
 	// +optional
 	ActiveChannel string `json:"activeChannel,omitempty"`
 
+	// ModulesSummary aggregates the states of all modules.
+	// +optional
+	ModulesSummary *ModulesSummary `json:"modulesSummary,omitempty"`
+
+	// DeletionBlockedBy lists the user-created custom resources which block the deletion of the Kyma
+	// because their module has the BlockIfCustomResourcesExist DeletionPolicy.
+	// +optional
+	DeletionBlockedBy []DeletionBlockingResource `json:"deletionBlockedBy,omitempty"`
+
+	// Purge reports the cleanup of the custom resources which remained in the runtime cluster after the deletion
+	// of the Kyma.
+	// +optional
+	Purge *PurgeStatus `json:"purge,omitempty"`
+
+	// MandatoryModules contains the state of the mandatory modules installed for the Kyma.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	MandatoryModules []MandatoryModuleStatus `json:"mandatoryModules,omitempty"`
+
+	// PendingUpgrades lists the module upgrades which wait for an UpgradeApproval, if the Kyma has gated upgrades.
+	// +optional
+	// +listType=map
+	// +listMapKey=module
+	PendingUpgrades []PendingUpgrade `json:"pendingUpgrades,omitempty"`
+
 	shared.LastOperation `json:"lastOperation,omitempty"`
 }
 
+// ModulesSummary aggregates the states of the modules of a Kyma.
+type ModulesSummary struct {
+	// States contains the number of modules per state.
+	// +optional
+	States map[shared.State]int `json:"states,omitempty"`
+
+	// DegradedModules lists the names of the modules which are neither Ready nor Unmanaged.
+	// +optional
+	DegradedModules []string `json:"degradedModules,omitempty"`
+}
+
+// MandatoryModuleStatus reports a mandatory module installed for the Kyma.
+type MandatoryModuleStatus struct {
+	// Name is the name of the module.
+	Name string `json:"name"`
+
+	// Version is the installed version of the module.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// State is the state of the module, as reported by its Manifest.
+	State shared.State `json:"state"`
+
+	// Manifest references the Manifest of the module.
+	// +optional
+	Manifest *TrackingObject `json:"manifest,omitempty"`
+}
+
+// PendingUpgrade is a module upgrade which waits for an UpgradeApproval.
+type PendingUpgrade struct {
+	// Module is the name of the module.
+	Module string `json:"module"`
+
+	// FromVersion is the installed version of the module.
+	FromVersion string `json:"fromVersion"`
+
+	// ToVersion is the version the module is upgraded to once it is approved.
+	ToVersion string `json:"toVersion"`
+
+	// ReleaseNotes is the link to the release notes of the new version, as given in the ModuleTemplate.
+	// +optional
+	ReleaseNotes string `json:"releaseNotes,omitempty"`
+
+	// Since is the time the upgrade to the new version became pending.
+	Since apimetav1.Time `json:"since"`
+}
+
+// DeletionBlockingResource is a custom resource of a module which blocks the deletion of the Kyma.
+type DeletionBlockingResource struct {
+	// Module is the name of the module the custom resource belongs to.
+	Module string `json:"module"`
+
+	shared.Resource `json:",inline"`
+}
+
+// PurgeStatus reports the cleanup of the custom resources which remained in the runtime cluster after the deletion
+// of the Kyma.
+type PurgeStatus struct {
+	// StartedAt is the time the purge started.
+	StartedAt apimetav1.Time `json:"startedAt"`
+
+	// CompletedAt is the time all remaining custom resources were deleted, force-finalized, or left in the cluster.
+	// +optional
+	CompletedAt *apimetav1.Time `json:"completedAt,omitempty"`
+
+	// Resources reports the cleanup per CRD with remaining custom resources.
+	// +optional
+	// +listType=map
+	// +listMapKey=crd
+	Resources []PurgedResources `json:"resources,omitempty"`
+}
+
+// PurgedResources reports the cleanup of the remaining custom resources of a CRD.
+type PurgedResources struct {
+	// CRD is the name of the CRD of the custom resources.
+	CRD string `json:"crd"`
+
+	// Found is the number of custom resources which remained after the deletion of the Kyma.
+	Found int `json:"found"`
+
+	// Deleted is the number of custom resources which were deleted cleanly.
+	// +optional
+	Deleted int `json:"deleted,omitempty"`
+
+	// ForceFinalized is the number of custom resources whose finalizers were removed,
+	// as they were not deleted within the deletion timeout.
+	// +optional
+	ForceFinalized int `json:"forceFinalized,omitempty"`
+
+	// Remaining is the number of custom resources which were neither deleted nor force-finalized.
+	// +optional
+	Remaining int `json:"remaining,omitempty"`
+}
+
 func (status *KymaStatus) GetModuleStatus(moduleName string) *ModuleStatus {
 	for _, moduleStatus := range status.Modules {
 		if moduleStatus.Name == moduleName {
@@ -194,6 +383,59 @@ type ModuleStatus struct {
 
 	// Resource contains information about the created module CR.
 	Resource *TrackingObject `json:"resource,omitempty"`
+
+	// ResourceStatus is a copy of the status of the module CR, mapped as declared in the ModuleTemplate.
+	// +optional
+	ResourceStatus *shared.ModuleCRStatus `json:"resourceStatus,omitempty"`
+
+	// Criticality of the Module. An empty criticality is treated as critical.
+	// +optional
+	Criticality ModuleCriticality `json:"criticality,omitempty"`
+
+	// Lifecycle contains the timestamps of the state and version transitions of the Module.
+	// +optional
+	Lifecycle *ModuleLifecycle `json:"lifecycle,omitempty"`
+
+	// Conditions of the Module, derived from the state transitions of the related Manifest.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []apimetav1.Condition `json:"conditions,omitempty"`
+}
+
+// ModuleLifecycle contains the timestamps of the state and version transitions of a Module.
+type ModuleLifecycle struct {
+	// InstallStartedAt is the time the Module was first added to the status.
+	// +optional
+	InstallStartedAt *apimetav1.Time `json:"installStartedAt,omitempty"`
+
+	// LastReadyAt is the time the Module last became Ready.
+	// +optional
+	LastReadyAt *apimetav1.Time `json:"lastReadyAt,omitempty"`
+
+	// LastTransitionAt is the time the State of the Module last changed.
+	// +optional
+	LastTransitionAt *apimetav1.Time `json:"lastTransitionAt,omitempty"`
+
+	// LastUpgradeAt is the time the Version of the Module last changed.
+	// +optional
+	LastUpgradeAt *apimetav1.Time `json:"lastUpgradeAt,omitempty"`
+
+	// LastUpgradeFrom is the Version of the Module before the last upgrade.
+	// +optional
+	LastUpgradeFrom string `json:"lastUpgradeFrom,omitempty"`
+
+	// LastUpgradeTo is the Version of the Module after the last upgrade.
+	// +optional
+	LastUpgradeTo string `json:"lastUpgradeTo,omitempty"`
+}
+
+func (m *ModuleStatus) IsOptional() bool {
+	return m.Criticality == ModuleCriticalityOptional
+}
+
+func (m *ModuleStatus) IsReady() bool {
+	return m.State == shared.StateReady || m.State == shared.StateUnmanaged
 }
 
 func (m *ModuleStatus) GetManifestCR() *unstructured.Unstructured {
@@ -345,14 +587,21 @@ func (kyma *Kyma) ContainsCondition(conditionType KymaConditionType, conditionSt
 	return false
 }
 
+// DetermineState aggregates the states of the critical modules and the conditions to the state of the Kyma.
+// Optional modules and the informational OptionalModulesReady, SpecConflict and SKRWebhookCertificate conditions
+// are not taken into account.
 func (kyma *Kyma) DetermineState() shared.State {
 	status := &kyma.Status
 	stateMap := map[shared.State]bool{}
-	for _, moduleStatus := range status.Modules {
+	for i := range status.Modules {
+		moduleStatus := &status.Modules[i]
+		if moduleStatus.IsOptional() {
+			continue
+		}
 		if moduleStatus.State == shared.StateError {
 			stateMap[shared.StateError] = true
 		}
-		if moduleStatus.State == shared.StateWarning {
+		if moduleStatus.State == shared.StateWarning || moduleStatus.State == shared.StateReadoptionPending {
 			stateMap[shared.StateWarning] = true
 		}
 		if moduleStatus.State == shared.StateProcessing {
@@ -370,6 +619,11 @@ func (kyma *Kyma) DetermineState() shared.State {
 	}
 
 	for _, condition := range status.Conditions {
+		if condition.Type == string(ConditionTypeOptionalModules) ||
+			condition.Type == string(ConditionTypeSpecConflict) ||
+			condition.Type == string(ConditionTypeSKRWebhookCertificate) {
+			continue
+		}
 		if condition.Status != apimetav1.ConditionTrue {
 			return shared.StateProcessing
 		}
@@ -378,16 +632,123 @@ func (kyma *Kyma) DetermineState() shared.State {
 	return shared.StateReady
 }
 
+// AllModulesReady reports whether all critical modules are ready.
 func (kyma *Kyma) AllModulesReady() bool {
 	for i := range kyma.Status.Modules {
 		moduleStatus := &kyma.Status.Modules[i]
-		if moduleStatus.State != shared.StateReady && moduleStatus.State != shared.StateUnmanaged {
+		if !moduleStatus.IsOptional() && !moduleStatus.IsReady() {
+			return false
+		}
+	}
+	return true
+}
+
+// AllOptionalModulesReady reports whether all optional modules are ready.
+func (kyma *Kyma) AllOptionalModulesReady() bool {
+	for i := range kyma.Status.Modules {
+		moduleStatus := &kyma.Status.Modules[i]
+		if moduleStatus.IsOptional() && !moduleStatus.IsReady() {
 			return false
 		}
 	}
 	return true
 }
 
+func (kyma *Kyma) HasOptionalModules() bool {
+	for i := range kyma.Status.Modules {
+		if kyma.Status.Modules[i].IsOptional() {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateModulesSummary recalculates the ModulesSummary from the module statuses.
+func (kyma *Kyma) UpdateModulesSummary() {
+	if len(kyma.Status.Modules) == 0 {
+		kyma.Status.ModulesSummary = nil
+		return
+	}
+
+	summary := &ModulesSummary{States: map[shared.State]int{}}
+	for i := range kyma.Status.Modules {
+		moduleStatus := &kyma.Status.Modules[i]
+		summary.States[moduleStatus.State]++
+		if !moduleStatus.IsReady() {
+			summary.DegradedModules = append(summary.DegradedModules, moduleStatus.Name)
+		}
+	}
+	slices.Sort(summary.DegradedModules)
+	kyma.Status.ModulesSummary = summary
+}
+
+// UpdateMandatoryModules recalculates the MandatoryModules from the Manifests of the mandatory modules of the Kyma.
+func (kyma *Kyma) UpdateMandatoryModules(manifests []Manifest) {
+	if len(manifests) == 0 {
+		kyma.Status.MandatoryModules = nil
+		return
+	}
+
+	mandatoryModules := make([]MandatoryModuleStatus, 0, len(manifests))
+	for i := range manifests {
+		manifest := &manifests[i]
+		mandatoryModules = append(mandatoryModules, MandatoryModuleStatus{
+			Name:    manifest.GetLabels()[shared.ModuleName],
+			Version: manifest.Spec.Version,
+			State:   manifest.Status.State,
+			Manifest: &TrackingObject{
+				PartialMeta: PartialMeta{
+					Name:       manifest.GetName(),
+					Namespace:  manifest.GetNamespace(),
+					Generation: manifest.GetGeneration(),
+				},
+				TypeMeta: apimetav1.TypeMeta{Kind: string(shared.ManifestKind), APIVersion: GroupVersion.String()},
+			},
+		})
+	}
+	slices.SortFunc(mandatoryModules, func(first, second MandatoryModuleStatus) int {
+		return strings.Compare(first.Name, second.Name)
+	})
+	kyma.Status.MandatoryModules = mandatoryModules
+}
+
+// GetModuleDeletionPolicy resolves the DeletionPolicy of the module, falling back to the DeletionPolicy of the Kyma
+// and finally to Cascade.
+func (kyma *Kyma) GetModuleDeletionPolicy(moduleName string) DeletionPolicy {
+	for _, module := range kyma.Spec.Modules {
+		if module.Name == moduleName && module.DeletionPolicy != "" {
+			return module.DeletionPolicy
+		}
+	}
+	if kyma.Spec.DeletionPolicy != "" {
+		return kyma.Spec.DeletionPolicy
+	}
+	return DeletionPolicyCascade
+}
+
+// IsChannelControlledByProvisioner reports whether the channel can only be changed in the control plane Kyma.
+func (kyma *Kyma) IsChannelControlledByProvisioner() bool {
+	return kyma.Spec.ChannelControlledBy == SpecOwnerProvisioner
+}
+
+// UpdateSpecConflictCondition sets the SpecConflict condition to True with the given conflicts as message.
+// Without conflicts, it is set to False if it exists, so the condition is only added once a conflict occurred.
+func (kyma *Kyma) UpdateSpecConflictCondition(conflicts []string) {
+	if len(conflicts) == 0 {
+		if kyma.ContainsCondition(ConditionTypeSpecConflict) {
+			kyma.UpdateCondition(ConditionTypeSpecConflict, apimetav1.ConditionFalse)
+		}
+		return
+	}
+	meta.SetStatusCondition(&kyma.Status.Conditions, apimetav1.Condition{
+		Type:               string(ConditionTypeSpecConflict),
+		Status:             apimetav1.ConditionTrue,
+		Reason:             string(ConditionReasonSpecConflict),
+		Message:            strings.Join(conflicts, "; "),
+		ObservedGeneration: kyma.GetGeneration(),
+	})
+}
+
 func (kyma *Kyma) HasSyncLabelEnabled() bool {
 	if sync, found := kyma.Labels[shared.SyncLabel]; found {
 		return shared.IsEnabled(sync)
@@ -411,6 +772,12 @@ func (kyma *Kyma) IsBeta() bool {
 	return found && shared.IsEnabled(beta)
 }
 
+// HasGatedUpgrades returns true if the modules of the Kyma are only upgraded to versions with an UpgradeApproval.
+func (kyma *Kyma) HasGatedUpgrades() bool {
+	gated, found := kyma.Labels[shared.UpgradeApprovalLabel]
+	return found && shared.IsEnabled(gated)
+}
+
 func (kyma *Kyma) EnsureLabelsAndFinalizers() bool {
 	if controllerutil.ContainsFinalizer(kyma, "foregroundDeletion") {
 		return false
@@ -440,6 +807,16 @@ func (kyma *Kyma) GetNamespacedName() types.NamespacedName {
 	}
 }
 
+// GetTenantNamespace returns the namespace of the Kyma in a shared SKR cluster, or an empty string if the Kyma
+// is not a tenant and owns the SKR cluster.
+func (kyma *Kyma) GetTenantNamespace() string {
+	return kyma.Labels[shared.TenantNamespaceLabel]
+}
+
+func (kyma *Kyma) GetRuntimeID() string {
+	return kyma.Labels[shared.RuntimeIDLabel]
+}
+
 func (kyma *Kyma) GetGlobalAccount() string {
 	return kyma.Labels[shared.GlobalAccountIDLabel]
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
@@ -206,3 +207,231 @@ func Test_GetPlan(t *testing.T) {
 		})
 	}
 }
+
+func Test_DetermineState(t *testing.T) {
+	tests := []struct {
+		name       string
+		modules    []v1beta2.ModuleStatus
+		conditions []apimetav1.Condition
+		expected   shared.State
+	}{
+		{
+			name: "Test DetermineState() with critical module in warning",
+			modules: []v1beta2.ModuleStatus{
+				{Name: "module1", State: shared.StateReady},
+				{Name: "module2", State: shared.StateWarning, Criticality: v1beta2.ModuleCriticalityCritical},
+			},
+			expected: shared.StateWarning,
+		},
+		{
+			name: "Test DetermineState() with module waiting for re-adoption",
+			modules: []v1beta2.ModuleStatus{
+				{Name: "module1", State: shared.StateReady},
+				{Name: "module2", State: shared.StateReadoptionPending},
+			},
+			expected: shared.StateWarning,
+		},
+		{
+			name: "Test DetermineState() with module without criticality in error",
+			modules: []v1beta2.ModuleStatus{
+				{Name: "module1", State: shared.StateError},
+			},
+			expected: shared.StateError,
+		},
+		{
+			name: "Test DetermineState() with optional module in error",
+			modules: []v1beta2.ModuleStatus{
+				{Name: "module1", State: shared.StateReady},
+				{Name: "module2", State: shared.StateError, Criticality: v1beta2.ModuleCriticalityOptional},
+			},
+			conditions: []apimetav1.Condition{
+				{Type: string(v1beta2.ConditionTypeModules), Status: apimetav1.ConditionTrue},
+				{Type: string(v1beta2.ConditionTypeOptionalModules), Status: apimetav1.ConditionFalse},
+			},
+			expected: shared.StateReady,
+		},
+		{
+			name: "Test DetermineState() with condition not true",
+			modules: []v1beta2.ModuleStatus{
+				{Name: "module1", State: shared.StateReady},
+			},
+			conditions: []apimetav1.Condition{
+				{Type: string(v1beta2.ConditionTypeModuleCatalog), Status: apimetav1.ConditionFalse},
+			},
+			expected: shared.StateProcessing,
+		},
+		{
+			name: "Test DetermineState() with SKR webhook certificate rotating",
+			modules: []v1beta2.ModuleStatus{
+				{Name: "module1", State: shared.StateReady},
+			},
+			conditions: []apimetav1.Condition{
+				{Type: string(v1beta2.ConditionTypeModules), Status: apimetav1.ConditionTrue},
+				{Type: string(v1beta2.ConditionTypeSKRWebhook), Status: apimetav1.ConditionTrue},
+				{Type: string(v1beta2.ConditionTypeSKRWebhookCertificate), Status: apimetav1.ConditionFalse},
+			},
+			expected: shared.StateReady,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			kyma := &v1beta2.Kyma{
+				Status: v1beta2.KymaStatus{
+					Modules:    testCase.modules,
+					Conditions: testCase.conditions,
+				},
+			}
+
+			assert.Equal(t, testCase.expected, kyma.DetermineState())
+		})
+	}
+}
+
+func Test_AllModulesReady_IgnoresOptionalModules(t *testing.T) {
+	kyma := &v1beta2.Kyma{
+		Status: v1beta2.KymaStatus{
+			Modules: []v1beta2.ModuleStatus{
+				{Name: "module1", State: shared.StateReady},
+				{Name: "module2", State: shared.StateUnmanaged},
+				{Name: "module3", State: shared.StateWarning, Criticality: v1beta2.ModuleCriticalityOptional},
+			},
+		},
+	}
+
+	assert.True(t, kyma.AllModulesReady())
+	assert.True(t, kyma.HasOptionalModules())
+	assert.False(t, kyma.AllOptionalModulesReady())
+}
+
+func Test_UpdateModulesSummary(t *testing.T) {
+	kyma := &v1beta2.Kyma{
+		Status: v1beta2.KymaStatus{
+			Modules: []v1beta2.ModuleStatus{
+				{Name: "module3", State: shared.StateError},
+				{Name: "module1", State: shared.StateReady},
+				{Name: "module2", State: shared.StateWarning, Criticality: v1beta2.ModuleCriticalityOptional},
+				{Name: "module4", State: shared.StateReady},
+			},
+		},
+	}
+
+	kyma.UpdateModulesSummary()
+
+	assert.Equal(t, map[shared.State]int{
+		shared.StateReady:   2,
+		shared.StateError:   1,
+		shared.StateWarning: 1,
+	}, kyma.Status.ModulesSummary.States)
+	assert.Equal(t, []string{"module2", "module3"}, kyma.Status.ModulesSummary.DegradedModules)
+
+	kyma.Status.Modules = nil
+	kyma.UpdateModulesSummary()
+
+	assert.Nil(t, kyma.Status.ModulesSummary)
+}
+
+func Test_UpdateMandatoryModules(t *testing.T) {
+	kyma := &v1beta2.Kyma{}
+	manifests := []v1beta2.Manifest{
+		{
+			ObjectMeta: apimetav1.ObjectMeta{
+				Name:       "kyma-sample-warden",
+				Namespace:  "kcp-system",
+				Generation: 2,
+				Labels:     map[string]string{shared.ModuleName: "warden"},
+			},
+			Spec:   v1beta2.ManifestSpec{Version: "1.2.0"},
+			Status: shared.Status{State: shared.StateProcessing},
+		},
+		{
+			ObjectMeta: apimetav1.ObjectMeta{
+				Name:       "kyma-sample-btp-operator",
+				Namespace:  "kcp-system",
+				Generation: 1,
+				Labels:     map[string]string{shared.ModuleName: "btp-operator"},
+			},
+			Spec:   v1beta2.ManifestSpec{Version: "0.9.1"},
+			Status: shared.Status{State: shared.StateReady},
+		},
+	}
+
+	kyma.UpdateMandatoryModules(manifests)
+
+	require.Len(t, kyma.Status.MandatoryModules, 2)
+	assert.Equal(t, "btp-operator", kyma.Status.MandatoryModules[0].Name)
+	assert.Equal(t, "0.9.1", kyma.Status.MandatoryModules[0].Version)
+	assert.Equal(t, shared.StateReady, kyma.Status.MandatoryModules[0].State)
+	assert.Equal(t, "warden", kyma.Status.MandatoryModules[1].Name)
+	assert.Equal(t, shared.StateProcessing, kyma.Status.MandatoryModules[1].State)
+	assert.Equal(t, "kyma-sample-warden", kyma.Status.MandatoryModules[1].Manifest.Name)
+	assert.Equal(t, int64(2), kyma.Status.MandatoryModules[1].Manifest.Generation)
+	assert.Equal(t, string(shared.ManifestKind), kyma.Status.MandatoryModules[1].Manifest.Kind)
+
+	kyma.UpdateMandatoryModules(nil)
+
+	assert.Nil(t, kyma.Status.MandatoryModules)
+}
+
+func Test_GetModuleDeletionPolicy(t *testing.T) {
+	tests := []struct {
+		name               string
+		kymaDeletionPolicy v1beta2.DeletionPolicy
+		modules            []v1beta2.Module
+		expected           v1beta2.DeletionPolicy
+	}{
+		{
+			name:     "defaults to Cascade",
+			modules:  []v1beta2.Module{{Name: "module1"}},
+			expected: v1beta2.DeletionPolicyCascade,
+		},
+		{
+			name:               "falls back to the Kyma deletion policy",
+			kymaDeletionPolicy: v1beta2.DeletionPolicyOrphan,
+			modules:            []v1beta2.Module{{Name: "module1"}},
+			expected:           v1beta2.DeletionPolicyOrphan,
+		},
+		{
+			name:               "module deletion policy overrides the Kyma deletion policy",
+			kymaDeletionPolicy: v1beta2.DeletionPolicyOrphan,
+			modules: []v1beta2.Module{
+				{Name: "module1", DeletionPolicy: v1beta2.DeletionPolicyBlockIfCustomResourcesExist},
+			},
+			expected: v1beta2.DeletionPolicyBlockIfCustomResourcesExist,
+		},
+		{
+			name:               "module not in spec uses the Kyma deletion policy",
+			kymaDeletionPolicy: v1beta2.DeletionPolicyBlockIfCustomResourcesExist,
+			modules:            []v1beta2.Module{{Name: "module2", DeletionPolicy: v1beta2.DeletionPolicyOrphan}},
+			expected:           v1beta2.DeletionPolicyBlockIfCustomResourcesExist,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			kyma := &v1beta2.Kyma{
+				Spec: v1beta2.KymaSpec{
+					DeletionPolicy: testCase.kymaDeletionPolicy,
+					Modules:        testCase.modules,
+				},
+			}
+
+			assert.Equal(t, testCase.expected, kyma.GetModuleDeletionPolicy("module1"))
+		})
+	}
+}
+
+func Test_UpdateSpecConflictCondition(t *testing.T) {
+	kyma := &v1beta2.Kyma{}
+
+	kyma.UpdateSpecConflictCondition(nil)
+	assert.False(t, kyma.ContainsCondition(v1beta2.ConditionTypeSpecConflict))
+
+	kyma.UpdateSpecConflictCondition([]string{"conflict1", "conflict2"})
+	assert.True(t, kyma.ContainsCondition(v1beta2.ConditionTypeSpecConflict, apimetav1.ConditionTrue))
+	assert.Equal(t, "conflict1; conflict2", kyma.Status.Conditions[0].Message)
+	assert.Equal(t, shared.StateReady, kyma.DetermineState())
+
+	kyma.UpdateSpecConflictCondition(nil)
+	assert.True(t, kyma.ContainsCondition(v1beta2.ConditionTypeSpecConflict, apimetav1.ConditionFalse))
+	assert.Equal(t, v1beta2.ConditionMessageNoSpecConflict, kyma.Status.Conditions[0].Message)
+	assert.Equal(t, shared.StateReady, kyma.DetermineState())
+}
@@ -33,6 +33,7 @@ const (
 	ConfigLayer      LayerName = "config"
 	DefaultCRLayer   LayerName = "default-cr"
 	RawManifestLayer LayerName = "raw-manifest"
+	HooksLayer       LayerName = "hooks"
 )
 
 var ErrLabelNotFound = errors.New("label is not found")
@@ -71,6 +72,18 @@ type ManifestSpec struct {
 	// +nullable
 	// Resource specifies a resource to be watched for state updates
 	Resource *unstructured.Unstructured `json:"resource,omitempty"`
+
+	// ResourceStatusMapping declares how the status of the Resource is copied into the status.
+	// +optional
+	ResourceStatusMapping *ModuleCRStatusMapping `json:"resourceStatusMapping,omitempty"`
+
+	// Hooks specifies the layer with the Jobs which are run before and after an upgrade.
+	// +optional
+	Hooks *InstallInfo `json:"hooks,omitempty"`
+
+	// UpgradeStrategy declares how the resources are replaced when the version changes.
+	// +optional
+	UpgradeStrategy *UpgradeStrategy `json:"upgradeStrategy,omitempty"`
 }
 
 // ImageSpec defines OCI Image specifications.
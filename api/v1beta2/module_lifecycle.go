@@ -0,0 +1,116 @@
+package v1beta2
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+// ModuleConditionType is a programmatic identifier indicating the type of a condition of a ModuleStatus.
+type ModuleConditionType string
+
+const (
+	// ModuleConditionTypeReady is True while the Module is Ready.
+	ModuleConditionTypeReady ModuleConditionType = "Ready"
+	// ModuleConditionTypeInstalled is True once the Module became Ready for the first time.
+	ModuleConditionTypeInstalled ModuleConditionType = "Installed"
+	// ModuleConditionTypeUpgrading is True from a change of the Module Version until the Module is Ready again.
+	// It is only set once the Module was upgraded.
+	ModuleConditionTypeUpgrading ModuleConditionType = "Upgrading"
+
+	ModuleConditionReasonInstalled        = "Installed"
+	ModuleConditionReasonInstalling       = "Installing"
+	ModuleConditionReasonUpgrading        = "Upgrading"
+	ModuleConditionReasonUpgradeCompleted = "UpgradeCompleted"
+	moduleConditionReasonUnknownState     = "Unknown"
+)
+
+// UpdateLifecycle carries the lifecycle timestamps and conditions over from the previous ModuleStatus, which is nil
+// for a newly added Module, and updates them based on the State and Version transition to this ModuleStatus.
+// If the Module became Ready after its installation or upgrade, it returns the time it took.
+func (m *ModuleStatus) UpdateLifecycle(previous *ModuleStatus, now time.Time) (time.Duration, bool) {
+	nowTime := apimetav1.NewTime(now)
+	lifecycle := &ModuleLifecycle{}
+	if previous != nil && previous.Lifecycle != nil {
+		lifecycle = previous.Lifecycle.DeepCopy()
+	}
+	if previous != nil && m.Conditions == nil {
+		m.Conditions = append([]apimetav1.Condition(nil), previous.Conditions...)
+	}
+	m.Lifecycle = lifecycle
+
+	if lifecycle.InstallStartedAt == nil {
+		lifecycle.InstallStartedAt = &nowTime
+	}
+	if previous == nil || previous.State != m.State || lifecycle.LastTransitionAt == nil {
+		lifecycle.LastTransitionAt = &nowTime
+	}
+	if previous != nil && previous.Version != "" && m.Version != "" && previous.Version != m.Version {
+		lifecycle.LastUpgradeFrom = previous.Version
+		lifecycle.LastUpgradeTo = m.Version
+		lifecycle.LastUpgradeAt = &nowTime
+	}
+
+	var timeToReady time.Duration
+	becameReady := false
+	if m.State == shared.StateReady && (previous == nil || previous.State != shared.StateReady) {
+		switch {
+		case lifecycle.isUpgrading():
+			timeToReady, becameReady = now.Sub(lifecycle.LastUpgradeAt.Time), true
+		case lifecycle.LastReadyAt == nil:
+			timeToReady, becameReady = now.Sub(lifecycle.InstallStartedAt.Time), true
+		}
+		lifecycle.LastReadyAt = &nowTime
+	}
+
+	m.updateConditions()
+	return timeToReady, becameReady
+}
+
+func (l *ModuleLifecycle) isUpgrading() bool {
+	return l.LastUpgradeAt != nil && (l.LastReadyAt == nil || l.LastReadyAt.Before(l.LastUpgradeAt))
+}
+
+func (m *ModuleStatus) updateConditions() {
+	readyStatus, readyReason := apimetav1.ConditionFalse, string(m.State)
+	if m.State == shared.StateReady {
+		readyStatus = apimetav1.ConditionTrue
+	}
+	if readyReason == "" {
+		readyReason = moduleConditionReasonUnknownState
+	}
+	m.setCondition(ModuleConditionTypeReady, readyStatus, readyReason, m.Message)
+
+	if m.Lifecycle.LastReadyAt != nil {
+		m.setCondition(ModuleConditionTypeInstalled, apimetav1.ConditionTrue, ModuleConditionReasonInstalled,
+			"module became ready")
+	} else {
+		m.setCondition(ModuleConditionTypeInstalled, apimetav1.ConditionFalse, ModuleConditionReasonInstalling,
+			"module did not become ready yet")
+	}
+
+	if m.Lifecycle.LastUpgradeAt == nil {
+		return
+	}
+	if m.Lifecycle.isUpgrading() {
+		m.setCondition(ModuleConditionTypeUpgrading, apimetav1.ConditionTrue, ModuleConditionReasonUpgrading,
+			"module is upgraded from "+m.Lifecycle.LastUpgradeFrom+" to "+m.Lifecycle.LastUpgradeTo)
+	} else {
+		m.setCondition(ModuleConditionTypeUpgrading, apimetav1.ConditionFalse, ModuleConditionReasonUpgradeCompleted,
+			"module was upgraded from "+m.Lifecycle.LastUpgradeFrom+" to "+m.Lifecycle.LastUpgradeTo)
+	}
+}
+
+func (m *ModuleStatus) setCondition(conditionType ModuleConditionType, status apimetav1.ConditionStatus,
+	reason, message string,
+) {
+	meta.SetStatusCondition(&m.Conditions, apimetav1.Condition{
+		Type:    string(conditionType),
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
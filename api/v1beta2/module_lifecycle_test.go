@@ -0,0 +1,97 @@
+package v1beta2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+func Test_UpdateLifecycle_NewModule(t *testing.T) {
+	now := time.Now()
+	moduleStatus := &v1beta2.ModuleStatus{Name: "module1", State: shared.StateProcessing, Version: "1.0.0"}
+
+	_, becameReady := moduleStatus.UpdateLifecycle(nil, now)
+
+	assert.False(t, becameReady)
+	require.NotNil(t, moduleStatus.Lifecycle)
+	assert.True(t, moduleStatus.Lifecycle.InstallStartedAt.Time.Equal(now))
+	assert.True(t, moduleStatus.Lifecycle.LastTransitionAt.Time.Equal(now))
+	assert.Nil(t, moduleStatus.Lifecycle.LastReadyAt)
+	assertModuleCondition(t, moduleStatus, v1beta2.ModuleConditionTypeReady, apimetav1.ConditionFalse, "Processing")
+	assertModuleCondition(t, moduleStatus, v1beta2.ModuleConditionTypeInstalled, apimetav1.ConditionFalse,
+		v1beta2.ModuleConditionReasonInstalling)
+	assert.Nil(t, meta.FindStatusCondition(moduleStatus.Conditions, string(v1beta2.ModuleConditionTypeUpgrading)))
+}
+
+func Test_UpdateLifecycle_InstallationBecomesReady(t *testing.T) {
+	start := time.Now()
+	previous := &v1beta2.ModuleStatus{Name: "module1", State: shared.StateProcessing, Version: "1.0.0"}
+	previous.UpdateLifecycle(nil, start)
+	moduleStatus := &v1beta2.ModuleStatus{Name: "module1", State: shared.StateReady, Version: "1.0.0"}
+
+	timeToReady, becameReady := moduleStatus.UpdateLifecycle(previous, start.Add(time.Minute))
+
+	assert.True(t, becameReady)
+	assert.Equal(t, time.Minute, timeToReady)
+	assert.True(t, moduleStatus.Lifecycle.InstallStartedAt.Time.Equal(start))
+	assert.True(t, moduleStatus.Lifecycle.LastReadyAt.Time.Equal(start.Add(time.Minute)))
+	assert.True(t, moduleStatus.Lifecycle.LastTransitionAt.Time.Equal(start.Add(time.Minute)))
+	assertModuleCondition(t, moduleStatus, v1beta2.ModuleConditionTypeReady, apimetav1.ConditionTrue, "Ready")
+	assertModuleCondition(t, moduleStatus, v1beta2.ModuleConditionTypeInstalled, apimetav1.ConditionTrue,
+		v1beta2.ModuleConditionReasonInstalled)
+}
+
+func Test_UpdateLifecycle_RecoveryFromWarningIsNotObserved(t *testing.T) {
+	start := time.Now()
+	previous := &v1beta2.ModuleStatus{Name: "module1", State: shared.StateReady, Version: "1.0.0"}
+	previous.UpdateLifecycle(nil, start)
+	warning := &v1beta2.ModuleStatus{Name: "module1", State: shared.StateWarning, Version: "1.0.0"}
+	warning.UpdateLifecycle(previous, start.Add(time.Minute))
+	moduleStatus := &v1beta2.ModuleStatus{Name: "module1", State: shared.StateReady, Version: "1.0.0"}
+
+	_, becameReady := moduleStatus.UpdateLifecycle(warning, start.Add(2*time.Minute))
+
+	assert.False(t, becameReady)
+	assert.True(t, moduleStatus.Lifecycle.LastReadyAt.Time.Equal(start.Add(2*time.Minute)))
+}
+
+func Test_UpdateLifecycle_Upgrade(t *testing.T) {
+	start := time.Now()
+	previous := &v1beta2.ModuleStatus{Name: "module1", State: shared.StateReady, Version: "1.0.0"}
+	previous.UpdateLifecycle(nil, start)
+	upgrading := &v1beta2.ModuleStatus{Name: "module1", State: shared.StateProcessing, Version: "1.1.0"}
+
+	_, becameReady := upgrading.UpdateLifecycle(previous, start.Add(time.Hour))
+
+	assert.False(t, becameReady)
+	assert.Equal(t, "1.0.0", upgrading.Lifecycle.LastUpgradeFrom)
+	assert.Equal(t, "1.1.0", upgrading.Lifecycle.LastUpgradeTo)
+	assert.True(t, upgrading.Lifecycle.LastUpgradeAt.Time.Equal(start.Add(time.Hour)))
+	assertModuleCondition(t, upgrading, v1beta2.ModuleConditionTypeUpgrading, apimetav1.ConditionTrue,
+		v1beta2.ModuleConditionReasonUpgrading)
+
+	moduleStatus := &v1beta2.ModuleStatus{Name: "module1", State: shared.StateReady, Version: "1.1.0"}
+	timeToReady, becameReady := moduleStatus.UpdateLifecycle(upgrading, start.Add(time.Hour+30*time.Second))
+
+	assert.True(t, becameReady)
+	assert.Equal(t, 30*time.Second, timeToReady)
+	assertModuleCondition(t, moduleStatus, v1beta2.ModuleConditionTypeUpgrading, apimetav1.ConditionFalse,
+		v1beta2.ModuleConditionReasonUpgradeCompleted)
+}
+
+func assertModuleCondition(t *testing.T, moduleStatus *v1beta2.ModuleStatus,
+	conditionType v1beta2.ModuleConditionType, status apimetav1.ConditionStatus, reason string,
+) {
+	t.Helper()
+	condition := meta.FindStatusCondition(moduleStatus.Conditions, string(conditionType))
+	require.NotNil(t, condition)
+	assert.Equal(t, status, condition.Status)
+	assert.Equal(t, reason, condition.Reason)
+}
@@ -41,6 +41,52 @@ type ModuleReleaseMetaSpec struct {
 	// +optional
 	// +kubebuilder:default:=false
 	Internal bool `json:"internal"`
+
+	// Mandatory configures the rollout of a mandatory module, which is installed on all Kymas unless excluded.
+	// It only applies to modules whose ModuleTemplates are marked as mandatory. Without it, the highest version
+	// of the mandatory ModuleTemplates is installed on all Kymas.
+	// +optional
+	Mandatory *MandatoryModuleRollout `json:"mandatory,omitempty"`
+}
+
+// MandatoryModuleRollout determines which Kymas a mandatory module is installed on, and in which version.
+type MandatoryModuleRollout struct {
+	// Exclusions select the Kymas by their labels which the mandatory module is not installed on,
+	// e.g. the Kymas of trial plans or of specific regions. The module is removed from Kymas which become excluded.
+	// +optional
+	Exclusions []apimetav1.LabelSelector `json:"exclusions,omitempty"`
+
+	// Targets assign a channel or a version to the Kymas selected by their labels. The first matching target applies.
+	// +optional
+	Targets []MandatoryModuleTarget `json:"targets,omitempty"`
+
+	// Channel is the channel whose version is installed on the Kymas which are not selected by a target.
+	// Defaults to the channel of the Kyma.
+	// +optional
+	// +kubebuilder:validation:Pattern:=^[a-z]+$
+	// +kubebuilder:validation:MaxLength:=32
+	// +kubebuilder:validation:MinLength:=3
+	Channel string `json:"channel,omitempty"`
+}
+
+// MandatoryModuleTarget assigns a channel or a version of a mandatory module to the Kymas selected by the Selector.
+// If both are set, the Version takes precedence.
+type MandatoryModuleTarget struct {
+	// Selector selects the Kymas by their labels.
+	Selector apimetav1.LabelSelector `json:"selector"`
+
+	// Channel is the channel whose version is installed on the selected Kymas.
+	// +optional
+	// +kubebuilder:validation:Pattern:=^[a-z]+$
+	// +kubebuilder:validation:MaxLength:=32
+	// +kubebuilder:validation:MinLength:=3
+	Channel string `json:"channel,omitempty"`
+
+	// Version is the version installed on the selected Kymas, e.g. an older version on regulated landscapes.
+	// +optional
+	// +kubebuilder:validation:Pattern:=`^((0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[a-zA-Z-][0-9a-zA-Z-]*)?)?$`
+	// +kubebuilder:validation:MaxLength:=32
+	Version string `json:"version,omitempty"`
 }
 
 // +kubebuilder:object:root=true
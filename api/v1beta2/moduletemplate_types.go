@@ -123,6 +123,101 @@ type ModuleTemplateSpec struct {
 	// RequiresDowntime indicates whether the module requires downtime in support of maintenance windows during module upgrades.
 	// +optional
 	RequiresDowntime bool `json:"requiresDowntime,omitempty"`
+
+	// Criticality declares whether the state of the module contributes to the state of the Kyma.
+	// It defaults to critical and can be overridden per Kyma in the module spec.
+	// +optional
+	Criticality ModuleCriticality `json:"criticality,omitempty"`
+
+	// ModuleCRStatus declares how the status of the module CR is surfaced in the status of the Kyma.
+	// By default, the state is read from .status.state of the module CR.
+	// +optional
+	ModuleCRStatus *ModuleCRStatusMapping `json:"moduleCRStatus,omitempty"`
+
+	// UpgradeStrategy declares how the resources of the module are replaced when the module is upgraded.
+	// By default, they are upgraded in place.
+	// +optional
+	UpgradeStrategy *UpgradeStrategy `json:"upgradeStrategy,omitempty"`
+}
+
+// UpgradeStrategyType is the way the resources of a module are replaced when the module is upgraded.
+// +kubebuilder:validation:Enum=InPlace;BlueGreen
+type UpgradeStrategyType string
+
+const (
+	// UpgradeStrategyInPlace updates the resources of the module and removes the resources of the previous version
+	// right away.
+	UpgradeStrategyInPlace UpgradeStrategyType = "InPlace"
+	// UpgradeStrategyBlueGreen installs the workloads of the new version alongside the previous ones, and only
+	// switches over to the new version and removes the previous one once the new version is Ready.
+	UpgradeStrategyBlueGreen UpgradeStrategyType = "BlueGreen"
+)
+
+// UpgradeStrategy declares how the resources of a module are replaced when the module is upgraded.
+type UpgradeStrategy struct {
+	// Type is the type of the upgrade strategy.
+	// +kubebuilder:default:=InPlace
+	Type UpgradeStrategyType `json:"type,omitempty"`
+
+	// Switch lists the resources which direct the traffic to a version of the module, such as the Service or the
+	// webhook configuration of the manager. During a BlueGreen upgrade, they keep the state of the previous version
+	// until the new version is Ready. Listed Services only select the pods of their version.
+	// +optional
+	Switch []SwitchResource `json:"switch,omitempty"`
+
+	// ReadyTimeout is the time the new version has to become Ready during a BlueGreen upgrade.
+	// Otherwise, the upgrade is aborted and the previous version is kept. Defaults to 10 minutes.
+	// +optional
+	ReadyTimeout *apimetav1.Duration `json:"readyTimeout,omitempty"`
+}
+
+// SwitchResource identifies a resource which directs the traffic to a version of the module.
+type SwitchResource struct {
+	apimetav1.GroupVersionKind `json:",inline"`
+
+	// Namespace is the namespace of the resource. It is optional.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+}
+
+// IsBlueGreen returns true if the strategy upgrades the module side by side.
+func (s *UpgradeStrategy) IsBlueGreen() bool {
+	return s != nil && s.Type == UpgradeStrategyBlueGreen
+}
+
+// ModuleCRStatusMapping declares how the status of the module CR is read and mapped to a Lifecycle Manager state.
+type ModuleCRStatusMapping struct {
+	// StatePath is the dot-separated path to the state in the module CR, status.state by default.
+	// +optional
+	StatePath string `json:"statePath,omitempty"`
+
+	// MessagePath is the dot-separated path to the message in the module CR. If empty, no message is read.
+	// +optional
+	MessagePath string `json:"messagePath,omitempty"`
+
+	// States map the values at the StatePath to Lifecycle Manager states. Values that are Lifecycle Manager states
+	// map to themselves, other values without a mapping are ignored.
+	// +optional
+	// +listType=map
+	// +listMapKey=value
+	States []ModuleCRStateMapping `json:"states,omitempty"`
+
+	// Conditions are the types of the conditions in .status.conditions of the module CR which are copied.
+	// +optional
+	// +listType=set
+	Conditions []string `json:"conditions,omitempty"`
+}
+
+type ModuleCRStateMapping struct {
+	// Value is the value at the StatePath of the module CR.
+	Value string `json:"value"`
+
+	// MappedState is the Lifecycle Manager state the value maps to.
+	// +kubebuilder:validation:Enum=Processing;Deleting;Ready;Error;Warning
+	MappedState shared.State `json:"mappedState"`
 }
 
 // Manager defines the structure for the manager field in ModuleTemplateSpec.
@@ -144,6 +239,10 @@ type ModuleInfo struct {
 	// Documentation is the link to the documentation of the module.
 	Documentation string `json:"documentation"`
 
+	// ReleaseNotes is the link to the release notes of the module version.
+	// +optional
+	ReleaseNotes string `json:"releaseNotes,omitempty"`
+
 	// Icons is a list of icons of the module.
 	// +optional
 	// +listType=map
@@ -255,6 +354,12 @@ func (m *ModuleTemplate) IsBeta() bool {
 	return false
 }
 
+// IsNamespacedModule reports whether the installation of the module is confined to a namespace, so that it can be
+// enabled by several tenants of one SKR cluster.
+func (m *ModuleTemplate) IsNamespacedModule() bool {
+	return shared.IsEnabled(m.Labels[shared.NamespacedModuleLabel])
+}
+
 func (m *ModuleTemplate) IsMandatory() bool {
 	return m.Spec.Mandatory
 }
@@ -0,0 +1,90 @@
+package v1beta2
+
+import (
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PurgeReport records the purge of the custom resources which remained in the runtime cluster of a deleted Kyma.
+// Unlike the purge status of the Kyma, it outlives the Kyma, so that orphaned resources can be followed up after the
+// runtime is deprovisioned. PurgeReports are removed once their retention period is over.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:singular=purgereport,path=purgereports
+// +kubebuilder:printcolumn:name="Kyma",type="string",JSONPath=".spec.kymaName"
+// +kubebuilder:printcolumn:name="Completed",type="date",JSONPath=".spec.completedAt"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+type PurgeReport struct {
+	apimetav1.TypeMeta   `json:",inline"`
+	apimetav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PurgeReportSpec `json:"spec,omitempty"`
+}
+
+// PurgeReportSpec holds the outcome of one purge run.
+type PurgeReportSpec struct {
+	// KymaName is the name of the purged Kyma.
+	KymaName string `json:"kymaName"`
+
+	// StartedAt is the time the purge started.
+	StartedAt apimetav1.Time `json:"startedAt"`
+
+	// CompletedAt is the time the purge completed. It is not set while the purge is in progress.
+	// +optional
+	CompletedAt *apimetav1.Time `json:"completedAt,omitempty"`
+
+	// Resources reports the purge per GroupVersionKind with remaining custom resources.
+	// +optional
+	// +listType=map
+	// +listMapKey=crd
+	Resources []PurgeReportResources `json:"resources,omitempty"`
+
+	// ForceFinalized lists the custom resources whose finalizers were removed. The list is capped,
+	// the number of all force-finalized custom resources is reported in Resources.
+	// +optional
+	ForceFinalized []PurgedObject `json:"forceFinalized,omitempty"`
+
+	// Errors lists the errors which occurred during the purge. The list is capped to the latest errors.
+	// +optional
+	Errors []string `json:"errors,omitempty"`
+}
+
+// PurgeReportResources reports the purge of the remaining custom resources of a GroupVersionKind.
+type PurgeReportResources struct {
+	// Group is the API group of the custom resources.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the storage version of the custom resources.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Kind is the kind of the custom resources.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	PurgedResources `json:",inline"`
+}
+
+// PurgedObject identifies a purged custom resource.
+type PurgedObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// +kubebuilder:object:root=true
+
+// PurgeReportList contains a list of PurgeReport.
+type PurgeReportList struct {
+	apimetav1.TypeMeta `json:",inline"`
+	apimetav1.ListMeta `json:"metadata,omitempty"`
+	Items              []PurgeReport `json:"items"`
+}
+
+//nolint:gochecknoinits // registers PurgeReport CRD on startup
+func init() {
+	SchemeBuilder.Register(&PurgeReport{}, &PurgeReportList{})
+}
@@ -0,0 +1,69 @@
+package v1beta2
+
+import (
+	"time"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpgradeApproval approves the upgrade of a module of a Kyma with gated upgrades to one exact version. The upgrade
+// is only approved until the approval expires, afterwards the approval is kept as a record of the decision.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:singular=upgradeapproval,path=upgradeapprovals
+// +kubebuilder:printcolumn:name="Kyma",type="string",JSONPath=".spec.kymaName"
+// +kubebuilder:printcolumn:name="Module",type="string",JSONPath=".spec.module"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version"
+// +kubebuilder:printcolumn:name="Expires",type="date",JSONPath=".spec.expiresAt"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:storageversion
+type UpgradeApproval struct {
+	apimetav1.TypeMeta   `json:",inline"`
+	apimetav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec UpgradeApprovalSpec `json:"spec,omitempty"`
+}
+
+// UpgradeApprovalSpec identifies the approved upgrade.
+type UpgradeApprovalSpec struct {
+	// KymaName is the name of the Kyma whose module is upgraded.
+	// +kubebuilder:validation:MinLength:=1
+	KymaName string `json:"kymaName"`
+
+	// Module is the name of the upgraded module.
+	// +kubebuilder:validation:MinLength:=1
+	Module string `json:"module"`
+
+	// Version is the version the module may be upgraded to. Upgrades to other versions are not approved.
+	// +kubebuilder:validation:MinLength:=1
+	Version string `json:"version"`
+
+	// ExpiresAt is the time after which the upgrade is no longer approved.
+	ExpiresAt apimetav1.Time `json:"expiresAt"`
+
+	// ApprovedBy identifies who approved the upgrade.
+	ApprovedBy string `json:"approvedBy"`
+
+	// Reason explains the approval.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// IsExpired returns true if the upgrade is no longer approved at the given time.
+func (a *UpgradeApproval) IsExpired(now time.Time) bool {
+	return !now.Before(a.Spec.ExpiresAt.Time)
+}
+
+// +kubebuilder:object:root=true
+
+// UpgradeApprovalList contains a list of UpgradeApproval.
+type UpgradeApprovalList struct {
+	apimetav1.TypeMeta `json:",inline"`
+	apimetav1.ListMeta `json:"metadata,omitempty"`
+	Items              []UpgradeApproval `json:"items"`
+}
+
+//nolint:gochecknoinits // registers UpgradeApproval CRD on startup
+func init() {
+	SchemeBuilder.Register(&UpgradeApproval{}, &UpgradeApprovalList{})
+}
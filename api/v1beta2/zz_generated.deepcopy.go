@@ -21,6 +21,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -55,6 +56,22 @@ func (in *CustomStateCheck) DeepCopy() *CustomStateCheck {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionBlockingResource) DeepCopyInto(out *DeletionBlockingResource) {
+	*out = *in
+	out.Resource = in.Resource
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionBlockingResource.
+func (in *DeletionBlockingResource) DeepCopy() *DeletionBlockingResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionBlockingResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayConfig) DeepCopyInto(out *GatewayConfig) {
 	*out = *in
@@ -203,6 +220,35 @@ func (in *KymaStatus) DeepCopyInto(out *KymaStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ModulesSummary != nil {
+		in, out := &in.ModulesSummary, &out.ModulesSummary
+		*out = new(ModulesSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionBlockedBy != nil {
+		in, out := &in.DeletionBlockedBy, &out.DeletionBlockedBy
+		*out = make([]DeletionBlockingResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Purge != nil {
+		in, out := &in.Purge, &out.Purge
+		*out = new(PurgeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MandatoryModules != nil {
+		in, out := &in.MandatoryModules, &out.MandatoryModules
+		*out = make([]MandatoryModuleStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PendingUpgrades != nil {
+		in, out := &in.PendingUpgrades, &out.PendingUpgrades
+		*out = make([]PendingUpgrade, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.LastOperation.DeepCopyInto(&out.LastOperation)
 }
 
@@ -232,6 +278,71 @@ func (in *Manager) DeepCopy() *Manager {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MandatoryModuleRollout) DeepCopyInto(out *MandatoryModuleRollout) {
+	*out = *in
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]MandatoryModuleTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MandatoryModuleRollout.
+func (in *MandatoryModuleRollout) DeepCopy() *MandatoryModuleRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(MandatoryModuleRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MandatoryModuleStatus) DeepCopyInto(out *MandatoryModuleStatus) {
+	*out = *in
+	if in.Manifest != nil {
+		in, out := &in.Manifest, &out.Manifest
+		*out = new(TrackingObject)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MandatoryModuleStatus.
+func (in *MandatoryModuleStatus) DeepCopy() *MandatoryModuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MandatoryModuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MandatoryModuleTarget) DeepCopyInto(out *MandatoryModuleTarget) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MandatoryModuleTarget.
+func (in *MandatoryModuleTarget) DeepCopy() *MandatoryModuleTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(MandatoryModuleTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Manifest) DeepCopyInto(out *Manifest) {
 	*out = *in
@@ -304,6 +415,21 @@ func (in *ManifestSpec) DeepCopyInto(out *ManifestSpec) {
 		in, out := &in.Resource, &out.Resource
 		*out = (*in).DeepCopy()
 	}
+	if in.ResourceStatusMapping != nil {
+		in, out := &in.ResourceStatusMapping, &out.ResourceStatusMapping
+		*out = new(ModuleCRStatusMapping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(InstallInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(UpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestSpec.
@@ -331,6 +457,46 @@ func (in *Module) DeepCopy() *Module {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleCRStateMapping) DeepCopyInto(out *ModuleCRStateMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleCRStateMapping.
+func (in *ModuleCRStateMapping) DeepCopy() *ModuleCRStateMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleCRStateMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleCRStatusMapping) DeepCopyInto(out *ModuleCRStatusMapping) {
+	*out = *in
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make([]ModuleCRStateMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleCRStatusMapping.
+func (in *ModuleCRStatusMapping) DeepCopy() *ModuleCRStatusMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleCRStatusMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModuleIcon) DeepCopyInto(out *ModuleIcon) {
 	*out = *in
@@ -366,6 +532,37 @@ func (in *ModuleInfo) DeepCopy() *ModuleInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleLifecycle) DeepCopyInto(out *ModuleLifecycle) {
+	*out = *in
+	if in.InstallStartedAt != nil {
+		in, out := &in.InstallStartedAt, &out.InstallStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastReadyAt != nil {
+		in, out := &in.LastReadyAt, &out.LastReadyAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTransitionAt != nil {
+		in, out := &in.LastTransitionAt, &out.LastTransitionAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUpgradeAt != nil {
+		in, out := &in.LastUpgradeAt, &out.LastUpgradeAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleLifecycle.
+func (in *ModuleLifecycle) DeepCopy() *ModuleLifecycle {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleLifecycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModuleReleaseMeta) DeepCopyInto(out *ModuleReleaseMeta) {
 	*out = *in
@@ -432,6 +629,11 @@ func (in *ModuleReleaseMetaSpec) DeepCopyInto(out *ModuleReleaseMetaSpec) {
 		*out = make([]ChannelVersionAssignment, len(*in))
 		copy(*out, *in)
 	}
+	if in.Mandatory != nil {
+		in, out := &in.Mandatory, &out.Mandatory
+		*out = new(MandatoryModuleRollout)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleReleaseMetaSpec.
@@ -462,6 +664,23 @@ func (in *ModuleStatus) DeepCopyInto(out *ModuleStatus) {
 		*out = new(TrackingObject)
 		**out = **in
 	}
+	if in.ResourceStatus != nil {
+		in, out := &in.ResourceStatus, &out.ResourceStatus
+		*out = new(shared.ModuleCRStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(ModuleLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleStatus.
@@ -571,6 +790,16 @@ func (in *ModuleTemplateSpec) DeepCopyInto(out *ModuleTemplateSpec) {
 		*out = new(Manager)
 		**out = **in
 	}
+	if in.ModuleCRStatus != nil {
+		in, out := &in.ModuleCRStatus, &out.ModuleCRStatus
+		*out = new(ModuleCRStatusMapping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(UpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleTemplateSpec.
@@ -583,6 +812,33 @@ func (in *ModuleTemplateSpec) DeepCopy() *ModuleTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModulesSummary) DeepCopyInto(out *ModulesSummary) {
+	*out = *in
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make(map[shared.State]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DegradedModules != nil {
+		in, out := &in.DegradedModules, &out.DegradedModules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModulesSummary.
+func (in *ModulesSummary) DeepCopy() *ModulesSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ModulesSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PartialMeta) DeepCopyInto(out *PartialMeta) {
 	*out = *in
@@ -598,6 +854,186 @@ func (in *PartialMeta) DeepCopy() *PartialMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingUpgrade) DeepCopyInto(out *PendingUpgrade) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingUpgrade.
+func (in *PendingUpgrade) DeepCopy() *PendingUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgeReport) DeepCopyInto(out *PurgeReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgeReport.
+func (in *PurgeReport) DeepCopy() *PurgeReport {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgeReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PurgeReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgeReportList) DeepCopyInto(out *PurgeReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PurgeReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgeReportList.
+func (in *PurgeReportList) DeepCopy() *PurgeReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgeReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PurgeReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgeReportResources) DeepCopyInto(out *PurgeReportResources) {
+	*out = *in
+	out.PurgedResources = in.PurgedResources
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgeReportResources.
+func (in *PurgeReportResources) DeepCopy() *PurgeReportResources {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgeReportResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgeReportSpec) DeepCopyInto(out *PurgeReportSpec) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]PurgeReportResources, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForceFinalized != nil {
+		in, out := &in.ForceFinalized, &out.ForceFinalized
+		*out = make([]PurgedObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgeReportSpec.
+func (in *PurgeReportSpec) DeepCopy() *PurgeReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgeReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgeStatus) DeepCopyInto(out *PurgeStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]PurgedResources, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgeStatus.
+func (in *PurgeStatus) DeepCopy() *PurgeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgedObject) DeepCopyInto(out *PurgedObject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgedObject.
+func (in *PurgedObject) DeepCopy() *PurgedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PurgedResources) DeepCopyInto(out *PurgedResources) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PurgedResources.
+func (in *PurgedResources) DeepCopy() *PurgedResources {
+	if in == nil {
+		return nil
+	}
+	out := new(PurgedResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resource) DeepCopyInto(out *Resource) {
 	*out = *in
@@ -628,6 +1064,22 @@ func (in *Service) DeepCopy() *Service {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchResource) DeepCopyInto(out *SwitchResource) {
+	*out = *in
+	out.GroupVersionKind = in.GroupVersionKind
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchResource.
+func (in *SwitchResource) DeepCopy() *SwitchResource {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrackingObject) DeepCopyInto(out *TrackingObject) {
 	*out = *in
@@ -645,6 +1097,105 @@ func (in *TrackingObject) DeepCopy() *TrackingObject {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeApproval) DeepCopyInto(out *UpgradeApproval) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeApproval.
+func (in *UpgradeApproval) DeepCopy() *UpgradeApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeApproval) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeApprovalList) DeepCopyInto(out *UpgradeApprovalList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UpgradeApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeApprovalList.
+func (in *UpgradeApprovalList) DeepCopy() *UpgradeApprovalList {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeApprovalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeApprovalList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeApprovalSpec) DeepCopyInto(out *UpgradeApprovalSpec) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeApprovalSpec.
+func (in *UpgradeApprovalSpec) DeepCopy() *UpgradeApprovalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeApprovalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStrategy) DeepCopyInto(out *UpgradeStrategy) {
+	*out = *in
+	if in.Switch != nil {
+		in, out := &in.Switch, &out.Switch
+		*out = make([]SwitchResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadyTimeout != nil {
+		in, out := &in.ReadyTimeout, &out.ReadyTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStrategy.
+func (in *UpgradeStrategy) DeepCopy() *UpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WatchableGVR) DeepCopyInto(out *WatchableGVR) {
 	*out = *in
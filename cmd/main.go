@@ -35,9 +35,14 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	machineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes"
 	k8sclientscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntime "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -47,6 +52,8 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal"
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
+	"github.com/kyma-project/lifecycle-manager/internal/catalogapi"
 	"github.com/kyma-project/lifecycle-manager/internal/controller/istiogatewaysecret"
 	"github.com/kyma-project/lifecycle-manager/internal/controller/kyma"
 	"github.com/kyma-project/lifecycle-manager/internal/controller/mandatorymodule"
@@ -60,7 +67,11 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/manifestclient"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/shard"
 	"github.com/kyma-project/lifecycle-manager/internal/remote"
+	"github.com/kyma-project/lifecycle-manager/internal/tracing"
+	"github.com/kyma-project/lifecycle-manager/internal/upgradeapprovals"
+	webhookv1beta2 "github.com/kyma-project/lifecycle-manager/internal/webhook/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	"github.com/kyma-project/lifecycle-manager/pkg/matcher"
 	"github.com/kyma-project/lifecycle-manager/pkg/queue"
@@ -81,11 +92,14 @@ const (
 	maintenanceWindowPolicyName        = "policy"
 	maintenanceWindowPoliciesDirectory = "/etc/maintenance-policy"
 	minMaintenanceWindowSize           = 20 * time.Minute
+
+	defaultLeaderElectionID = "893110f7.kyma-project.io"
+
+	storageVersionMigrationConfigMap = "lifecycle-manager-storage-version-migration"
 )
 
 var (
 	buildVersion                         = "not_provided" //nolint:gochecknoglobals // used to embed static binary version during release builds
-	errFailedToDropStoredVersions        = errors.New("failed to drop stored versions")
 	errFailedToScheduleMetricsCleanupJob = errors.New("failed to schedule metrics cleanup job")
 )
 
@@ -150,6 +164,29 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 	config := ctrl.GetConfigOrDie()
 	config.QPS = float32(flagVar.ClientQPS)
 	config.Burst = flagVar.ClientBurst
+	ctx := ctrl.SetupSignalHandler()
+
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Options{
+		Exporter:     flagVar.TracingExporter,
+		OTLPEndpoint: flagVar.TracingOTLPEndpoint,
+		OTLPInsecure: flagVar.TracingOTLPInsecure,
+		SampleRatio:  flagVar.TracingSampleRatio,
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	defer shutdownTracing(context.Background()) //nolint:errcheck // remaining spans are dropped on shutdown errors
+
+	leaderElectionID, leaderElectionNamespace := defaultLeaderElectionID, ""
+	clusterScopeCacheOptions := cacheOptions
+	shardID := 0
+	var shardLock resourcelock.Interface
+	if flagVar.IsSharded() {
+		shardID, shardLock = claimShard(ctx, config, scheme, flagVar, setupLog)
+		cacheOptions = internal.WithShardSelector(cacheOptions, shard.Selector(shardID))
+		leaderElectionID, leaderElectionNamespace = shard.LeaseName(shardID), flagVar.ShardLeaseNamespace
+	}
 
 	mgr, err := ctrl.NewManager(
 		config, ctrl.Options{
@@ -157,19 +194,31 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 			Metrics: metricsserver.Options{
 				BindAddress: flagVar.MetricsAddr,
 			},
-			HealthProbeBindAddress: flagVar.ProbeAddr,
-			LeaderElection:         flagVar.EnableLeaderElection,
-			LeaderElectionID:       "893110f7.kyma-project.io",
-			LeaseDuration:          &flagVar.LeaderElectionLeaseDuration,
-			RenewDeadline:          &flagVar.LeaderElectionRenewDeadline,
-			RetryPeriod:            &flagVar.LeaderElectionRetryPeriod,
-			Cache:                  cacheOptions,
+			HealthProbeBindAddress:  flagVar.ProbeAddr,
+			LeaderElection:          flagVar.EnableLeaderElection,
+			LeaderElectionID:        leaderElectionID,
+			LeaderElectionNamespace: leaderElectionNamespace,
+			// in sharded mode, the manager keeps the shard claimed with the identity of the replica
+			LeaderElectionResourceLockInterface: shardLock,
+			LeaseDuration:                       &flagVar.LeaderElectionLeaseDuration,
+			RenewDeadline:                       &flagVar.LeaderElectionRenewDeadline,
+			RetryPeriod:                         &flagVar.LeaderElectionRetryPeriod,
+			Cache:                               cacheOptions,
 		},
 	)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(bootstrapFailedExitCode)
 	}
+	// controllers of cluster-wide resources run in the manager electing one replica across all shards
+	clusterScopeMgr := mgr
+	if flagVar.IsSharded() {
+		clusterScopeMgr = newClusterScopeManager(config, scheme, clusterScopeCacheOptions, flagVar, setupLog)
+		if err = mgr.Add(shard.NewClusterScopeRunnable(clusterScopeMgr)); err != nil {
+			setupLog.Error(err, "unable to add cluster scope manager")
+			os.Exit(bootstrapFailedExitCode)
+		}
+	}
 	kcpRestConfig := mgr.GetConfig()
 	remoteClientCache := remote.NewClientCache()
 	kcpClient := remote.NewClientWithConfig(mgr.GetClient(), kcpRestConfig)
@@ -178,22 +227,32 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 	var skrWebhookManager *watcher.SKRWebhookManifestManager
 	var options ctrlruntime.Options
 	if flagVar.EnableKcpWatcher {
-		if skrWebhookManager, err = createSkrWebhookManager(mgr, skrContextProvider, flagVar); err != nil {
+		if skrWebhookManager, err = createSkrWebhookManager(mgr, clusterScopeMgr, skrContextProvider,
+			flagVar); err != nil {
 			setupLog.Error(err, "failed to create skr webhook manager")
 			os.Exit(bootstrapFailedExitCode)
 		}
-		setupKcpWatcherReconciler(mgr, options, eventRecorder, flagVar, setupLog)
-		err = istiogatewaysecret.SetupReconciler(mgr, flagVar, options)
+		setupKcpWatcherReconciler(clusterScopeMgr, options, eventRecorder, flagVar, setupLog)
+		err = istiogatewaysecret.SetupReconciler(clusterScopeMgr, flagVar, options, skrWebhookManager.WatcherMetrics)
 		if err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "Istio")
 			os.Exit(bootstrapFailedExitCode)
 		}
 	}
+	if flagVar.IsSharded() {
+		if err = mgr.Add(shard.NewLabeler(mgr.GetAPIReader(), mgr.GetClient(), flagVar.ShardLeaseNamespace, shardID,
+			flagVar.ShardCount, flagVar.ShardLabelingInterval)); err != nil {
+			setupLog.Error(err, "unable to add shard labeler")
+			os.Exit(bootstrapFailedExitCode)
+		}
+	}
 
-	sharedMetrics := metrics.NewSharedMetrics()
+	sharedMetrics := metrics.NewSharedMetrics(metrics.Cardinality(flagVar.MetricsCardinality))
 	descriptorProvider := provider.NewCachedDescriptorProvider()
 	kymaMetrics := metrics.NewKymaMetrics(sharedMetrics)
+	manifestMetrics := metrics.NewManifestMetrics(sharedMetrics)
 	mandatoryModulesMetrics := metrics.NewMandatoryModulesMetrics()
+	queueMetrics := metrics.NewQueueMetrics()
 
 	maintenanceWindow, err := maintenancewindows.InitializeMaintenanceWindow(setupLog,
 		maintenanceWindowPoliciesDirectory,
@@ -205,33 +264,133 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 	if err != nil {
 		setupLog.Error(err, "unable to set maintenance windows policy")
 	}
+	auditTrail := newAuditTrail(mgr, flagVar, setupLog)
 	setupKymaReconciler(mgr, descriptorProvider, skrContextProvider, eventRecorder, flagVar, options, skrWebhookManager,
-		kymaMetrics, setupLog, maintenanceWindow)
-	setupManifestReconciler(mgr, flagVar, options, sharedMetrics, mandatoryModulesMetrics, setupLog,
-		eventRecorder)
-	setupMandatoryModuleReconciler(mgr, descriptorProvider, flagVar, options, mandatoryModulesMetrics, setupLog)
-	setupMandatoryModuleDeletionReconciler(mgr, descriptorProvider, eventRecorder, flagVar, options, setupLog)
+		kymaMetrics, queueMetrics, setupLog, maintenanceWindow, auditTrail)
+	setupManifestReconciler(mgr, flagVar, options, manifestMetrics, mandatoryModulesMetrics, queueMetrics, setupLog,
+		eventRecorder, auditTrail)
+	setupMandatoryModuleReconciler(mgr, descriptorProvider, flagVar, options, mandatoryModulesMetrics, setupLog,
+		auditTrail)
+	setupMandatoryModuleDeletionReconciler(mgr, descriptorProvider, eventRecorder, flagVar, options, setupLog,
+		auditTrail)
 	if flagVar.EnablePurgeFinalizer {
 		setupPurgeReconciler(mgr, skrContextProvider, eventRecorder, flagVar, options, setupLog)
 	}
 
 	if flagVar.EnableWebhooks {
-		// enable conversion webhook for CRDs here
-
-		setupLog.Info("currently no configured webhooks")
+		setupWebhooks(mgr, setupLog)
+	}
+	if flagVar.EnableCatalogAPI {
+		// the catalog API reads the Kymas of all shards
+		setupCatalogAPI(clusterScopeMgr, descriptorProvider, flagVar, setupLog, maintenanceWindow)
 	}
 
 	addHealthChecks(mgr, setupLog)
 
-	go cleanupStoredVersions(flagVar.DropCrdStoredVersionMap, mgr, setupLog)
-	go scheduleMetricsCleanup(kymaMetrics, flagVar.MetricsCleanupIntervalInMinutes, mgr, setupLog)
+	setupStorageVersionMigration(mgr, flagVar, setupLog)
+	go scheduleMetricsCleanup(kymaMetrics, manifestMetrics, flagVar.MetricsCleanupIntervalInMinutes, mgr, setupLog)
 
-	if err = mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	if err = mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(runtimeProblemExitCode)
 	}
 }
 
+// claimShard blocks until one of the shards is claimed for the identity of this replica and returns it with the
+// Lease lock through which the leader election of the manager keeps the shard.
+func claimShard(ctx context.Context, config *rest.Config, scheme *machineryruntime.Scheme,
+	flagVar *flags.FlagVar, setupLog logr.Logger,
+) (int, resourcelock.Interface) {
+	kcpClient, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		setupLog.Error(err, "unable to create client for shard leases")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		setupLog.Error(err, "unable to determine the shard lease identity")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	identity := hostname + "_" + string(uuid.NewUUID())
+	shardID, err := shard.NewLeaseClaimer(kcpClient, flagVar.ShardLeaseNamespace, identity, flagVar.ShardCount,
+		flagVar.LeaderElectionLeaseDuration, flagVar.LeaderElectionRetryPeriod).WaitForShard(ctx)
+	if err != nil {
+		setupLog.Error(err, "unable to claim a shard")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		setupLog.Error(err, "unable to create clientset for shard leases")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	lock, err := resourcelock.New(resourcelock.LeasesResourceLock, flagVar.ShardLeaseNamespace,
+		shard.LeaseName(shardID), clientset.CoreV1(), clientset.CoordinationV1(),
+		resourcelock.ResourceLockConfig{Identity: identity})
+	if err != nil {
+		setupLog.Error(err, "unable to create shard lease lock")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	setupLog.Info(fmt.Sprintf("claimed shard %d of %d", shardID, flagVar.ShardCount))
+	return shardID, lock
+}
+
+// newClusterScopeManager creates the manager of the controllers of cluster-wide resources in sharded mode.
+// It caches all Kymas and elects a single replica on its own Lease, so that the controllers fail over to another
+// replica independently of the shards.
+func newClusterScopeManager(config *rest.Config, scheme *machineryruntime.Scheme, cacheOptions cache.Options,
+	flagVar *flags.FlagVar, setupLog logr.Logger,
+) ctrl.Manager {
+	mgr, err := ctrl.NewManager(
+		config, ctrl.Options{
+			Scheme:                  scheme,
+			Metrics:                 metricsserver.Options{BindAddress: "0"},
+			HealthProbeBindAddress:  "0",
+			LeaderElection:          true,
+			LeaderElectionID:        shard.ClusterScopeLeaseName,
+			LeaderElectionNamespace: flagVar.ShardLeaseNamespace,
+			LeaseDuration:           &flagVar.LeaderElectionLeaseDuration,
+			RenewDeadline:           &flagVar.LeaderElectionRenewDeadline,
+			RetryPeriod:             &flagVar.LeaderElectionRetryPeriod,
+			Cache:                   cacheOptions,
+		},
+	)
+	if err != nil {
+		setupLog.Error(err, "unable to start cluster scope manager")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	return mgr
+}
+
+// newAuditTrail creates the audit trail of the module lifecycle decisions with the sinks enabled by the flags.
+// It returns nil if no sink is enabled, which disables auditing.
+func newAuditTrail(mgr ctrl.Manager, flagVar *flags.FlagVar, setupLog logr.Logger) *audit.Trail {
+	var sinks []audit.Sink
+	if flagVar.AuditLogFile != "" {
+		fileSink, err := audit.NewFileSink(flagVar.AuditLogFile)
+		if err != nil {
+			setupLog.Error(err, "unable to create audit log file sink")
+			os.Exit(bootstrapFailedExitCode)
+		}
+		sinks = append(sinks, fileSink)
+	}
+	if flagVar.EnableAuditEvents {
+		sinks = append(sinks, audit.NewEventSink(mgr.GetEventRecorderFor(shared.OperatorName)))
+	}
+	if flagVar.AuditWebhookURL != "" {
+		webhookSink := audit.NewWebhookSink(flagVar.AuditWebhookURL, flagVar.AuditWebhookTimeout,
+			flagVar.AuditWebhookBufferSize, metrics.NewAuditMetrics())
+		if err := mgr.Add(webhookSink); err != nil {
+			setupLog.Error(err, "unable to add audit webhook sink")
+			os.Exit(bootstrapFailedExitCode)
+		}
+		sinks = append(sinks, webhookSink)
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+	return audit.NewTrail(sinks...)
+}
+
 func addHealthChecks(mgr manager.Manager, setupLog logr.Logger) {
 	// +kubebuilder:scaffold:builder
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -244,22 +403,31 @@ func addHealthChecks(mgr manager.Manager, setupLog logr.Logger) {
 	}
 }
 
-func cleanupStoredVersions(crdVersionsToDrop string, mgr manager.Manager, setupLog logr.Logger) {
-	if crdVersionsToDrop == "" {
+// setupStorageVersionMigration migrates the objects of the CRDs listed in the drop-crd-stored-version-map flag to
+// their storage version, before the listed versions are dropped from the stored versions of the CRDs.
+func setupStorageVersionMigration(mgr manager.Manager, flagVar *flags.FlagVar, setupLog logr.Logger) {
+	if flagVar.DropCrdStoredVersionMap == "" {
 		return
 	}
-
-	ctx := context.Background()
-	if !mgr.GetCache().WaitForCacheSync(ctx) {
-		setupLog.V(log.InfoLevel).Error(errFailedToDropStoredVersions, "failed to sync cache")
-		return
+	// the objects are listed without the cache, which is restricted to the shard of the replica
+	uncachedClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		setupLog.Error(err, "unable to create client for the storage version migration")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	progress := crd.NewConfigMapProgressStore(uncachedClient, client.ObjectKey{
+		Name:      storageVersionMigrationConfigMap,
+		Namespace: flagVar.StorageVersionMigrationNamespace,
+	})
+	if err := mgr.Add(crd.NewStorageVersionMigrationRunnable(uncachedClient, flagVar.DropCrdStoredVersionMap,
+		flagVar.StorageVersionMigrationBatchSize, progress)); err != nil {
+		setupLog.Error(err, "unable to add storage version migration")
+		os.Exit(bootstrapFailedExitCode)
 	}
-
-	crd.DropStoredVersion(ctx, mgr.GetClient(), crdVersionsToDrop)
 }
 
-func scheduleMetricsCleanup(kymaMetrics *metrics.KymaMetrics, cleanupIntervalInMinutes int, mgr manager.Manager,
-	setupLog logr.Logger,
+func scheduleMetricsCleanup(kymaMetrics *metrics.KymaMetrics, manifestMetrics *metrics.ManifestMetrics,
+	cleanupIntervalInMinutes int, mgr manager.Manager, setupLog logr.Logger,
 ) {
 	ctx := context.Background()
 	if !mgr.GetCache().WaitForCacheSync(ctx) {
@@ -274,6 +442,9 @@ func scheduleMetricsCleanup(kymaMetrics *metrics.KymaMetrics, cleanupIntervalInM
 		if err := kymaMetrics.CleanupNonExistingKymaCrsMetrics(ctx, mgr.GetClient()); err != nil {
 			setupLog.Info(fmt.Sprintf("failed to cleanup non existing kyma crs metrics, err: %s", err))
 		}
+		if err := manifestMetrics.CleanupNonExistingKymaCrsMetrics(ctx, mgr.GetClient()); err != nil {
+			setupLog.Info(fmt.Sprintf("failed to cleanup non existing kyma crs manifest metrics, err: %s", err))
+		}
 	})
 	if scheduleErr != nil {
 		setupLog.Info(fmt.Sprintf("failed to setup cleanup routine for non existing kyma crs metrics, err: %s",
@@ -286,20 +457,14 @@ func scheduleMetricsCleanup(kymaMetrics *metrics.KymaMetrics, cleanupIntervalInM
 func setupKymaReconciler(mgr ctrl.Manager, descriptorProvider *provider.CachedDescriptorProvider,
 	skrContextFactory remote.SkrContextProvider, event event.Event, flagVar *flags.FlagVar, options ctrlruntime.Options,
 	skrWebhookManager *watcher.SKRWebhookManifestManager, kymaMetrics *metrics.KymaMetrics,
-	setupLog logr.Logger, maintenanceWindow *maintenancewindows.MaintenanceWindow,
+	queueMetrics *metrics.QueueMetrics, setupLog logr.Logger, maintenanceWindow *maintenancewindows.MaintenanceWindow,
+	auditTrail *audit.Trail,
 ) {
 	options.RateLimiter = internal.RateLimiter(flagVar.FailureBaseDelay,
 		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
 	options.CacheSyncTimeout = flagVar.CacheSyncTimeout
 	options.MaxConcurrentReconciles = flagVar.MaxConcurrentKymaReconciles
 
-	moduleTemplateInfoLookupStrategies := moduletemplateinfolookup.NewModuleTemplateInfoLookupStrategies([]moduletemplateinfolookup.ModuleTemplateInfoLookupStrategy{
-		moduletemplateinfolookup.NewByVersionStrategy(mgr.GetClient()),
-		moduletemplateinfolookup.NewByChannelStrategy(mgr.GetClient()),
-		moduletemplateinfolookup.NewWithMaintenanceWindowDecorator(maintenanceWindow,
-			moduletemplateinfolookup.NewByModuleReleaseMetaStrategy(mgr.GetClient())),
-	})
-
 	if err := (&kyma.Reconciler{
 		Client:             mgr.GetClient(),
 		SkrContextFactory:  skrContextFactory,
@@ -316,15 +481,20 @@ func setupKymaReconciler(mgr ctrl.Manager, descriptorProvider *provider.CachedDe
 		InKCPMode:           flagVar.InKCPMode,
 		RemoteSyncNamespace: flagVar.RemoteSyncNamespace,
 		IsManagedKyma:       flagVar.IsKymaManaged,
+		IsSharded:           flagVar.IsSharded(),
 		Metrics:             kymaMetrics,
 		RemoteCatalog: remote.NewRemoteCatalogFromKyma(mgr.GetClient(), skrContextFactory,
 			flagVar.RemoteSyncNamespace),
-		TemplateLookup: templatelookup.NewTemplateLookup(mgr.GetClient(), descriptorProvider, moduleTemplateInfoLookupStrategies),
+		TemplateLookup: newTemplateLookup(mgr.GetClient(), descriptorProvider, maintenanceWindow,
+			upgradeapprovals.NewUpgradeApprovalGate(mgr.GetClient())),
+		AuditTrail: auditTrail,
 	}).SetupWithManager(
 		mgr, options, kyma.SetupOptions{
 			ListenerAddr:                 flagVar.KymaListenerAddr,
 			EnableDomainNameVerification: flagVar.EnableDomainNameVerification,
 			IstioNamespace:               flagVar.IstioNamespace,
+			EnablePriorityQueue:          flagVar.EnablePriorityQueue,
+			QueueMetrics:                 queueMetrics,
 		},
 	); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Kyma")
@@ -332,7 +502,7 @@ func setupKymaReconciler(mgr ctrl.Manager, descriptorProvider *provider.CachedDe
 	}
 }
 
-func createSkrWebhookManager(mgr ctrl.Manager, skrContextFactory remote.SkrContextProvider,
+func createSkrWebhookManager(mgr, clusterScopeMgr ctrl.Manager, skrContextFactory remote.SkrContextProvider,
 	flagVar *flags.FlagVar,
 ) (*watcher.SKRWebhookManifestManager, error) {
 	config := watcher.SkrWebhookManagerConfig{
@@ -361,14 +531,34 @@ func createSkrWebhookManager(mgr ctrl.Manager, skrContextFactory remote.SkrConte
 	if err != nil {
 		return nil, err
 	}
+	certificateProvider, err := createCertificateProvider(mgr, clusterScopeMgr, certConfig, flagVar)
+	if err != nil {
+		return nil, err
+	}
 	return watcher.NewSKRWebhookManifestManager(
 		mgr.GetClient(),
 		skrContextFactory,
 		config,
 		certConfig,
+		certificateProvider,
 		resolvedKcpAddr)
 }
 
+func createCertificateProvider(mgr, clusterScopeMgr ctrl.Manager, certConfig watcher.CertificateConfig,
+	flagVar *flags.FlagVar,
+) (watcher.CertificateProvider, error) {
+	if flagVar.CertificateProvider != flags.CertificateProviderBuiltin {
+		return watcher.NewCertManagerCertificateProvider(mgr.GetClient(), certConfig), nil
+	}
+
+	provider := watcher.NewBuiltinCertificateProvider(mgr.GetClient(), certConfig)
+	renewer := watcher.NewBuiltinCertificateProvider(clusterScopeMgr.GetClient(), certConfig)
+	if err := clusterScopeMgr.Add(watcher.NewCertificateRenewer(renewer, flagVar.SelfSignedCertRenewInterval)); err != nil {
+		return nil, fmt.Errorf("failed to add certificate renewer: %w", err)
+	}
+	return provider, nil
+}
+
 func setupPurgeReconciler(mgr ctrl.Manager,
 	skrContextProvider remote.SkrContextProvider,
 	event event.Event,
@@ -380,12 +570,26 @@ func setupPurgeReconciler(mgr ctrl.Manager,
 		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
 	options.CacheSyncTimeout = flagVar.CacheSyncTimeout
 
+	groupDeletionTimeouts, err := purge.ParseGroupDeletionTimeouts(flagVar.PurgeGroupDeletionTimeouts)
+	if err != nil {
+		setupLog.Error(err, "unable to parse purge group deletion timeouts")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	policy := purge.Policy{
+		Include:               matcher.CreateCRDMatcherFrom(flagVar.PurgeIncludeFor),
+		Skip:                  matcher.CreateCRDMatcherFrom(flagVar.SkipPurgingFor),
+		ForceFinalize:         matcher.CreateCRDMatcherFrom(flagVar.PurgeForceFinalizeFor),
+		DeletionOrder:         purge.ParseDeletionOrder(flagVar.PurgeDeletionOrder),
+		DeletionTimeout:       flagVar.PurgeDeletionTimeout,
+		GroupDeletionTimeouts: groupDeletionTimeouts,
+	}
+
 	if err := (&purge.Reconciler{
 		Client:                mgr.GetClient(),
 		SkrContextFactory:     skrContextProvider,
 		Event:                 event,
 		PurgeFinalizerTimeout: flagVar.PurgeFinalizerTimeout,
-		SkipCRDs:              matcher.CreateCRDMatcherFrom(flagVar.SkipPurgingFor),
+		Policy:                policy,
 		IsManagedKyma:         flagVar.IsKymaManaged,
 		Metrics:               metrics.NewPurgeMetrics(),
 	}).SetupWithManager(
@@ -394,11 +598,15 @@ func setupPurgeReconciler(mgr ctrl.Manager,
 		setupLog.Error(err, "unable to create controller", "controller", "PurgeReconciler")
 		os.Exit(bootstrapFailedExitCode)
 	}
+	if err := mgr.Add(purge.NewReportPruner(mgr.GetClient(), flagVar.PurgeReportRetention)); err != nil {
+		setupLog.Error(err, "unable to add purge report pruner")
+		os.Exit(bootstrapFailedExitCode)
+	}
 }
 
 func setupManifestReconciler(mgr ctrl.Manager, flagVar *flags.FlagVar, options ctrlruntime.Options,
-	sharedMetrics *metrics.SharedMetrics, mandatoryModulesMetrics *metrics.MandatoryModulesMetrics,
-	setupLog logr.Logger, event event.Event,
+	manifestMetrics *metrics.ManifestMetrics, mandatoryModulesMetrics *metrics.MandatoryModulesMetrics,
+	queueMetrics *metrics.QueueMetrics, setupLog logr.Logger, event event.Event, auditTrail *audit.Trail,
 ) {
 	options.RateLimiter = internal.RateLimiter(flagVar.FailureBaseDelay,
 		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
@@ -418,7 +626,10 @@ func setupManifestReconciler(mgr ctrl.Manager, flagVar *flags.FlagVar, options c
 		}, manifest.SetupOptions{
 			ListenerAddr:                 flagVar.ManifestListenerAddr,
 			EnableDomainNameVerification: flagVar.EnableDomainNameVerification,
-		}, metrics.NewManifestMetrics(sharedMetrics), mandatoryModulesMetrics,
+			EnablePriorityQueue:          flagVar.EnablePriorityQueue,
+			QueueMetrics:                 queueMetrics,
+			AuditTrail:                   auditTrail,
+		}, manifestMetrics, mandatoryModulesMetrics,
 		manifestClient,
 	); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Manifest")
@@ -458,6 +669,7 @@ func setupMandatoryModuleReconciler(mgr ctrl.Manager,
 	options ctrlruntime.Options,
 	metrics *metrics.MandatoryModulesMetrics,
 	setupLog logr.Logger,
+	auditTrail *audit.Trail,
 ) {
 	options.RateLimiter = internal.RateLimiter(flagVar.FailureBaseDelay,
 		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
@@ -476,6 +688,7 @@ func setupMandatoryModuleReconciler(mgr ctrl.Manager,
 		InKCPMode:           flagVar.InKCPMode,
 		DescriptorProvider:  descriptorProvider,
 		Metrics:             metrics,
+		AuditTrail:          auditTrail,
 	}).SetupWithManager(mgr, options); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MandatoryModule")
 		os.Exit(bootstrapFailedExitCode)
@@ -488,6 +701,7 @@ func setupMandatoryModuleDeletionReconciler(mgr ctrl.Manager,
 	flagVar *flags.FlagVar,
 	options ctrlruntime.Options,
 	setupLog logr.Logger,
+	auditTrail *audit.Trail,
 ) {
 	options.RateLimiter = internal.RateLimiter(flagVar.FailureBaseDelay,
 		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
@@ -498,6 +712,7 @@ func setupMandatoryModuleDeletionReconciler(mgr ctrl.Manager,
 		Client:             mgr.GetClient(),
 		Event:              event,
 		DescriptorProvider: descriptorProvider,
+		AuditTrail:         auditTrail,
 		RequeueIntervals: queue.RequeueIntervals{
 			Success: flagVar.MandatoryModuleDeletionRequeueSuccessInterval,
 			Busy:    flagVar.KymaRequeueBusyInterval,
@@ -509,3 +724,53 @@ func setupMandatoryModuleDeletionReconciler(mgr ctrl.Manager,
 		os.Exit(bootstrapFailedExitCode)
 	}
 }
+
+func setupWebhooks(mgr ctrl.Manager, setupLog logr.Logger) {
+	if err := webhookv1beta2.SetupConversionWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create conversion webhook")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	for kind, setup := range map[shared.Kind]func(ctrl.Manager) error{
+		shared.KymaKind:              webhookv1beta2.SetupKymaWebhookWithManager,
+		shared.ModuleTemplateKind:    webhookv1beta2.SetupModuleTemplateWebhookWithManager,
+		shared.ModuleReleaseMetaKind: webhookv1beta2.SetupModuleReleaseMetaWebhookWithManager,
+	} {
+		if err := setup(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", kind)
+			os.Exit(bootstrapFailedExitCode)
+		}
+	}
+}
+
+func newTemplateLookup(clnt client.Reader, descriptorProvider *provider.CachedDescriptorProvider,
+	maintenanceWindow moduletemplateinfolookup.MaintenanceWindow,
+	upgradeApproval moduletemplateinfolookup.UpgradeApproval,
+) *templatelookup.TemplateLookup {
+	return templatelookup.NewTemplateLookup(clnt, descriptorProvider,
+		moduletemplateinfolookup.NewDefaultModuleTemplateInfoLookupStrategies(clnt, maintenanceWindow, upgradeApproval))
+}
+
+// setupCatalogAPI serves the catalog API on its own listener over HTTPS. Clients are authenticated with a
+// TokenReview and authorized with a SubjectAccessReview for the non-resource URLs of the API.
+func setupCatalogAPI(mgr ctrl.Manager, descriptorProvider *provider.CachedDescriptorProvider,
+	flagVar *flags.FlagVar, setupLog logr.Logger, maintenanceWindow *maintenancewindows.MaintenanceWindow,
+) {
+	handler := catalogapi.NewHandler(mgr.GetClient(),
+		newTemplateLookup(mgr.GetClient(), descriptorProvider, maintenanceWindow,
+			upgradeapprovals.NewUpgradeApprovalGate(mgr.GetClient())),
+		newTemplateLookup(mgr.GetClient(), descriptorProvider, nil, nil))
+	server, err := metricsserver.NewServer(metricsserver.Options{
+		BindAddress:    flagVar.CatalogAPIAddr,
+		SecureServing:  true,
+		CertDir:        flagVar.CatalogAPICertDir,
+		FilterProvider: catalogapi.WithAuthenticationAndAuthorization,
+		ExtraHandlers:  map[string]http.Handler{catalogapi.PathPrefix: handler},
+	}, mgr.GetConfig(), mgr.GetHTTPClient())
+	if err == nil {
+		err = mgr.Add(server)
+	}
+	if err != nil {
+		setupLog.Error(err, "unable to add catalog API")
+		os.Exit(bootstrapFailedExitCode)
+	}
+}
@@ -0,0 +1,166 @@
+// Command simulate resolves the ModuleTemplates for the modules of a Kyma and renders the resulting Manifests and
+// SKR objects offline, using the same lookup, parsing and rendering as Lifecycle Manager.
+//
+//	simulate -f kyma.yaml -f templates/ [-kyma name] [-oci-layout dir] [-render=false]
+//
+// The objects are printed as YAML to stdout. The command fails if a module could not be resolved or rendered.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
+	"github.com/kyma-project/lifecycle-manager/internal/simulation"
+
+	_ "ocm.software/ocm/api/ocm"
+)
+
+const (
+	simulationFailedExitCode = 1
+	invalidUsageExitCode     = 2
+	defaultNamespace         = "kcp-system"
+)
+
+var errModulesFailed = errors.New("simulation failed")
+
+type fileList []string
+
+func (f *fileList) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *fileList) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func main() {
+	var files fileList
+	flag.Var(&files, "f", "YAML file or directory with Kyma, ModuleTemplate and ModuleReleaseMeta objects, "+
+		"can be repeated")
+	kymaName := flag.String("kyma", "", "Name of the Kyma to simulate, can be omitted if there is only one Kyma")
+	namespace := flag.String("namespace", defaultNamespace, "Namespace of the objects without a namespace")
+	ociLayout := flag.String("oci-layout", "",
+		"Local OCI image layout directory with the raw manifest layers, they are pulled from the registry otherwise")
+	render := flag.Bool("render", true, "Render the objects created in the SKR cluster")
+	inKCPMode := flag.Bool("in-kcp-mode", true, "Simulate Lifecycle Manager running in KCP")
+	syncNamespace := flag.String("sync-namespace", shared.DefaultRemoteNamespace,
+		"Namespace of the module CRs without a namespace")
+	flag.Parse()
+
+	if len(files) == 0 {
+		fmt.Fprintln(os.Stderr, "at least one file has to be given with -f")
+		flag.Usage()
+		os.Exit(invalidUsageExitCode)
+	}
+
+	if err := run(context.Background(), os.Stdout, files, *kymaName, *namespace, *ociLayout, *render,
+		simulation.Options{InKCPMode: *inKCPMode, RemoteSyncNamespace: *syncNamespace}); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(simulationFailedExitCode)
+	}
+}
+
+func run(ctx context.Context, out io.Writer, files []string, kymaName, namespace, ociLayout string, render bool,
+	options simulation.Options,
+) error {
+	objects, err := simulation.LoadObjects(files, namespace)
+	if err != nil {
+		return err
+	}
+
+	if render {
+		workDir, err := os.MkdirTemp("", "lifecycle-manager-simulate-")
+		if err != nil {
+			return fmt.Errorf("failed to create work directory: %w", err)
+		}
+		defer os.RemoveAll(workDir)
+		if options.SpecResolver, err = newSpecResolver(ociLayout, workDir); err != nil {
+			return err
+		}
+	}
+
+	results, err := simulation.Simulate(ctx, objects, kymaName, options)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if err := printResult(out, result); err != nil {
+			return err
+		}
+		if result.Err != nil {
+			fmt.Fprintf(os.Stderr, "module %s: %v\n", result.Name, result.Err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%w: %d of %d modules", errModulesFailed, failed, len(results))
+	}
+	return nil
+}
+
+func newSpecResolver(ociLayout, workDir string) (*manifest.SpecResolver, error) {
+	if ociLayout == "" {
+		return manifest.NewSpecResolver(simulation.StaticKeyChainLookup{KeyChain: authn.DefaultKeychain},
+			img.NewPathExtractor()), nil
+	}
+	extractor, err := simulation.NewLayoutPathExtractor(ociLayout, workDir)
+	if err != nil {
+		return nil, err
+	}
+	return manifest.NewSpecResolver(simulation.StaticKeyChainLookup{KeyChain: authn.NewMultiKeychain()},
+		extractor), nil
+}
+
+func printResult(out io.Writer, result simulation.ModuleResult) error {
+	status := "resolved"
+	if result.Err != nil {
+		status = "failed: " + result.Err.Error()
+	}
+	if _, err := fmt.Fprintf(out, "# module: %s, channel: %s, template: %s, version: %s, %s\n",
+		result.Name, result.Channel, result.Template, result.Version, status); err != nil {
+		return fmt.Errorf("failed to print result: %w", err)
+	}
+
+	if result.Manifest != nil {
+		result.Manifest.SetGroupVersionKind(v1beta2.GroupVersion.WithKind(string(shared.ManifestKind)))
+		if err := printObject(out, result.Manifest); err != nil {
+			return err
+		}
+	}
+	for _, resource := range result.Resources {
+		if err := printObject(out, resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printObject(out io.Writer, object any) error {
+	if resource, ok := object.(*unstructured.Unstructured); ok {
+		object = resource.Object
+	}
+	data, err := yaml.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal object: %w", err)
+	}
+	if _, err := fmt.Fprintf(out, "---\n%s", data); err != nil {
+		return fmt.Errorf("failed to print object: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/simulation"
+)
+
+func TestRun_PrintsManifestOfResolvedModule(t *testing.T) {
+	var out bytes.Buffer
+
+	err := run(context.Background(), &out, []string{"testdata/kyma.yaml", "testdata/templates"}, "",
+		defaultNamespace, "", false,
+		simulation.Options{InKCPMode: true, RemoteSyncNamespace: shared.DefaultRemoteNamespace})
+
+	require.NoError(t, err)
+	documents := strings.Split(out.String(), "---\n")
+	require.Len(t, documents, 2)
+	assert.Equal(t, "# module: template-operator, channel: regular, template: template-operator-regular, "+
+		"version: 1.1.1-e2e-test, resolved\n", documents[0])
+
+	manifest := &v1beta2.Manifest{}
+	require.NoError(t, yaml.Unmarshal([]byte(documents[1]), manifest))
+	assert.Equal(t, string(shared.ManifestKind), manifest.Kind)
+	assert.Equal(t, defaultNamespace, manifest.GetNamespace())
+	assert.Equal(t, "kyma-sample", manifest.GetLabels()[shared.KymaName])
+	assert.Equal(t, "template-operator", manifest.GetLabels()[shared.ModuleName])
+	assert.Equal(t, "1.1.1-e2e-test", manifest.Spec.Version)
+	require.NotNil(t, manifest.Spec.Resource)
+	assert.Equal(t, "Sample", manifest.Spec.Resource.GetKind())
+}
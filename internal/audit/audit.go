@@ -0,0 +1,110 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Operation is the lifecycle decision taken for a module.
+type Operation string
+
+const (
+	// OperationInstall is recorded when the Manifest of a module is created.
+	OperationInstall Operation = "Install"
+	// OperationUpgrade is recorded when the Manifest of a module is updated to another version.
+	OperationUpgrade Operation = "Upgrade"
+	// OperationUpgradeSkipped is recorded when an upgrade waits for the next maintenance window or for an approval.
+	OperationUpgradeSkipped Operation = "UpgradeSkipped"
+	// OperationUpgradeBlocked is recorded when an upgrade is rejected by the version skew validation, or blocked by a
+	// failed pre-upgrade hook.
+	OperationUpgradeBlocked Operation = "UpgradeBlocked"
+	// OperationUnmanage is recorded when a module is no longer managed by Lifecycle Manager.
+	OperationUnmanage Operation = "Unmanage"
+	// OperationDelete is recorded when the Manifest of a module is deleted.
+	OperationDelete Operation = "Delete"
+	// OperationApplied is recorded when the resources of a module are applied to the SKR cluster and ready.
+	OperationApplied Operation = "Applied"
+	// OperationRemoved is recorded when the resources of a module are removed from the SKR cluster.
+	OperationRemoved Operation = "Removed"
+	// OperationReadopt is recorded when the existing resources of a module which is managed again are adopted.
+	OperationReadopt Operation = "Readopt"
+	// OperationUpgradeAborted is recorded when a BlueGreen upgrade is aborted as the new version did not become Ready.
+	OperationUpgradeAborted Operation = "UpgradeAborted"
+)
+
+// Actor is the controller taking a lifecycle decision.
+type Actor string
+
+const (
+	ActorKymaController                        Actor = "kyma-controller"
+	ActorManifestController                    Actor = "manifest-controller"
+	ActorMandatoryModuleInstallationController Actor = "mandatory-module-installation-controller"
+	ActorMandatoryModuleDeletionController     Actor = "mandatory-module-deletion-controller"
+)
+
+// Record is an entry of the audit trail.
+type Record struct {
+	Time          time.Time `json:"time"`
+	Operation     Operation `json:"operation"`
+	KymaNamespace string    `json:"kymaNamespace"`
+	KymaName      string    `json:"kymaName"`
+	Module        string    `json:"module"`
+	FromVersion   string    `json:"fromVersion,omitempty"`
+	ToVersion     string    `json:"toVersion,omitempty"`
+	Actor         Actor     `json:"actor"`
+	// Trigger is the change that led to the decision, such as the ModuleTemplate a module is installed from.
+	Trigger string `json:"trigger,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// Sink delivers audit records to a destination.
+type Sink interface {
+	Write(ctx context.Context, record Record) error
+}
+
+// Trail delivers the audit records to all configured sinks. A nil Trail discards all records.
+type Trail struct {
+	sinks []Sink
+}
+
+func NewTrail(sinks ...Sink) *Trail {
+	return &Trail{sinks: sinks}
+}
+
+// For returns the recorder for the decisions of the given controller.
+func (t *Trail) For(actor Actor) *Recorder {
+	if t == nil || len(t.sinks) == 0 {
+		return nil
+	}
+	return &Recorder{trail: t, actor: actor}
+}
+
+func (t *Trail) write(ctx context.Context, record Record) {
+	for _, sink := range t.sinks {
+		if err := sink.Write(ctx, record); err != nil {
+			logf.FromContext(ctx).Error(err, "failed to write audit record",
+				"operation", record.Operation, "module", record.Module, "kyma", record.KymaName)
+		}
+	}
+}
+
+// Recorder records the decisions of one controller. A nil Recorder discards all records.
+type Recorder struct {
+	trail *Trail
+	actor Actor
+}
+
+// Record sets the actor and, if missing, the time of the record and writes it to all sinks of the trail.
+// Failing sinks are logged, so that auditing never blocks reconciliation.
+func (r *Recorder) Record(ctx context.Context, record Record) {
+	if r == nil {
+		return
+	}
+	record.Actor = r.actor
+	if record.Time.IsZero() {
+		record.Time = time.Now().UTC()
+	}
+	r.trail.write(ctx, record)
+}
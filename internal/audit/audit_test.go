@@ -0,0 +1,194 @@
+package audit_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
+)
+
+var errSinkFailed = errors.New("sink failed")
+
+func TestRecorder_SetsActorAndTimeForAllSinks(t *testing.T) {
+	t.Parallel()
+	first, second := &sinkStub{}, &sinkStub{err: errSinkFailed}
+	third := &sinkStub{}
+	recorder := audit.NewTrail(first, second, third).For(audit.ActorKymaController)
+
+	recorder.Record(context.Background(), upgradeRecord())
+
+	for _, sink := range []*sinkStub{first, second, third} {
+		require.Len(t, sink.records, 1)
+		assert.Equal(t, audit.ActorKymaController, sink.records[0].Actor)
+		assert.False(t, sink.records[0].Time.IsZero())
+	}
+}
+
+func TestRecorder_NilTrailDiscardsRecords(t *testing.T) {
+	t.Parallel()
+	var trail *audit.Trail
+
+	recorder := trail.For(audit.ActorKymaController)
+
+	assert.Nil(t, recorder)
+	assert.NotPanics(t, func() { recorder.Record(context.Background(), upgradeRecord()) })
+}
+
+func TestFileSink_AppendsJSONLines(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := audit.NewFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Write(context.Background(), upgradeRecord()))
+	require.NoError(t, sink.Write(context.Background(), audit.Record{Operation: audit.OperationDelete}))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var records []audit.Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var auditRecord audit.Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &auditRecord))
+		records = append(records, auditRecord)
+	}
+	require.Len(t, records, 2)
+	assert.Equal(t, upgradeRecord(), records[0])
+	assert.Equal(t, audit.OperationDelete, records[1].Operation)
+}
+
+func TestEventSink_RecordsEventOnKyma(t *testing.T) {
+	t.Parallel()
+	recorder := record.NewFakeRecorder(2)
+	sink := audit.NewEventSink(recorder)
+
+	require.NoError(t, sink.Write(context.Background(), upgradeRecord()))
+	require.NoError(t, sink.Write(context.Background(), audit.Record{
+		Operation: audit.OperationUpgradeBlocked, Module: "istio", Actor: audit.ActorKymaController,
+		Reason: "version skew",
+	}))
+
+	assert.Equal(t, "Normal AuditUpgrade module istio from 1.0.0 to 1.1.0 by kyma-controller, "+
+		"triggered by ModuleTemplate istio-1.1.0: new version assigned to channel regular", <-recorder.Events)
+	assert.Equal(t, "Warning AuditUpgradeBlocked module istio by kyma-controller: version skew", <-recorder.Events)
+}
+
+func TestWebhookSink_PostsRecord(t *testing.T) {
+	t.Parallel()
+	received := make(chan audit.Record, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, err := io.ReadAll(request.Body)
+		assert.NoError(t, err)
+		var auditRecord audit.Record
+		assert.NoError(t, json.Unmarshal(body, &auditRecord))
+		assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+		received <- auditRecord
+		writer.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	sink := audit.NewWebhookSink(server.URL, time.Second, 1, &dropRecorderStub{})
+	startSink(t, sink)
+
+	require.NoError(t, sink.Write(context.Background(), upgradeRecord()))
+
+	assert.Equal(t, upgradeRecord(), <-received)
+}
+
+func TestWebhookSink_CountsRejectedRecordAsDropped(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	dropped := &dropRecorderStub{}
+	sink := audit.NewWebhookSink(server.URL, time.Second, 1, dropped)
+	startSink(t, sink)
+
+	require.NoError(t, sink.Write(context.Background(), upgradeRecord()))
+
+	assert.Eventually(t, func() bool {
+		return slices.Equal(dropped.get(), []string{audit.DropReasonDeliveryFailed})
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWebhookSink_DropsRecordWhenBufferIsFull(t *testing.T) {
+	t.Parallel()
+	dropped := &dropRecorderStub{}
+	sink := audit.NewWebhookSink("http://localhost:1", time.Second, 1, dropped)
+
+	require.NoError(t, sink.Write(context.Background(), upgradeRecord()))
+	err := sink.Write(context.Background(), upgradeRecord())
+
+	require.ErrorIs(t, err, audit.ErrWebhookBufferFull)
+	assert.Equal(t, []string{audit.DropReasonBufferFull}, dropped.get())
+}
+
+func startSink(t *testing.T, sink *audit.WebhookSink) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- sink.Start(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, <-done)
+	})
+}
+
+type dropRecorderStub struct {
+	mu      sync.Mutex
+	reasons []string
+}
+
+func (s *dropRecorderStub) RecordDropped(reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reasons = append(s.reasons, reason)
+}
+
+func (s *dropRecorderStub) get() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.reasons)
+}
+
+type sinkStub struct {
+	records []audit.Record
+	err     error
+}
+
+func (s *sinkStub) Write(_ context.Context, auditRecord audit.Record) error {
+	s.records = append(s.records, auditRecord)
+	return s.err
+}
+
+func upgradeRecord() audit.Record {
+	return audit.Record{
+		Time:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Operation:     audit.OperationUpgrade,
+		KymaNamespace: "kcp-system",
+		KymaName:      "kyma",
+		Module:        "istio",
+		FromVersion:   "1.0.0",
+		ToVersion:     "1.1.0",
+		Actor:         audit.ActorKymaController,
+		Trigger:       "ModuleTemplate istio-1.1.0",
+		Reason:        "new version assigned to channel regular",
+	}
+}
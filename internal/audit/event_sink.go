@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// EventSink records the audit records as Kubernetes Events on the Kyma. Repeated records of the same operation
+// on a Kyma are aggregated into one Event by the event correlator of the recorder.
+type EventSink struct {
+	recorder record.EventRecorder
+}
+
+func NewEventSink(recorder record.EventRecorder) *EventSink {
+	return &EventSink{recorder: recorder}
+}
+
+func (s *EventSink) Write(_ context.Context, auditRecord Record) error {
+	kyma := &apicorev1.ObjectReference{
+		APIVersion: v1beta2.GroupVersion.String(),
+		Kind:       string(shared.KymaKind),
+		Namespace:  auditRecord.KymaNamespace,
+		Name:       auditRecord.KymaName,
+	}
+	eventType := apicorev1.EventTypeNormal
+	if auditRecord.Operation == OperationUpgradeBlocked {
+		eventType = apicorev1.EventTypeWarning
+	}
+	s.recorder.Event(kyma, eventType, "Audit"+string(auditRecord.Operation), message(auditRecord))
+	return nil
+}
+
+func message(auditRecord Record) string {
+	msg := fmt.Sprintf("module %s", auditRecord.Module)
+	switch {
+	case auditRecord.FromVersion != "" && auditRecord.ToVersion != "":
+		msg += fmt.Sprintf(" from %s to %s", auditRecord.FromVersion, auditRecord.ToVersion)
+	case auditRecord.ToVersion != "":
+		msg += " in version " + auditRecord.ToVersion
+	case auditRecord.FromVersion != "":
+		msg += " in version " + auditRecord.FromVersion
+	}
+	msg += " by " + string(auditRecord.Actor)
+	if auditRecord.Trigger != "" {
+		msg += ", triggered by " + auditRecord.Trigger
+	}
+	if auditRecord.Reason != "" {
+		msg += ": " + auditRecord.Reason
+	}
+	return msg
+}
@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	ErrWebhookRejected   = errors.New("audit webhook rejected the record")
+	ErrWebhookBufferFull = errors.New("audit webhook buffer is full, the record is dropped")
+)
+
+const (
+	// DropReasonBufferFull is reported for records which do not fit into the buffer of the WebhookSink.
+	DropReasonBufferFull = "buffer_full"
+	// DropReasonDeliveryFailed is reported for records which the WebhookSink fails to post.
+	DropReasonDeliveryFailed = "delivery_failed"
+)
+
+// DropRecorder counts the audit records which are not delivered, by reason.
+type DropRecorder interface {
+	RecordDropped(reason string)
+}
+
+// WebhookSink posts each audit record as JSON to an HTTP endpoint. The records are queued in a bounded buffer and
+// posted in the background once the sink is started, so that a slow endpoint does not block the reconciliation.
+// Records which do not fit into the buffer or fail to be posted are dropped and counted by the DropRecorder.
+type WebhookSink struct {
+	url     string
+	client  *http.Client
+	records chan Record
+	dropped DropRecorder
+}
+
+func NewWebhookSink(url string, timeout time.Duration, bufferSize int, dropped DropRecorder) *WebhookSink {
+	return &WebhookSink{
+		url:     url,
+		client:  &http.Client{Timeout: timeout},
+		records: make(chan Record, bufferSize),
+		dropped: dropped,
+	}
+}
+
+// Write queues the record to be posted without waiting for the endpoint.
+func (s *WebhookSink) Write(_ context.Context, record Record) error {
+	select {
+	case s.records <- record:
+		return nil
+	default:
+		s.dropped.RecordDropped(DropReasonBufferFull)
+		return ErrWebhookBufferFull
+	}
+}
+
+// Start posts the queued records until the context is done.
+func (s *WebhookSink) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case record := <-s.records:
+			if err := s.post(ctx, record); err != nil {
+				s.dropped.RecordDropped(DropReasonDeliveryFailed)
+				logger.Error(err, "failed to post audit record",
+					"operation", record.Operation, "module", record.Module, "kyma", record.KymaName)
+			}
+		}
+	}
+}
+
+// NeedLeaderElection returns false, so that the records are posted on every replica which writes them.
+func (s *WebhookSink) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *WebhookSink) post(ctx context.Context, record Record) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit record: %w", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create audit webhook request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to send audit record: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: status %d", ErrWebhookRejected, response.StatusCode)
+	}
+	return nil
+}
@@ -69,6 +69,16 @@ func (c *KcpCacheOptions) GetCacheOptions() cache.Options {
 					c.kcpNamespace: {},
 				},
 			},
+			&v1beta2.PurgeReport{}: {
+				Namespaces: map[string]cache.Config{
+					c.kcpNamespace: {},
+				},
+			},
+			&v1beta2.UpgradeApproval{}: {
+				Namespaces: map[string]cache.Config{
+					c.kcpNamespace: {},
+				},
+			},
 			&certmanagerv1.Issuer{}: {
 				Namespaces: map[string]cache.Config{
 					c.kcpNamespace:   {},
@@ -97,3 +107,33 @@ func GetCacheOptions(isKymaManaged bool, istioNamespace, kcpNamespace string) ca
 	options := &DefaultCacheOptions{}
 	return options.GetCacheOptions()
 }
+
+// WithShardSelector restricts the cached Kymas and Manifests to the ones selected by the shard label selector.
+// The ByObject map is copied, so that the given options keep caching the resources of all shards.
+func WithShardSelector(options cache.Options, shardSelector k8slabels.Selector) cache.Options {
+	byObjects := make(map[client.Object]cache.ByObject, len(options.ByObject))
+	for obj, byObject := range options.ByObject {
+		byObjects[obj] = byObject
+	}
+	options.ByObject = byObjects
+	kymaSelected, manifestSelected := false, false
+	for obj, byObject := range options.ByObject {
+		switch obj.(type) {
+		case *v1beta2.Kyma:
+			kymaSelected = true
+		case *v1beta2.Manifest:
+			manifestSelected = true
+		default:
+			continue
+		}
+		byObject.Label = shardSelector
+		options.ByObject[obj] = byObject
+	}
+	if !kymaSelected {
+		options.ByObject[&v1beta2.Kyma{}] = cache.ByObject{Label: shardSelector}
+	}
+	if !manifestSelected {
+		options.ByObject[&v1beta2.Manifest{}] = cache.ByObject{Label: shardSelector}
+	}
+	return options
+}
@@ -0,0 +1,62 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal"
+)
+
+func TestWithShardSelector(t *testing.T) {
+	shardSelector := k8slabels.SelectorFromSet(k8slabels.Set{"shard": "1"})
+	tests := []struct {
+		name           string
+		isKymaManaged  bool
+		expectedLength int
+	}{
+		{name: "restricts KCP cache options", isKymaManaged: true, expectedLength: 10},
+		{name: "adds selectors to default cache options", isKymaManaged: false, expectedLength: 3},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			options := internal.WithShardSelector(
+				internal.GetCacheOptions(testCase.isKymaManaged, "istio-system", "kcp-system"), shardSelector)
+
+			assert.Len(t, options.ByObject, testCase.expectedLength)
+			assertSelector(t, options, func(obj any) bool { _, ok := obj.(*v1beta2.Kyma); return ok }, shardSelector)
+			assertSelector(t, options, func(obj any) bool { _, ok := obj.(*v1beta2.Manifest); return ok },
+				shardSelector)
+		})
+	}
+}
+
+func TestWithShardSelector_KeepsGivenOptions(t *testing.T) {
+	shardSelector := k8slabels.SelectorFromSet(k8slabels.Set{"shard": "1"})
+	options := internal.GetCacheOptions(true, "istio-system", "kcp-system")
+	expectedLength := len(options.ByObject)
+
+	_ = internal.WithShardSelector(options, shardSelector)
+
+	assert.Len(t, options.ByObject, expectedLength)
+	for _, byObject := range options.ByObject {
+		assert.NotEqual(t, shardSelector, byObject.Label)
+	}
+}
+
+func assertSelector(t *testing.T, options cache.Options, matches func(obj any) bool,
+	expected k8slabels.Selector,
+) {
+	t.Helper()
+	for obj, byObject := range options.ByObject {
+		if matches(obj) {
+			assert.Equal(t, expected, byObject.Label)
+			return
+		}
+	}
+	require.Fail(t, "no cache options found")
+}
@@ -0,0 +1,84 @@
+package catalogapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// WithAuthenticationAndAuthorization provides the filter of the catalog API server. A request is only served if its
+// bearer token is authenticated by a TokenReview and a SubjectAccessReview allows the user to get the requested path.
+func WithAuthenticationAndAuthorization(config *rest.Config, httpClient *http.Client) (metricsserver.Filter, error) {
+	clnt, err := client.New(config, client.Options{HTTPClient: httpClient})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for the catalog API authentication: %w", err)
+	}
+	return func(_ logr.Logger, handler http.Handler) (http.Handler, error) {
+		return NewAuthenticatingHandler(clnt, handler), nil
+	}, nil
+}
+
+// NewAuthenticatingHandler wraps the handler so that it only serves authenticated and authorized requests.
+func NewAuthenticatingHandler(clnt client.Client, handler http.Handler) http.Handler {
+	return &authenticatingHandler{client: clnt, handler: handler}
+}
+
+type authenticatingHandler struct {
+	client  client.Client
+	handler http.Handler
+}
+
+func (h *authenticatingHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	token, found := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer ")
+	if !found || token == "" {
+		http.Error(writer, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	tokenReview := &authenticationv1.TokenReview{Spec: authenticationv1.TokenReviewSpec{Token: token}}
+	if err := h.client.Create(ctx, tokenReview); err != nil {
+		writeError(ctx, writer, fmt.Errorf("failed to authenticate request: %w", err))
+		return
+	}
+	if !tokenReview.Status.Authenticated {
+		http.Error(writer, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	user := tokenReview.Status.User
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for key, value := range user.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+	accessReview := &authorizationv1.SubjectAccessReview{Spec: authorizationv1.SubjectAccessReviewSpec{
+		User:   user.Username,
+		Groups: user.Groups,
+		UID:    user.UID,
+		Extra:  extra,
+		NonResourceAttributes: &authorizationv1.NonResourceAttributes{
+			Path: request.URL.Path,
+			Verb: strings.ToLower(request.Method),
+		},
+	}}
+	if err := h.client.Create(ctx, accessReview); err != nil {
+		writeError(ctx, writer, fmt.Errorf("failed to authorize request: %w", err))
+		return
+	}
+	if !accessReview.Status.Allowed {
+		http.Error(writer, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	h.handler.ServeHTTP(writer, request)
+}
@@ -0,0 +1,70 @@
+package catalogapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8sclientscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/kyma-project/lifecycle-manager/internal/catalogapi"
+)
+
+func TestAuthenticatingHandler(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		authorization  string
+		expectedStatus int
+	}{
+		{name: "without token", authorization: "", expectedStatus: http.StatusUnauthorized},
+		{name: "with unknown token", authorization: "Bearer unknown", expectedStatus: http.StatusUnauthorized},
+		{name: "with token of unauthorized user", authorization: "Bearer reader", expectedStatus: http.StatusForbidden},
+		{name: "with token of authorized user", authorization: "Bearer admin", expectedStatus: http.StatusOK},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			handler := catalogapi.NewAuthenticatingHandler(newReviewingClient(),
+				http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+					writer.WriteHeader(http.StatusOK)
+				}))
+			request := httptest.NewRequest(http.MethodGet, "/catalog/namespaces/kcp-system/modules", nil)
+			if testCase.authorization != "" {
+				request.Header.Set("Authorization", testCase.authorization)
+			}
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testCase.expectedStatus, recorder.Code)
+		})
+	}
+}
+
+// newReviewingClient authenticates the tokens "reader" and "admin" as the users of the same name,
+// and only allows admin to get the catalog API paths.
+func newReviewingClient() client.Client {
+	return fake.NewClientBuilder().WithScheme(k8sclientscheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+			switch review := obj.(type) {
+			case *authenticationv1.TokenReview:
+				if review.Spec.Token == "reader" || review.Spec.Token == "admin" {
+					review.Status.Authenticated = true
+					review.Status.User.Username = review.Spec.Token
+				}
+			case *authorizationv1.SubjectAccessReview:
+				review.Status.Allowed = review.Spec.User == "admin" &&
+					review.Spec.NonResourceAttributes.Verb == "get"
+			}
+			return nil
+		},
+	}).Build()
+}
@@ -0,0 +1,326 @@
+package catalogapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/remote"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+const (
+	// PathPrefix is the path under which the catalog API is served.
+	PathPrefix = "/catalog/"
+	// KymaSpecSourceHeader is set on the responses for a Kyma to tell which spec of the Kyma they are based on.
+	KymaSpecSourceHeader = "X-Kyma-Spec-Source"
+	// KymaSpecSourceKCP marks responses based on the spec of the Kyma in the control plane only.
+	KymaSpecSourceKCP = "kcp"
+)
+
+type TemplateLookup interface {
+	GetRegularTemplates(ctx context.Context, kyma *v1beta2.Kyma) templatelookup.ModuleTemplatesByModuleName
+}
+
+// Handler serves the read-only catalog API:
+//   - GET /catalog/namespaces/{namespace}/modules lists the modules of the catalog, optionally only those
+//     visible to the Kyma given in the kyma query parameter,
+//   - GET /catalog/namespaces/{namespace}/kymas/{name}/modules resolves the ModuleTemplate of each module of the Kyma,
+//   - GET /catalog/namespaces/{namespace}/kymas/{name}/pending-upgrades lists the module upgrades of the Kyma
+//     waiting for the next maintenance window.
+type Handler struct {
+	client client.Reader
+	// templateLookup resolves the ModuleTemplates the same way as the Kyma controller.
+	templateLookup TemplateLookup
+	// upgradeLookup resolves the ModuleTemplates ignoring maintenance windows.
+	upgradeLookup TemplateLookup
+	mux           *http.ServeMux
+}
+
+func NewHandler(client client.Reader, templateLookup, upgradeLookup TemplateLookup) *Handler {
+	handler := &Handler{
+		client:         client,
+		templateLookup: templateLookup,
+		upgradeLookup:  upgradeLookup,
+		mux:            http.NewServeMux(),
+	}
+	handler.mux.HandleFunc("GET "+PathPrefix+"namespaces/{namespace}/modules", handler.listModules)
+	handler.mux.HandleFunc("GET "+PathPrefix+"namespaces/{namespace}/kymas/{name}/modules", handler.resolveModules)
+	handler.mux.HandleFunc("GET "+PathPrefix+"namespaces/{namespace}/kymas/{name}/pending-upgrades",
+		handler.listPendingUpgrades)
+	return handler
+}
+
+func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	h.mux.ServeHTTP(writer, request)
+}
+
+func (h *Handler) listModules(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	namespace := request.PathValue("namespace")
+	var kyma *v1beta2.Kyma
+	if kymaName := request.URL.Query().Get("kyma"); kymaName != "" {
+		var err error
+		if kyma, err = h.getKyma(ctx, types.NamespacedName{Namespace: namespace, Name: kymaName}); err != nil {
+			writeError(ctx, writer, err)
+			return
+		}
+		writer.Header().Set(KymaSpecSourceHeader, KymaSpecSourceKCP)
+	}
+
+	modules, err := h.getCatalog(ctx, namespace, kyma)
+	if err != nil {
+		writeError(ctx, writer, err)
+		return
+	}
+	writeJSON(ctx, writer, modules)
+}
+
+func (h *Handler) resolveModules(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	kyma, err := h.getKyma(ctx, types.NamespacedName{
+		Namespace: request.PathValue("namespace"), Name: request.PathValue("name"),
+	})
+	if err != nil {
+		writeError(ctx, writer, err)
+		return
+	}
+	writer.Header().Set(KymaSpecSourceHeader, KymaSpecSourceKCP)
+
+	templates := h.templateLookup.GetRegularTemplates(ctx, kyma)
+	modules := make([]ResolvedModule, 0, len(templates))
+	for _, moduleInfo := range templatelookup.FetchModuleInfo(kyma) {
+		templateInfo, found := templates[moduleInfo.Name]
+		if !found {
+			continue
+		}
+		module := ResolvedModule{
+			Name:             moduleInfo.Name,
+			Enabled:          moduleInfo.Enabled,
+			Channel:          templateInfo.DesiredChannel,
+			InstalledVersion: installedVersion(kyma, moduleInfo.Name),
+		}
+		if templateInfo.ModuleTemplate != nil {
+			module.Template = templateInfo.GetName()
+			module.Version = templateInfo.GetVersion()
+		}
+		if templateInfo.Err != nil {
+			module.Reason = templateInfo.Err.Error()
+		} else {
+			module.Resolved = true
+			if module.Reason, err = h.resolvedReason(ctx, kyma, moduleInfo, templateInfo); err != nil {
+				writeError(ctx, writer, err)
+				return
+			}
+		}
+		modules = append(modules, module)
+	}
+	writeJSON(ctx, writer, modules)
+}
+
+func (h *Handler) listPendingUpgrades(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	kyma, err := h.getKyma(ctx, types.NamespacedName{
+		Namespace: request.PathValue("namespace"), Name: request.PathValue("name"),
+	})
+	if err != nil {
+		writeError(ctx, writer, err)
+		return
+	}
+	writer.Header().Set(KymaSpecSourceHeader, KymaSpecSourceKCP)
+
+	templates := h.templateLookup.GetRegularTemplates(ctx, kyma)
+	var upgrades map[string]*templatelookup.ModuleTemplateInfo
+	pending := make([]PendingUpgrade, 0)
+	for _, moduleInfo := range templatelookup.FetchModuleInfo(kyma) {
+		templateInfo, found := templates[moduleInfo.Name]
+		if !found || !isPendingUpgrade(templateInfo.Err) {
+			continue
+		}
+		if upgrades == nil {
+			upgrades = h.upgradeLookup.GetRegularTemplates(ctx, kyma)
+		}
+		upgrade, found := upgrades[moduleInfo.Name]
+		if !found || upgrade.Err != nil || upgrade.ModuleTemplate == nil {
+			continue
+		}
+		pending = append(pending, PendingUpgrade{
+			Name:             moduleInfo.Name,
+			Channel:          upgrade.DesiredChannel,
+			InstalledVersion: installedVersion(kyma, moduleInfo.Name),
+			TargetVersion:    upgrade.GetVersion(),
+			Template:         upgrade.GetName(),
+			Reason:           templateInfo.Err.Error(),
+		})
+	}
+	writeJSON(ctx, writer, pending)
+}
+
+// isPendingUpgrade returns true if the upgrade of a module is held back until the next maintenance window or until it
+// is approved.
+func isPendingUpgrade(err error) bool {
+	return errors.Is(err, moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow) ||
+		errors.Is(err, moduletemplateinfolookup.ErrWaitingForUpgradeApproval)
+}
+
+// getKyma fetches the Kyma from the control plane. The spec of the remote Kyma is not merged, as the API must not
+// connect to the SKR clusters, so modules enabled or changed only in the remote Kyma are not taken into account.
+func (h *Handler) getKyma(ctx context.Context, name types.NamespacedName) (*v1beta2.Kyma, error) {
+	kyma := &v1beta2.Kyma{}
+	if err := h.client.Get(ctx, name, kyma); err != nil {
+		return nil, fmt.Errorf("failed to get Kyma %s: %w", name, err)
+	}
+	return kyma, nil
+}
+
+func (h *Handler) getCatalog(ctx context.Context, namespace string, kyma *v1beta2.Kyma) ([]CatalogModule, error) {
+	moduleReleaseMetas := &v1beta2.ModuleReleaseMetaList{}
+	if err := h.client.List(ctx, moduleReleaseMetas, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ModuleReleaseMetas: %w", err)
+	}
+	moduleTemplates := &v1beta2.ModuleTemplateList{}
+	if err := h.client.List(ctx, moduleTemplates, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ModuleTemplates: %w", err)
+	}
+
+	templatesByModule := map[string][]*v1beta2.ModuleTemplate{}
+	for i := range moduleTemplates.Items {
+		template := &moduleTemplates.Items[i]
+		moduleName := templatelookup.GetModuleName(template)
+		templatesByModule[moduleName] = append(templatesByModule[moduleName], template)
+	}
+	moduleReleaseMetaByModule := map[string]*v1beta2.ModuleReleaseMeta{}
+	for i := range moduleReleaseMetas.Items {
+		moduleReleaseMetaByModule[moduleReleaseMetas.Items[i].Spec.ModuleName] = &moduleReleaseMetas.Items[i]
+	}
+
+	moduleNames := make([]string, 0, len(templatesByModule))
+	for moduleName := range templatesByModule {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	for moduleName := range moduleReleaseMetaByModule {
+		if _, found := templatesByModule[moduleName]; !found {
+			moduleNames = append(moduleNames, moduleName)
+		}
+	}
+	slices.Sort(moduleNames)
+
+	modules := make([]CatalogModule, 0, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		module, visible := newCatalogModule(moduleName, moduleReleaseMetaByModule[moduleName],
+			templatesByModule[moduleName], kyma)
+		if visible {
+			modules = append(modules, module)
+		}
+	}
+	return modules, nil
+}
+
+// newCatalogModule builds the catalog entry of a module. It is not visible for the given Kyma if the module is
+// beta or internal and the Kyma is not.
+func newCatalogModule(moduleName string, moduleReleaseMeta *v1beta2.ModuleReleaseMeta,
+	templates []*v1beta2.ModuleTemplate, kyma *v1beta2.Kyma,
+) (CatalogModule, bool) {
+	module := CatalogModule{Name: moduleName}
+	for _, template := range templates {
+		module.Templates = append(module.Templates, TemplateReference{
+			Name: template.GetName(), Version: template.GetVersion(), Mandatory: template.IsMandatory(),
+		})
+	}
+
+	if moduleReleaseMeta != nil {
+		module.ModuleReleaseMeta = moduleReleaseMeta.GetName()
+		module.Beta = moduleReleaseMeta.IsBeta()
+		module.Internal = moduleReleaseMeta.IsInternal()
+		for _, assignment := range moduleReleaseMeta.Spec.Channels {
+			channel := ChannelAssignment{Channel: assignment.Channel, Version: assignment.Version}
+			if template := findTemplate(templates, assignment.Version); template != nil {
+				channel.Template = template.GetName()
+			}
+			module.Channels = append(module.Channels, channel)
+		}
+		return module, kyma == nil || remote.IsAllowedModuleReleaseMeta(*moduleReleaseMeta, kyma)
+	}
+
+	visible := kyma == nil
+	for _, template := range templates {
+		if template.Spec.Channel == "" || template.IsMandatory() {
+			continue
+		}
+		module.Beta = module.Beta || template.IsBeta()
+		module.Internal = module.Internal || template.IsInternal()
+		module.Channels = append(module.Channels, ChannelAssignment{
+			Channel: template.Spec.Channel, Version: template.GetVersion(), Template: template.GetName(),
+		})
+		if kyma != nil && templatelookup.ValidateTemplateMode(
+			templatelookup.ModuleTemplateInfo{ModuleTemplate: template}, kyma, nil).Err == nil {
+			visible = true
+		}
+	}
+	return module, visible
+}
+
+func (h *Handler) resolvedReason(ctx context.Context, kyma *v1beta2.Kyma, moduleInfo templatelookup.ModuleInfo,
+	templateInfo *templatelookup.ModuleTemplateInfo,
+) (string, error) {
+	if moduleInfo.IsInstalledByVersion() {
+		return fmt.Sprintf("version %s is requested", moduleInfo.Version), nil
+	}
+	moduleReleaseMeta, err := templatelookup.GetModuleReleaseMeta(ctx, h.client, moduleInfo.Name, kyma.Namespace)
+	if util.IsNotFound(err) {
+		return fmt.Sprintf("ModuleTemplate %s is assigned to channel %s",
+			templateInfo.GetName(), templateInfo.DesiredChannel), nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("version %s is assigned to channel %s in ModuleReleaseMeta %s",
+		templateInfo.GetVersion(), templateInfo.DesiredChannel, moduleReleaseMeta.GetName()), nil
+}
+
+func findTemplate(templates []*v1beta2.ModuleTemplate, version string) *v1beta2.ModuleTemplate {
+	for _, template := range templates {
+		if template.GetVersion() == version {
+			return template
+		}
+	}
+	return nil
+}
+
+func installedVersion(kyma *v1beta2.Kyma, moduleName string) string {
+	for _, moduleStatus := range kyma.Status.Modules {
+		if moduleStatus.Name == moduleName {
+			return moduleStatus.Version
+		}
+	}
+	return ""
+}
+
+func writeJSON(ctx context.Context, writer http.ResponseWriter, body any) {
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(body); err != nil {
+		logf.FromContext(ctx).Error(err, "failed to write catalog API response")
+	}
+}
+
+func writeError(ctx context.Context, writer http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if util.IsNotFound(err) {
+		status = http.StatusNotFound
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	if err := json.NewEncoder(writer).Encode(errorResponse{Error: err.Error()}); err != nil {
+		logf.FromContext(ctx).Error(err, "failed to write catalog API response")
+	}
+}
@@ -0,0 +1,200 @@
+package catalogapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/catalogapi"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+)
+
+const namespace = "kcp-system"
+
+func TestHandler_ListModules(t *testing.T) {
+	t.Parallel()
+	betaModuleReleaseMeta := newModuleReleaseMeta("serverless",
+		v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "1.0.0"})
+	betaModuleReleaseMeta.Spec.Beta = true
+	legacyTemplate := newModuleTemplate("keda", "2.0.0")
+	legacyTemplate.Spec.Channel = "fast"
+	handler := catalogapi.NewHandler(newFakeClient(t,
+		newKyma(),
+		newModuleReleaseMeta("istio", v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "1.0.0"},
+			v1beta2.ChannelVersionAssignment{Channel: "fast", Version: "1.1.0"}),
+		newModuleTemplate("istio", "1.0.0"),
+		betaModuleReleaseMeta,
+		newModuleTemplate("serverless", "1.0.0"),
+		legacyTemplate,
+	), nil, nil)
+
+	var modules []catalogapi.CatalogModule
+	get(t, handler, "/catalog/namespaces/kcp-system/modules", http.StatusOK, &modules)
+
+	require.Len(t, modules, 3)
+	assert.Equal(t, catalogapi.CatalogModule{
+		Name:              "istio",
+		ModuleReleaseMeta: "istio",
+		Channels: []catalogapi.ChannelAssignment{
+			{Channel: "regular", Version: "1.0.0", Template: "istio-1.0.0"},
+			{Channel: "fast", Version: "1.1.0"},
+		},
+		Templates: []catalogapi.TemplateReference{{Name: "istio-1.0.0", Version: "1.0.0"}},
+	}, modules[0])
+	assert.Equal(t, []catalogapi.ChannelAssignment{{Channel: "fast", Version: "2.0.0", Template: "keda-2.0.0"}},
+		modules[1].Channels)
+	assert.True(t, modules[2].Beta)
+
+	get(t, handler, "/catalog/namespaces/kcp-system/modules?kyma=kyma", http.StatusOK, &modules)
+
+	assert.Equal(t, []string{"istio", "keda"}, catalogModuleNames(modules))
+}
+
+func TestHandler_ResolveModules(t *testing.T) {
+	t.Parallel()
+	kyma := newKyma(v1beta2.Module{Name: "istio"}, v1beta2.Module{Name: "keda"})
+	kyma.Status.Modules = []v1beta2.ModuleStatus{{Name: "istio", Version: "0.9.0"}}
+	lookup := templateLookupStub{
+		"istio": {ModuleTemplate: newModuleTemplate("istio", "1.0.0"), DesiredChannel: "regular"},
+		"keda":  {Err: templatelookup.ErrTemplateNotAllowed, DesiredChannel: "regular"},
+	}
+	handler := catalogapi.NewHandler(newFakeClient(t, kyma,
+		newModuleReleaseMeta("istio", v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "1.0.0"}),
+	), lookup, nil)
+
+	var modules []catalogapi.ResolvedModule
+	header := get(t, handler, "/catalog/namespaces/kcp-system/kymas/kyma/modules", http.StatusOK, &modules)
+
+	assert.Equal(t, catalogapi.KymaSpecSourceKCP, header.Get(catalogapi.KymaSpecSourceHeader))
+
+	assert.Equal(t, []catalogapi.ResolvedModule{
+		{
+			Name:             "istio",
+			Enabled:          true,
+			Channel:          "regular",
+			Template:         "istio-1.0.0",
+			Version:          "1.0.0",
+			InstalledVersion: "0.9.0",
+			Resolved:         true,
+			Reason:           "version 1.0.0 is assigned to channel regular in ModuleReleaseMeta istio",
+		},
+		{
+			Name:    "keda",
+			Enabled: true,
+			Channel: "regular",
+			Reason:  templatelookup.ErrTemplateNotAllowed.Error(),
+		},
+	}, modules)
+}
+
+func TestHandler_ListPendingUpgrades(t *testing.T) {
+	t.Parallel()
+	kyma := newKyma(v1beta2.Module{Name: "istio"}, v1beta2.Module{Name: "keda"})
+	kyma.Status.Modules = []v1beta2.ModuleStatus{{Name: "istio", Version: "1.0.0"}}
+	lookup := templateLookupStub{
+		"istio": {Err: moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow, DesiredChannel: "regular"},
+		"keda":  {ModuleTemplate: newModuleTemplate("keda", "1.0.0"), DesiredChannel: "regular"},
+	}
+	upgradeLookup := templateLookupStub{
+		"istio": {ModuleTemplate: newModuleTemplate("istio", "1.1.0"), DesiredChannel: "regular"},
+		"keda":  {ModuleTemplate: newModuleTemplate("keda", "1.0.0"), DesiredChannel: "regular"},
+	}
+	handler := catalogapi.NewHandler(newFakeClient(t, kyma), lookup, upgradeLookup)
+
+	var upgrades []catalogapi.PendingUpgrade
+	header := get(t, handler, "/catalog/namespaces/kcp-system/kymas/kyma/pending-upgrades", http.StatusOK,
+		&upgrades)
+
+	assert.Equal(t, catalogapi.KymaSpecSourceKCP, header.Get(catalogapi.KymaSpecSourceHeader))
+
+	assert.Equal(t, []catalogapi.PendingUpgrade{{
+		Name:             "istio",
+		Channel:          "regular",
+		InstalledVersion: "1.0.0",
+		TargetVersion:    "1.1.0",
+		Template:         "istio-1.1.0",
+		Reason:           moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow.Error(),
+	}}, upgrades)
+}
+
+func TestHandler_KymaNotFound(t *testing.T) {
+	t.Parallel()
+	handler := catalogapi.NewHandler(newFakeClient(t), templateLookupStub{}, templateLookupStub{})
+
+	get(t, handler, "/catalog/namespaces/kcp-system/kymas/unknown/modules", http.StatusNotFound, nil)
+}
+
+type templateLookupStub map[string]templatelookup.ModuleTemplateInfo
+
+func (s templateLookupStub) GetRegularTemplates(_ context.Context,
+	_ *v1beta2.Kyma,
+) templatelookup.ModuleTemplatesByModuleName {
+	templates := templatelookup.ModuleTemplatesByModuleName{}
+	for name, templateInfo := range s {
+		templates[name] = &templateInfo
+	}
+	return templates
+}
+
+func get(t *testing.T, handler http.Handler, path string, expectedStatus int, body any) http.Header {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	require.Equal(t, expectedStatus, recorder.Code, recorder.Body.String())
+	if body != nil {
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), body))
+	}
+	return recorder.Header()
+}
+
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func newKyma(modules ...v1beta2.Module) *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "kyma", Namespace: namespace},
+		Spec:       v1beta2.KymaSpec{Channel: "regular", Modules: modules},
+	}
+}
+
+func newModuleReleaseMeta(moduleName string, channels ...v1beta2.ChannelVersionAssignment) *v1beta2.ModuleReleaseMeta {
+	return &v1beta2.ModuleReleaseMeta{
+		ObjectMeta: apimetav1.ObjectMeta{Name: moduleName, Namespace: namespace},
+		Spec:       v1beta2.ModuleReleaseMetaSpec{ModuleName: moduleName, Channels: channels},
+	}
+}
+
+func newModuleTemplate(moduleName, version string) *v1beta2.ModuleTemplate {
+	return &v1beta2.ModuleTemplate{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      moduleName + "-" + version,
+			Namespace: namespace,
+			Labels:    map[string]string{shared.ModuleName: moduleName},
+		},
+		Spec: v1beta2.ModuleTemplateSpec{ModuleName: moduleName, Version: version},
+	}
+}
+
+func catalogModuleNames(modules []catalogapi.CatalogModule) []string {
+	names := make([]string, 0, len(modules))
+	for _, module := range modules {
+		names = append(names, module.Name)
+	}
+	return names
+}
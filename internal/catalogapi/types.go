@@ -0,0 +1,53 @@
+package catalogapi
+
+// CatalogModule is a module offered in the catalog of the control plane.
+type CatalogModule struct {
+	Name string `json:"name"`
+	// ModuleReleaseMeta is the name of the ModuleReleaseMeta of the module, empty if the channels are assigned
+	// through the deprecated spec.channel of the ModuleTemplates.
+	ModuleReleaseMeta string              `json:"moduleReleaseMeta,omitempty"`
+	Beta              bool                `json:"beta,omitempty"`
+	Internal          bool                `json:"internal,omitempty"`
+	Channels          []ChannelAssignment `json:"channels,omitempty"`
+	Templates         []TemplateReference `json:"templates,omitempty"`
+}
+
+type ChannelAssignment struct {
+	Channel string `json:"channel"`
+	Version string `json:"version"`
+	// Template is empty if there is no ModuleTemplate for the version.
+	Template string `json:"template,omitempty"`
+}
+
+type TemplateReference struct {
+	Name      string `json:"name"`
+	Version   string `json:"version,omitempty"`
+	Mandatory bool   `json:"mandatory,omitempty"`
+}
+
+// ResolvedModule is the ModuleTemplate resolved for a module of a Kyma, with the reason it was chosen or rejected.
+type ResolvedModule struct {
+	Name string `json:"name"`
+	// Enabled is false for modules which are only listed in the status of the Kyma, as they are being removed.
+	Enabled          bool   `json:"enabled"`
+	Channel          string `json:"channel,omitempty"`
+	Template         string `json:"template,omitempty"`
+	Version          string `json:"version,omitempty"`
+	InstalledVersion string `json:"installedVersion,omitempty"`
+	Resolved         bool   `json:"resolved"`
+	Reason           string `json:"reason"`
+}
+
+// PendingUpgrade is an upgrade of a module of a Kyma which waits for the next maintenance window.
+type PendingUpgrade struct {
+	Name             string `json:"name"`
+	Channel          string `json:"channel,omitempty"`
+	InstalledVersion string `json:"installedVersion,omitempty"`
+	TargetVersion    string `json:"targetVersion"`
+	Template         string `json:"template"`
+	Reason           string `json:"reason"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
@@ -11,6 +11,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
+	"github.com/kyma-project/lifecycle-manager/pkg/queue"
 )
 
 var ErrSecretNotFound = errors.New("root secret not found")
@@ -25,12 +26,14 @@ type (
 type Reconciler struct {
 	getRootSecret GetterFunc
 	handler       Handler
+	queue.RequeueIntervals
 }
 
-func NewReconciler(getSecretFunc GetterFunc, handler Handler) *Reconciler {
+func NewReconciler(getSecretFunc GetterFunc, handler Handler, requeueIntervals queue.RequeueIntervals) *Reconciler {
 	return &Reconciler{
-		getRootSecret: getSecretFunc,
-		handler:       handler,
+		getRootSecret:    getSecretFunc,
+		handler:          handler,
+		RequeueIntervals: requeueIntervals,
 	}
 }
 
@@ -50,5 +53,6 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, fmt.Errorf("failed to manage gateway secret: %w", err)
 	}
 
-	return ctrl.Result{}, nil
+	// requeue to drop the previous CA from the gateway secret once the rotation grace period is over
+	return ctrl.Result{RequeueAfter: r.Success}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/kyma-project/lifecycle-manager/internal/controller/istiogatewaysecret"
+	"github.com/kyma-project/lifecycle-manager/pkg/queue"
 )
 
 func TestReconcile_WhenGetSecretFuncReturnsError_ReturnError(t *testing.T) {
@@ -20,7 +22,7 @@ func TestReconcile_WhenGetSecretFuncReturnsError_ReturnError(t *testing.T) {
 		return nil, errors.New("some-error")
 	}
 	mockHandler := &mockHandler{}
-	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, mockHandler)
+	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, mockHandler, queue.RequeueIntervals{})
 
 	// ACT
 	_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{})
@@ -36,7 +38,7 @@ func TestReconcile_WhenGetSecretFuncReturnsNoErrorAndSecretIsNil_ReturnError(t *
 		return nil, nil
 	}
 	mockHandler := &mockHandler{}
-	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, mockHandler)
+	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, mockHandler, queue.RequeueIntervals{})
 
 	// ACT
 	_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{})
@@ -56,7 +58,7 @@ func TestReconcile_WhenGetSecretFuncIsCalled_IsCalledWithRequestNamespacedName(t
 		assert.Equal(t, request.Name, name.Name)
 		return nil, nil
 	}
-	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, &mockHandler{})
+	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, &mockHandler{}, queue.RequeueIntervals{})
 
 	// ACT
 	// ASSERT
@@ -70,7 +72,7 @@ func TestReconcile_WhenGetSecretFuncReturnsSecret_HandlerManageGatewaySecretIsCa
 		return secret, nil
 	}
 	mockHandler := &mockHandler{}
-	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, mockHandler)
+	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, mockHandler, queue.RequeueIntervals{})
 
 	// ACT
 	_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{})
@@ -80,6 +82,23 @@ func TestReconcile_WhenGetSecretFuncReturnsSecret_HandlerManageGatewaySecretIsCa
 	assert.Equal(t, 1, mockHandler.calls)
 }
 
+func TestReconcile_WhenHandlerManageGatewaySecretSucceeds_RequeueAfterSuccessInterval(t *testing.T) {
+	// ARRANGE
+	secret := &apicorev1.Secret{}
+	var stubGetterFunc istiogatewaysecret.GetterFunc = func(ctx context.Context, name types.NamespacedName) (*apicorev1.Secret, error) {
+		return secret, nil
+	}
+	requeueIntervals := queue.RequeueIntervals{Success: 30 * time.Second}
+	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, &mockHandler{}, requeueIntervals)
+
+	// ACT
+	result, err := reconciler.Reconcile(context.TODO(), ctrl.Request{})
+
+	// ASSERT
+	require.NoError(t, err)
+	assert.Equal(t, requeueIntervals.Success, result.RequeueAfter)
+}
+
 func TestReconcile_WhenHandlerManageGatewaySecretReturnsError_ReturnError(t *testing.T) {
 	// ARRANGE
 	secret := &apicorev1.Secret{}
@@ -87,7 +106,7 @@ func TestReconcile_WhenHandlerManageGatewaySecretReturnsError_ReturnError(t *tes
 		return secret, nil
 	}
 	mockHandler := &mockHandler{err: errors.New("some-error")}
-	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, mockHandler)
+	reconciler := istiogatewaysecret.NewReconciler(stubGetterFunc, mockHandler, queue.RequeueIntervals{})
 
 	// ACT
 	_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{})
@@ -18,19 +18,22 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/gatewaysecret"
 	gatewaysecretclient "github.com/kyma-project/lifecycle-manager/internal/gatewaysecret/client"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
+	"github.com/kyma-project/lifecycle-manager/pkg/queue"
 )
 
-const (
-	controllerName    = "istio-controller"
-	kcpRootSecretName = "klm-watcher"
-)
+const controllerName = "istio-controller"
 
 var errCouldNotGetLastModifiedAt = errors.New("getting lastModifiedAt time failed")
 
-func SetupReconciler(mgr ctrl.Manager, flagVar *flags.FlagVar, options ctrlruntime.Options) error {
+func SetupReconciler(mgr ctrl.Manager, flagVar *flags.FlagVar, options ctrlruntime.Options,
+	rotationMetrics gatewaysecret.RotationMetrics,
+) error {
 	options.MaxConcurrentReconciles = flagVar.MaxConcurrentWatcherReconciles
 
-	clnt := gatewaysecretclient.NewGatewaySecretRotationClient(mgr.GetConfig())
+	var clnt gatewaysecret.Client = gatewaysecretclient.NewGatewaySecretRotationClient(mgr.GetConfig())
+	if flagVar.CertificateProvider == flags.CertificateProviderBuiltin {
+		clnt = gatewaysecretclient.NewCASecretRotationClient(mgr.GetConfig())
+	}
 	var parseLastModifiedFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
 		if gwSecretLastModifiedAtValue, ok := secret.Annotations[shared.LastModifiedAtAnnotation]; ok {
 			if gwSecretLastModifiedAt, err := time.Parse(time.RFC3339, gwSecretLastModifiedAtValue); err == nil {
@@ -39,7 +42,8 @@ func SetupReconciler(mgr ctrl.Manager, flagVar *flags.FlagVar, options ctrlrunti
 		}
 		return time.Time{}, errCouldNotGetLastModifiedAt
 	}
-	handler := gatewaysecret.NewGatewaySecretHandler(clnt, parseLastModifiedFunc)
+	handler := gatewaysecret.NewGatewaySecretHandler(clnt, parseLastModifiedFunc, flagVar.CARotationGracePeriod,
+		rotationMetrics)
 
 	var getSecretFunc GetterFunc = func(ctx context.Context, name types.NamespacedName) (*apicorev1.Secret, error) {
 		secret := &apicorev1.Secret{}
@@ -51,7 +55,9 @@ func SetupReconciler(mgr ctrl.Manager, flagVar *flags.FlagVar, options ctrlrunti
 		return secret, nil
 	}
 
-	return NewReconciler(getSecretFunc, handler).setupWithManager(mgr, options)
+	return NewReconciler(getSecretFunc, handler, queue.RequeueIntervals{
+		Success: flagVar.WatcherRequeueSuccessInterval,
+	}).setupWithManager(mgr, options)
 }
 
 func (r *Reconciler) setupWithManager(mgr ctrl.Manager, opts ctrlruntime.Options) error {
@@ -79,5 +85,5 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager, opts ctrlruntime.Options
 }
 
 func isRootSecret(object client.Object) bool {
-	return object.GetNamespace() == shared.IstioNamespace && object.GetName() == kcpRootSecretName
+	return object.GetNamespace() == shared.IstioNamespace && object.GetName() == shared.RootCASecretName
 }
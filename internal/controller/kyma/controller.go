@@ -20,9 +20,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -32,11 +34,14 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
 	"github.com/kyma-project/lifecycle-manager/internal/event"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/parser"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/internal/remote"
+	"github.com/kyma-project/lifecycle-manager/internal/tracing"
 	"github.com/kyma-project/lifecycle-manager/pkg/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	"github.com/kyma-project/lifecycle-manager/pkg/module/sync"
@@ -70,9 +75,11 @@ type Reconciler struct {
 	InKCPMode           bool
 	RemoteSyncNamespace string
 	IsManagedKyma       bool
+	IsSharded           bool
 	Metrics             *metrics.KymaMetrics
 	RemoteCatalog       *remote.RemoteCatalog
 	TemplateLookup      *templatelookup.TemplateLookup
+	AuditTrail          *audit.Trail
 }
 
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=kymas,verbs=get;list;watch;create;update;patch;delete
@@ -84,6 +91,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=moduletemplates,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=modulereleasemetas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=operator.kyma-project.io,resources=upgradeapprovals,verbs=get;list;watch
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=moduletemplates/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch
@@ -91,6 +99,13 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=update
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := tracing.Start(ctx, "Kyma.Reconcile", tracing.Kyma(req.NamespacedName))
+	result, err := r.reconcileRequest(ctx, req)
+	tracing.End(span, err)
+	return result, err
+}
+
+func (r *Reconciler) reconcileRequest(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := logf.FromContext(ctx)
 	logger.V(log.DebugLevel).Info("Kyma reconciliation started")
 
@@ -120,7 +135,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	if r.SyncKymaEnabled(kyma) {
-		err := r.SkrContextFactory.Init(ctx, kyma.GetNamespacedName())
+		initCtx, span := tracing.Start(ctx, "Kyma.InitSkrContext")
+		err := r.SkrContextFactory.Init(initCtx, kyma.GetNamespacedName())
+		tracing.End(span, err)
 		if !kyma.DeletionTimestamp.IsZero() && errors.Is(err, common.ErrAccessSecretNotFound) {
 			return r.handleDeletedSkr(ctx, kyma)
 		}
@@ -188,7 +205,11 @@ func (r *Reconciler) reconcile(ctx context.Context, kyma *v1beta2.Kyma) (ctrl.Re
 	}
 
 	if r.SyncKymaEnabled(kyma) {
-		updateRequired, err := r.SyncRemoteCrds.Execute(ctx, kyma)
+		crdsCtx, span := tracing.Start(ctx, "Kyma.SyncRemoteCrds")
+		start := time.Now()
+		updateRequired, err := r.SyncRemoteCrds.Execute(crdsCtx, kyma)
+		r.Metrics.ObservePhase(kyma.Name, metrics.KymaPhaseCrdSync, time.Since(start), err)
+		tracing.End(span, err)
 		if err != nil {
 			r.Metrics.RecordRequeueReason(metrics.CrdsSync, queue.UnexpectedRequeue)
 			return r.requeueWithError(ctx, kyma, fmt.Errorf("could not sync CRDs: %w", err))
@@ -202,21 +223,29 @@ func (r *Reconciler) reconcile(ctx context.Context, kyma *v1beta2.Kyma) (ctrl.Re
 			return ctrl.Result{Requeue: true}, nil
 		}
 		// update the control-plane kyma with the changes to the spec of the remote Kyma
-		if err = r.replaceSpecFromRemote(ctx, kyma); err != nil {
+		specCtx, span := tracing.Start(ctx, "Kyma.ReplaceSpecFromRemote")
+		err = r.replaceSpecFromRemote(specCtx, kyma)
+		tracing.End(span, err)
+		if err != nil {
 			r.Metrics.RecordRequeueReason(metrics.SpecReplacementFromRemote, queue.UnexpectedRequeue)
 			return r.requeueWithError(ctx, kyma, fmt.Errorf("could not replace control plane kyma spec"+
 				" with remote kyma spec: %w", err))
 		}
 	}
 
-	res, err := r.processKymaState(ctx, kyma)
+	stateCtx, span := tracing.Start(ctx, "Kyma.ProcessState", tracing.StateKey.String(string(kyma.Status.State)))
+	res, err := r.processKymaState(stateCtx, kyma)
+	tracing.End(span, err)
 	if err != nil {
 		r.Metrics.RecordRequeueReason(metrics.ProcessingKymaState, queue.UnexpectedRequeue)
 		return ctrl.Result{}, err
 	}
 
 	if r.SyncKymaEnabled(kyma) {
-		if err := r.syncStatusToRemote(ctx, kyma); err != nil {
+		statusCtx, span := tracing.Start(ctx, "Kyma.SyncStatusToRemote")
+		err := r.syncStatusToRemote(statusCtx, kyma)
+		tracing.End(span, err)
+		if err != nil {
 			r.Metrics.RecordRequeueReason(metrics.StatusSyncToRemote, queue.UnexpectedRequeue)
 			return r.requeueWithError(ctx, kyma, fmt.Errorf("could not synchronize remote kyma status: %w", err))
 		}
@@ -288,7 +317,8 @@ func (r *Reconciler) ValidateDefaultChannel(kyma *v1beta2.Kyma) error {
 	return nil
 }
 
-// replaceSpecFromRemote replaces the spec from control-lane Kyma with the remote Kyma spec as single source of truth.
+// replaceSpecFromRemote replaces the spec from control-plane Kyma with the remote Kyma spec, except for the fields
+// controlled by the provisioner.
 func (r *Reconciler) replaceSpecFromRemote(ctx context.Context, controlPlaneKyma *v1beta2.Kyma) error {
 	remoteKyma, err := r.fetchRemoteKyma(ctx, controlPlaneKyma)
 	if err != nil {
@@ -299,7 +329,17 @@ func (r *Reconciler) replaceSpecFromRemote(ctx context.Context, controlPlaneKyma
 		return err
 	}
 
-	remote.ReplaceSpec(controlPlaneKyma, remoteKyma)
+	conflicts := remote.MergeSpec(controlPlaneKyma, remoteKyma)
+	controlPlaneKyma.UpdateSpecConflictCondition(conflicts)
+	if len(conflicts) > 0 {
+		skrContext, err := r.SkrContextFactory.Get(controlPlaneKyma.GetNamespacedName())
+		if err != nil {
+			return fmt.Errorf("failed to get skrContext: %w", err)
+		}
+		if err := skrContext.ReportSpecConflicts(ctx, controlPlaneKyma, remoteKyma, conflicts); err != nil {
+			logf.FromContext(ctx).V(log.InfoLevel).Error(err, "failed to report spec conflicts")
+		}
+	}
 
 	if err := r.ValidateDefaultChannel(controlPlaneKyma); err != nil {
 		return err
@@ -336,6 +376,17 @@ func (r *Reconciler) handleInitialState(ctx context.Context, kyma *v1beta2.Kyma)
 	return ctrl.Result{Requeue: true}, nil
 }
 
+func updateOptionalModulesCondition(kyma *v1beta2.Kyma) {
+	switch {
+	case !kyma.HasOptionalModules():
+		meta.RemoveStatusCondition(&kyma.Status.Conditions, string(v1beta2.ConditionTypeOptionalModules))
+	case kyma.AllOptionalModulesReady():
+		kyma.UpdateCondition(v1beta2.ConditionTypeOptionalModules, apimetav1.ConditionTrue)
+	default:
+		kyma.UpdateCondition(v1beta2.ConditionTypeOptionalModules, apimetav1.ConditionFalse)
+	}
+}
+
 func (r *Reconciler) handleProcessingState(ctx context.Context, kyma *v1beta2.Kyma) (ctrl.Result, error) {
 	logger := logf.FromContext(ctx)
 	var errGroup errgroup.Group
@@ -350,12 +401,17 @@ func (r *Reconciler) handleProcessingState(ctx context.Context, kyma *v1beta2.Ky
 		} else {
 			kyma.UpdateCondition(v1beta2.ConditionTypeModules, apimetav1.ConditionFalse)
 		}
+		updateOptionalModulesCondition(kyma)
+		kyma.UpdateModulesSummary()
 		return nil
 	})
 
 	if r.SyncKymaEnabled(kyma) {
 		errGroup.Go(func() error {
-			if err := r.RemoteCatalog.SyncModuleCatalog(ctx, kyma); err != nil {
+			start := time.Now()
+			err := r.RemoteCatalog.SyncModuleCatalog(ctx, kyma)
+			r.Metrics.ObservePhase(kyma.Name, metrics.KymaPhaseCatalogSync, time.Since(start), err)
+			if err != nil {
 				r.Metrics.RecordRequeueReason(metrics.ModuleCatalogSync, queue.UnexpectedRequeue)
 				kyma.UpdateCondition(v1beta2.ConditionTypeModuleCatalog, apimetav1.ConditionFalse)
 				return fmt.Errorf("failed to synchronize remote module catalog: %w", err)
@@ -367,7 +423,10 @@ func (r *Reconciler) handleProcessingState(ctx context.Context, kyma *v1beta2.Ky
 
 	if r.WatcherEnabled(kyma) {
 		errGroup.Go(func() error {
-			if err := r.SKRWebhookManager.Install(ctx, kyma); err != nil {
+			start := time.Now()
+			err := r.SKRWebhookManager.Install(ctx, kyma)
+			r.Metrics.ObservePhase(kyma.Name, metrics.KymaPhaseWebhookInstall, time.Since(start), err)
+			if err != nil {
 				r.Metrics.RecordRequeueReason(metrics.SkrWebhookResourcesInstallation, queue.UnexpectedRequeue)
 				if errors.Is(err, &watcher.CertificateNotReadyError{}) {
 					kyma.UpdateCondition(v1beta2.ConditionTypeSKRWebhook, apimetav1.ConditionFalse)
@@ -401,6 +460,29 @@ func (r *Reconciler) handleProcessingState(ctx context.Context, kyma *v1beta2.Ky
 func (r *Reconciler) handleDeletingState(ctx context.Context, kyma *v1beta2.Kyma) (ctrl.Result, error) {
 	logger := logf.FromContext(ctx).V(log.InfoLevel)
 
+	blockingResources, err := r.getDeletionBlockingResources(ctx, kyma)
+	if err != nil {
+		r.Metrics.RecordRequeueReason(metrics.KymaDeletionBlocked, queue.UnexpectedRequeue)
+		return ctrl.Result{}, err
+	}
+	if len(blockingResources) > 0 {
+		kyma.Status.DeletionBlockedBy = blockingResources
+		if err := r.updateStatus(ctx, kyma, shared.StateDeleting,
+			fmt.Sprintf("deletion blocked by %d custom resources", len(blockingResources))); err != nil {
+			r.Metrics.RecordRequeueReason(metrics.KymaDeletionBlocked, queue.UnexpectedRequeue)
+			return ctrl.Result{}, err
+		}
+		r.Metrics.RecordRequeueReason(metrics.KymaDeletionBlocked, queue.IntendedRequeue)
+		return ctrl.Result{RequeueAfter: r.RequeueIntervals.Busy}, nil
+	}
+	if len(kyma.Status.DeletionBlockedBy) > 0 {
+		kyma.Status.DeletionBlockedBy = nil
+		if err := r.updateStatus(ctx, kyma, shared.StateDeleting, "waiting for modules to be deleted"); err != nil {
+			r.Metrics.RecordRequeueReason(metrics.KymaDeletionBlocked, queue.UnexpectedRequeue)
+			return ctrl.Result{}, err
+		}
+	}
+
 	if r.WatcherEnabled(kyma) {
 		if err := r.SKRWebhookManager.Remove(ctx, kyma); err != nil {
 			return ctrl.Result{}, err
@@ -458,21 +540,94 @@ func (r *Reconciler) cleanupManifestCRs(ctx context.Context, kyma *v1beta2.Kyma)
 		return nil
 	}
 
-	if err = r.deleteManifests(ctx, relatedManifests); err != nil {
+	if err = r.deleteManifests(ctx, kyma, relatedManifests); err != nil {
 		return fmt.Errorf("error while trying to delete manifests: %w", err)
 	}
 	return ErrManifestsStillExist
 }
 
-func (r *Reconciler) deleteManifests(ctx context.Context, manifests []v1beta2.Manifest) error {
+// deleteManifests deletes the manifests according to the DeletionPolicy of their modules.
+// The manifests of modules with the Orphan DeletionPolicy are marked as unmanaged instead, so that the manifest
+// controller removes the managed-by labels from the module resources before it deletes the manifest.
+func (r *Reconciler) deleteManifests(ctx context.Context, kyma *v1beta2.Kyma, manifests []v1beta2.Manifest) error {
 	for i := range manifests {
-		if err := r.Delete(ctx, &manifests[i]); client.IgnoreNotFound(err) != nil {
+		manifest := &manifests[i]
+		if kyma.GetModuleDeletionPolicy(manifest.GetLabels()[shared.ModuleName]) == v1beta2.DeletionPolicyOrphan {
+			if err := r.orphanManifest(ctx, manifest); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("error while trying to orphan manifest: %w", err)
+			}
+			continue
+		}
+		if err := r.Delete(ctx, manifest); client.IgnoreNotFound(err) != nil {
 			return fmt.Errorf("error while trying to delete manifest: %w", err)
 		}
 	}
 	return nil
 }
 
+func (r *Reconciler) orphanManifest(ctx context.Context, manifest *v1beta2.Manifest) error {
+	if manifest.IsUnmanaged() {
+		return nil
+	}
+	annotations := manifest.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[shared.UnmanagedAnnotation] = shared.EnableLabelValue
+	manifest.SetAnnotations(annotations)
+	return r.Update(ctx, manifest)
+}
+
+// getDeletionBlockingResources returns the user-created custom resources of all modules
+// with the BlockIfCustomResourcesExist DeletionPolicy.
+func (r *Reconciler) getDeletionBlockingResources(ctx context.Context,
+	kyma *v1beta2.Kyma,
+) ([]v1beta2.DeletionBlockingResource, error) {
+	manifests, err := r.getRelatedManifestCRs(ctx, kyma)
+	if err != nil {
+		return nil, fmt.Errorf("error while trying to get manifests: %w", err)
+	}
+
+	var moduleCRClient *modulecr.Client
+	var blockingResources []v1beta2.DeletionBlockingResource
+	for i := range manifests {
+		manifest := &manifests[i]
+		moduleName := manifest.GetLabels()[shared.ModuleName]
+		// a manifest under deletion cannot block the deletion anymore
+		if !manifest.GetDeletionTimestamp().IsZero() ||
+			kyma.GetModuleDeletionPolicy(moduleName) != v1beta2.DeletionPolicyBlockIfCustomResourcesExist {
+			continue
+		}
+		if moduleCRClient == nil {
+			if moduleCRClient, err = r.getModuleCRClient(kyma); err != nil {
+				return nil, err
+			}
+		}
+		resources, err := moduleCRClient.GetUserCreatedCRs(ctx, manifest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get custom resources of module %s: %w", moduleName, err)
+		}
+		for _, resource := range resources {
+			blockingResources = append(blockingResources, v1beta2.DeletionBlockingResource{
+				Module:   moduleName,
+				Resource: resource,
+			})
+		}
+	}
+	return blockingResources, nil
+}
+
+func (r *Reconciler) getModuleCRClient(kyma *v1beta2.Kyma) (*modulecr.Client, error) {
+	if !r.SyncKymaEnabled(kyma) {
+		return modulecr.NewClient(r.Client), nil
+	}
+	skrContext, err := r.SkrContextFactory.Get(kyma.GetNamespacedName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skrContext: %w", err)
+	}
+	return modulecr.NewClient(skrContext.Client), nil
+}
+
 func (r *Reconciler) getRelatedManifestCRs(ctx context.Context, kyma *v1beta2.Kyma) ([]v1beta2.Manifest, error) {
 	manifestList := &v1beta2.ManifestList{}
 	labelSelector := k8slabels.SelectorFromSet(k8slabels.Set{shared.KymaName: kyma.Name})
@@ -484,6 +639,23 @@ func (r *Reconciler) getRelatedManifestCRs(ctx context.Context, kyma *v1beta2.Ky
 	return manifestList.Items, nil
 }
 
+// syncMandatoryModuleStatus reports the mandatory modules of the Kyma in its status, based on their Manifests.
+func (r *Reconciler) syncMandatoryModuleStatus(ctx context.Context, kyma *v1beta2.Kyma) error {
+	manifestList := &v1beta2.ManifestList{}
+	labelSelector := k8slabels.SelectorFromSet(k8slabels.Set{
+		shared.KymaName:          kyma.Name,
+		shared.IsMandatoryModule: shared.EnableLabelValue,
+	})
+	if err := r.List(ctx, manifestList, &client.ListOptions{
+		Namespace:     kyma.Namespace,
+		LabelSelector: labelSelector,
+	}); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to get mandatory module manifests, %w", err)
+	}
+	kyma.UpdateMandatoryModules(manifestList.Items)
+	return nil
+}
+
 func (r *Reconciler) relatedManifestCRsAreDeleted(manifests []v1beta2.Manifest) bool {
 	return len(manifests) == 0
 }
@@ -505,15 +677,25 @@ func (r *Reconciler) updateKyma(ctx context.Context, kyma *v1beta2.Kyma) error {
 }
 
 func (r *Reconciler) reconcileManifests(ctx context.Context, kyma *v1beta2.Kyma) error {
-	templates := r.TemplateLookup.GetRegularTemplates(ctx, kyma)
+	lookupCtx, span := tracing.Start(ctx, "Kyma.LookupTemplates")
+	start := time.Now()
+	templates := r.TemplateLookup.GetRegularTemplates(lookupCtx, kyma)
 	prsr := parser.NewParser(r.Client, r.DescriptorProvider, r.InKCPMode, r.RemoteSyncNamespace)
 	modules := prsr.GenerateModulesFromTemplates(kyma, templates)
+	r.Metrics.ObservePhase(kyma.Name, metrics.KymaPhaseTemplateLookup, time.Since(start), nil)
+	tracing.End(span, nil)
 
-	runner := sync.New(r)
-	if err := runner.ReconcileManifests(ctx, kyma, modules); err != nil {
+	runner := sync.New(r, r.AuditTrail.For(audit.ActorKymaController))
+	manifestsCtx, span := tracing.Start(ctx, "Kyma.ReconcileManifests")
+	err := runner.ReconcileManifests(manifestsCtx, kyma, modules)
+	tracing.End(span, err)
+	if err != nil {
 		return fmt.Errorf("sync failed: %w", err)
 	}
 	runner.SyncModuleStatus(ctx, kyma, modules, r.Metrics)
+	if err := r.syncMandatoryModuleStatus(ctx, kyma); err != nil {
+		return err
+	}
 	// If module get removed from kyma, the module deletion happens here.
 	if err := r.DeleteNoLongerExistingModules(ctx, kyma); err != nil {
 		return fmt.Errorf("error while syncing conditions during deleting non exists modules: %w", err)
@@ -551,6 +733,16 @@ func (r *Reconciler) DeleteNoLongerExistingModules(ctx context.Context, kyma *v1
 			continue
 		}
 		err = r.deleteManifest(ctx, moduleStatus.Manifest)
+		if err == nil && moduleStatus.State != shared.StateDeleting {
+			r.AuditTrail.For(audit.ActorKymaController).Record(ctx, audit.Record{
+				Operation:     audit.OperationDelete,
+				KymaNamespace: kyma.GetNamespace(),
+				KymaName:      kyma.GetName(),
+				Module:        moduleStatus.Name,
+				FromVersion:   moduleStatus.Version,
+				Reason:        "module is removed from the Kyma spec",
+			})
+		}
 	}
 
 	if client.IgnoreNotFound(err) != nil {
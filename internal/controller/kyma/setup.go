@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	watcherevent "github.com/kyma-project/runtime-watcher/listener/pkg/event"
 	"github.com/kyma-project/runtime-watcher/listener/pkg/types"
@@ -23,6 +24,8 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/watch"
+	"github.com/kyma-project/lifecycle-manager/pkg/log"
+	"github.com/kyma-project/lifecycle-manager/pkg/queue"
 	"github.com/kyma-project/lifecycle-manager/pkg/security"
 )
 
@@ -30,6 +33,8 @@ type SetupOptions struct {
 	ListenerAddr                 string
 	EnableDomainNameVerification bool
 	IstioNamespace               string
+	EnablePriorityQueue          bool
+	QueueMetrics                 queue.PriorityQueueMetrics
 }
 
 const controllerName = "kyma"
@@ -57,13 +62,18 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts ctrlruntime.Options
 	if err := mgr.Add(runnableListener); err != nil {
 		return fmt.Errorf("KymaReconciler %w", err)
 	}
+	if settings.EnablePriorityQueue {
+		opts.NewQueue = queue.NewPriorityQueueFunc(r.queuedKymaState, settings.QueueMetrics)
+	}
 	if err := ctrl.NewControllerManagedBy(mgr).For(&v1beta2.Kyma{}).
 		Named(controllerName).
 		WithOptions(opts).
-		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})).
+		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{},
+			watch.SKREventForwardedPredicate())).
 		Watches(&v1beta2.ModuleTemplate{},
 			handler.EnqueueRequestsFromMapFunc(watch.NewTemplateChangeHandler(r).Watch())).
 		Watches(&v1beta2.ModuleReleaseMeta{}, watch.NewModuleReleaseMetaEventHandler(r)).
+		Watches(&v1beta2.UpgradeApproval{}, handler.EnqueueRequestsFromMapFunc(watch.UpgradeApprovalToKyma)).
 		Watches(&apicorev1.Secret{}, handler.Funcs{}).
 		Watches(&v1beta2.Manifest{},
 			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &v1beta2.Kyma{},
@@ -79,7 +89,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts ctrlruntime.Options
 func (r *Reconciler) skrEventHandler() *handler.Funcs {
 	return &handler.Funcs{
 		GenericFunc: func(ctx context.Context, evnt event.GenericEvent,
-			queue workqueue.TypedRateLimitingInterface[ctrl.Request],
+			workQueue workqueue.TypedRateLimitingInterface[ctrl.Request],
 		) {
 			logger := ctrl.Log.WithName("listener")
 			unstructWatcherEvt, conversionOk := evnt.Object.(*unstructured.Unstructured)
@@ -101,14 +111,42 @@ func (r *Reconciler) skrEventHandler() *handler.Funcs {
 				return
 			}
 
+			// the cache of a sharded replica only contains the Kymas of its own shard,
+			// events of other shards are forwarded to the replica owning the Kyma
+			if r.IsSharded && !r.ownsKyma(ctx, ownerObjectKey) {
+				logger.V(log.DebugLevel).Info(
+					fmt.Sprintf("event received from SKR for %s of another shard, forwarding", ownerObjectKey))
+				if err := watch.ForwardSKREvent(ctx, r.Client, ownerObjectKey, time.Now()); err != nil {
+					logger.Error(err, "failed to forward event received from SKR")
+				}
+				return
+			}
+
 			logger.Info(
 				fmt.Sprintf("event received from SKR, adding %s to queue",
 					ownerObjectKey),
 			)
 
-			queue.Add(ctrl.Request{
+			queue.AddWithPriority(workQueue, ctrl.Request{
 				NamespacedName: ownerObjectKey,
-			})
+			}, queue.PriorityUserChange)
 		},
 	}
 }
+
+func (r *Reconciler) queuedKymaState(request ctrl.Request) queue.ObjectState {
+	kyma := &v1beta2.Kyma{}
+	if err := r.Get(context.Background(), request.NamespacedName, kyma); err != nil {
+		return queue.ObjectState{}
+	}
+	return queue.ObjectState{
+		Exists:     true,
+		Deleting:   !kyma.DeletionTimestamp.IsZero(),
+		Generation: kyma.GetGeneration(),
+		State:      kyma.Status.State,
+	}
+}
+
+func (r *Reconciler) ownsKyma(ctx context.Context, kymaKey client.ObjectKey) bool {
+	return r.Get(ctx, kymaKey, &v1beta2.Kyma{}) == nil
+}
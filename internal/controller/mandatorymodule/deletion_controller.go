@@ -28,6 +28,7 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
 	"github.com/kyma-project/lifecycle-manager/internal/event"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
@@ -45,6 +46,7 @@ type DeletionReconciler struct {
 	event.Event
 	queue.RequeueIntervals
 	DescriptorProvider *provider.CachedDescriptorProvider
+	AuditTrail         *audit.Trail
 }
 
 func (r *DeletionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -87,7 +89,7 @@ func (r *DeletionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, nil
 	}
 
-	if err := r.removeManifests(ctx, manifests); err != nil {
+	if err := r.removeManifests(ctx, template, manifests); err != nil {
 		r.Event.Warning(template, deletingManifestError, err)
 		return ctrl.Result{}, fmt.Errorf("failed to remove MandatoryModule Manifest: %w", err)
 	}
@@ -126,11 +128,26 @@ func (r *DeletionReconciler) getCorrespondingManifests(ctx context.Context,
 	return filtered, nil
 }
 
-func (r *DeletionReconciler) removeManifests(ctx context.Context, manifests []v1beta2.Manifest) error {
+func (r *DeletionReconciler) removeManifests(ctx context.Context, template *v1beta2.ModuleTemplate,
+	manifests []v1beta2.Manifest,
+) error {
+	auditRecorder := r.AuditTrail.For(audit.ActorMandatoryModuleDeletionController)
 	for _, manifest := range manifests {
 		if err := r.Delete(ctx, &manifest); err != nil {
 			return fmt.Errorf("not able to delete manifest %s/%s: %w", manifest.Namespace, manifest.Name, err)
 		}
+		// manifests already under deletion were recorded when they were first deleted
+		if manifest.DeletionTimestamp.IsZero() {
+			auditRecorder.Record(ctx, audit.Record{
+				Operation:     audit.OperationDelete,
+				KymaNamespace: manifest.GetNamespace(),
+				KymaName:      manifest.GetLabels()[shared.KymaName],
+				Module:        manifest.GetLabels()[shared.ModuleName],
+				FromVersion:   manifest.Spec.Version,
+				Trigger:       fmt.Sprintf("%s %s", shared.ModuleTemplateKind, template.GetName()),
+				Reason:        "mandatory ModuleTemplate is deleted",
+			})
+		}
 	}
 	logf.FromContext(ctx).V(log.DebugLevel).Info("Marked all MandatoryModule Manifests for deletion")
 	return nil
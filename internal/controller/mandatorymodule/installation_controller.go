@@ -18,13 +18,16 @@ package mandatorymodule
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/parser"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
@@ -43,6 +46,7 @@ type InstallationReconciler struct {
 	RemoteSyncNamespace string
 	InKCPMode           bool
 	Metrics             *metrics.MandatoryModulesMetrics
+	AuditTrail          *audit.Trail
 }
 
 func (r *InstallationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -64,18 +68,37 @@ func (r *InstallationReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{RequeueAfter: r.RequeueIntervals.Success}, nil
 	}
 
-	mandatoryTemplates, err := templatelookup.GetMandatory(ctx, r.Client)
+	mandatoryTemplates, err := templatelookup.GetMandatoryForKyma(ctx, r.Client, kyma)
 	if err != nil {
 		return emptyResultWithErr(err)
 	}
 	r.Metrics.RecordMandatoryTemplatesCount(len(mandatoryTemplates))
+	// tenants of a shared SKR cluster only install the cluster-scoped mandatory modules they own
+	templatelookup.MarkTenantConflicts(ctx, r.Client, kyma, mandatoryTemplates)
+	excludedModules := make(map[string]bool)
+	for name, template := range mandatoryTemplates {
+		switch {
+		case errors.Is(template.Err, templatelookup.ErrClusterScopedModuleConflict):
+			delete(mandatoryTemplates, name)
+		case errors.Is(template.Err, templatelookup.ErrMandatoryModuleExcluded):
+			excludedModules[name] = true
+			delete(mandatoryTemplates, name)
+		case template.Err != nil:
+			// the module is kept as is on the Kyma until its rollout can be resolved again
+			logger.Error(template.Err, "failed to resolve mandatory module for Kyma", "module", name)
+			r.Metrics.RecordMandatoryModuleRolloutError(kyma.GetName(), name)
+		}
+	}
+	if err := r.removeExcludedModules(ctx, kyma, excludedModules); err != nil {
+		return emptyResultWithErr(err)
+	}
 
 	modules, err := r.GenerateModulesFromTemplate(ctx, mandatoryTemplates, kyma)
 	if err != nil {
 		return emptyResultWithErr(err)
 	}
 
-	runner := sync.New(r)
+	runner := sync.New(r, r.AuditTrail.For(audit.ActorMandatoryModuleInstallationController))
 	if err := runner.ReconcileManifests(ctx, kyma, modules); err != nil {
 		return emptyResultWithErr(err)
 	}
@@ -90,6 +113,43 @@ func (r *InstallationReconciler) GenerateModulesFromTemplate(ctx context.Context
 	return parser.GenerateMandatoryModulesFromTemplates(ctx, kyma, templates), nil
 }
 
+// removeExcludedModules deletes the Manifests of the mandatory modules which the Kyma is excluded from.
+func (r *InstallationReconciler) removeExcludedModules(ctx context.Context, kyma *v1beta2.Kyma,
+	excludedModules map[string]bool,
+) error {
+	if len(excludedModules) == 0 {
+		return nil
+	}
+	manifests := &v1beta2.ManifestList{}
+	if err := r.List(ctx, manifests, client.InNamespace(kyma.GetNamespace()), client.MatchingLabels{
+		shared.IsMandatoryModule: shared.EnableLabelValue,
+		shared.KymaName:          kyma.GetName(),
+	}); err != nil {
+		return fmt.Errorf("not able to list mandatory module manifests: %w", err)
+	}
+
+	auditRecorder := r.AuditTrail.For(audit.ActorMandatoryModuleInstallationController)
+	for _, manifest := range manifests.Items {
+		moduleName := manifest.GetLabels()[shared.ModuleName]
+		if !excludedModules[moduleName] || !manifest.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.Delete(ctx, &manifest); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("not able to delete manifest %s/%s: %w", manifest.Namespace, manifest.Name, err)
+		}
+		auditRecorder.Record(ctx, audit.Record{
+			Operation:     audit.OperationDelete,
+			KymaNamespace: kyma.GetNamespace(),
+			KymaName:      kyma.GetName(),
+			Module:        moduleName,
+			FromVersion:   manifest.Spec.Version,
+			Trigger:       fmt.Sprintf("%s %s", shared.ModuleReleaseMetaKind, moduleName),
+			Reason:        "Kyma is excluded from the mandatory module",
+		})
+	}
+	return nil
+}
+
 func emptyResultWithErr(err error) (ctrl.Result, error) {
 	return ctrl.Result{}, fmt.Errorf("MandatoryModuleController: %w", err)
 }
@@ -28,6 +28,10 @@ func (r *InstallationReconciler) SetupWithManager(mgr ctrl.Manager, opts ctrlrun
 			&v1beta2.ModuleTemplate{},
 			handler.EnqueueRequestsFromMapFunc(watch.NewMandatoryTemplateChangeHandler(r).Watch()),
 		).
+		Watches(
+			&v1beta2.ModuleReleaseMeta{},
+			handler.EnqueueRequestsFromMapFunc(watch.NewMandatoryTemplateChangeHandler(r).WatchModuleReleaseMeta()),
+		).
 		Watches(&apicorev1.Secret{}, handler.Funcs{}).
 		Complete(r); err != nil {
 		return fmt.Errorf("failed to setup manager for mandatory module installation controller: %w", err)
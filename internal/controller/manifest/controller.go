@@ -3,6 +3,7 @@ package manifest
 import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
 	declarativev2 "github.com/kyma-project/lifecycle-manager/internal/declarative/v2"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
@@ -17,7 +18,7 @@ import (
 
 func NewReconciler(mgr manager.Manager, requeueIntervals queue.RequeueIntervals,
 	manifestMetrics *metrics.ManifestMetrics, mandatoryModulesMetrics *metrics.MandatoryModulesMetrics,
-	manifestClient declarativev2.ManifestAPIClient,
+	manifestClient declarativev2.ManifestAPIClient, auditTrail *audit.Trail,
 ) *declarativev2.Reconciler {
 	kcp := &declarativev2.ClusterInfo{
 		Client: mgr.GetClient(),
@@ -34,5 +35,6 @@ func NewReconciler(mgr manager.Manager, requeueIntervals queue.RequeueIntervals,
 		declarativev2.WithCustomStateCheck(statecheck.NewManagerStateCheck(statefulChecker, deploymentChecker)),
 		declarativev2.WithRemoteTargetCluster(lookup.ConfigResolver),
 		manifest.WithClientCacheKey(),
+		declarativev2.WithAuditRecorder(auditTrail.For(audit.ActorManifestController)),
 	)
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
 	declarativev2 "github.com/kyma-project/lifecycle-manager/internal/declarative/v2"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/pkg/queue"
@@ -33,6 +34,9 @@ const controllerName = "manifest"
 type SetupOptions struct {
 	ListenerAddr                 string
 	EnableDomainNameVerification bool
+	EnablePriorityQueue          bool
+	QueueMetrics                 queue.PriorityQueueMetrics
+	AuditTrail                   *audit.Trail
 }
 
 func SetupWithManager(mgr manager.Manager, opts ctrlruntime.Options, requeueIntervals queue.RequeueIntervals,
@@ -61,7 +65,7 @@ func SetupWithManager(mgr manager.Manager, opts ctrlruntime.Options, requeueInte
 
 	addSkrEventToQueueFunc := &handler.Funcs{
 		GenericFunc: func(ctx context.Context, evnt event.GenericEvent,
-			queue workqueue.TypedRateLimitingInterface[ctrl.Request],
+			workQueue workqueue.TypedRateLimitingInterface[ctrl.Request],
 		) {
 			ctrl.Log.WithName("listener").Info(
 				fmt.Sprintf(
@@ -69,10 +73,15 @@ func SetupWithManager(mgr manager.Manager, opts ctrlruntime.Options, requeueInte
 					client.ObjectKeyFromObject(evnt.Object).String(),
 				),
 			)
-			queue.Add(ctrl.Request{NamespacedName: client.ObjectKeyFromObject(evnt.Object)})
+			queue.AddWithPriority(workQueue, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(evnt.Object)},
+				queue.PriorityUserChange)
 		},
 	}
 
+	if settings.EnablePriorityQueue {
+		opts.NewQueue = queue.NewPriorityQueueFunc(queuedManifestState(mgr.GetClient()), settings.QueueMetrics)
+	}
+
 	skrEventChannel := source.Channel(runnableListener.ReceivedEvents, addSkrEventToQueueFunc)
 	if err := ctrl.NewControllerManagedBy(mgr).
 		For(&v1beta2.Manifest{}).
@@ -83,9 +92,24 @@ func SetupWithManager(mgr manager.Manager, opts ctrlruntime.Options, requeueInte
 		WatchesRawSource(skrEventChannel).
 		WithOptions(opts).
 		Complete(NewReconciler(mgr, requeueIntervals, manifestMetrics, mandatoryModulesMetrics,
-			manifestClient)); err != nil {
+			manifestClient, settings.AuditTrail)); err != nil {
 		return fmt.Errorf("failed to setup manager for manifest controller: %w", err)
 	}
 
 	return nil
 }
+
+func queuedManifestState(kcpClient client.Reader) queue.ObjectStateFunc {
+	return func(request ctrl.Request) queue.ObjectState {
+		manifest := &v1beta2.Manifest{}
+		if err := kcpClient.Get(context.Background(), request.NamespacedName, manifest); err != nil {
+			return queue.ObjectState{}
+		}
+		return queue.ObjectState{
+			Exists:     true,
+			Deleting:   !manifest.DeletionTimestamp.IsZero(),
+			Generation: manifest.GetGeneration(),
+			State:      manifest.GetStatus().State,
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// deletionPollInterval is the interval in which the deletion of the resources of a stage is checked.
+const deletionPollInterval = 10 * time.Second
+
+// performCleanup purges the custom resources remaining in the SKR cluster stage by stage, following the Policy, and
+// records the progress in the purge pass. It returns the time after which the purge has to continue, or zero once
+// all stages are completed.
+func (r *Reconciler) performCleanup(ctx context.Context, kyma *v1beta2.Kyma, pass *purgePass,
+	remoteClient client.Client, tenantNamespace string,
+) (time.Duration, error) {
+	crdList := apiextensionsv1.CustomResourceDefinitionList{}
+	if err := remoteClient.List(ctx, &crdList); err != nil {
+		return 0, fmt.Errorf("failed fetching CRDs from remote cluster: %w", err)
+	}
+
+	for _, stage := range r.Policy.stages(crdList.Items, kyma, tenantNamespace) {
+		requeueAfter, err := r.purgeStage(ctx, pass, remoteClient, stage, tenantNamespace)
+		if err != nil || requeueAfter > 0 {
+			return requeueAfter, err
+		}
+	}
+	return 0, nil
+}
+
+// purgeStage deletes the resources of the CRDs of the stage. Once the deletion timeout of the stage is exceeded,
+// the finalizers of the remaining resources are removed for the CRDs matched by the ForceFinalize policy, all other
+// resources are reported as remaining.
+func (r *Reconciler) purgeStage(ctx context.Context, pass *purgePass, remoteClient client.Client,
+	stage stage, tenantNamespace string,
+) (time.Duration, error) {
+	now := time.Now()
+	deletionStartedAt := now
+	remaining := map[string]*unstructured.UnstructuredList{}
+	for _, crd := range stage.crds {
+		resources, err := getAllRemainingCRs(ctx, remoteClient, crd, tenantNamespace)
+		if err != nil {
+			return 0, fmt.Errorf("failed fetching stale resources from remote cluster: %w", err)
+		}
+		pass.gvks[crd.Name] = resources.GroupVersionKind()
+		observeResources(pass.status, crd.Name, len(resources.Items))
+		if len(resources.Items) == 0 {
+			continue
+		}
+		startedAt, err := deleteResources(ctx, remoteClient, &resources, now)
+		if err != nil {
+			return 0, fmt.Errorf("failed deleting stale resources: %w", err)
+		}
+		if len(resources.Items) == 0 {
+			continue
+		}
+		if startedAt.Before(deletionStartedAt) {
+			deletionStartedAt = startedAt
+		}
+		remaining[crd.Name] = &resources
+	}
+	if len(remaining) == 0 {
+		return 0, nil
+	}
+
+	deadline := deletionStartedAt.Add(r.Policy.deletionTimeout(stage.group))
+	if now.Before(deadline) {
+		return min(deadline.Sub(now), deletionPollInterval), nil
+	}
+	for _, crd := range stage.crds {
+		resources, found := remaining[crd.Name]
+		if !found {
+			continue
+		}
+		purged := purgedResources(pass.status, crd.Name)
+		if !r.Policy.shouldForceFinalize(crd) {
+			purged.Remaining = len(resources.Items)
+			continue
+		}
+		forceFinalized, err := dropFinalizers(ctx, remoteClient, resources, pass)
+		purged.ForceFinalized += forceFinalized
+		if err != nil {
+			return 0, fmt.Errorf("failed removing finalizers from stale resources: %w", err)
+		}
+	}
+	return 0, nil
+}
+
+func getAllRemainingCRs(ctx context.Context, remoteClient client.Client,
+	crd apiextensionsv1.CustomResourceDefinition, namespace string,
+) (unstructured.UnstructuredList, error) {
+	staleResources := unstructured.UnstructuredList{}
+
+	// Since there are multiple possible versions, we are choosing storage version
+	var gvk schema.GroupVersionKind
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			gvk = schema.GroupVersionKind{
+				Group:   crd.Spec.Group,
+				Kind:    crd.Spec.Names.Kind,
+				Version: version.Name,
+			}
+			break
+		}
+	}
+	staleResources.SetGroupVersionKind(gvk)
+
+	if err := remoteClient.List(ctx, &staleResources, client.InNamespace(namespace)); err != nil {
+		return unstructured.UnstructuredList{}, fmt.Errorf("failed fetching resources: %w", err)
+	}
+
+	return staleResources, nil
+}
+
+// deleteResources deletes the resources which are not being deleted yet. It returns the time the deletion of the
+// first of the resources started, and drops the resources without finalizers from the list, as they are gone.
+func deleteResources(ctx context.Context, remoteClient client.Client, resources *unstructured.UnstructuredList,
+	now time.Time,
+) (time.Time, error) {
+	startedAt := now
+	pending := resources.Items[:0]
+	for index := range resources.Items {
+		resource := resources.Items[index]
+		if deletionTimestamp := resource.GetDeletionTimestamp(); deletionTimestamp != nil {
+			if deletionTimestamp.Time.Before(startedAt) {
+				startedAt = deletionTimestamp.Time
+			}
+		} else if err := remoteClient.Delete(ctx, &resource); err != nil && !util.IsNotFound(err) {
+			return startedAt, fmt.Errorf("failed deleting resource %s/%s: %w",
+				resource.GetNamespace(), resource.GetName(), err)
+		}
+		if len(resource.GetFinalizers()) > 0 {
+			pending = append(pending, resource)
+		}
+	}
+	resources.Items = pending
+	return startedAt, nil
+}
+
+// dropFinalizers removes the finalizers of the resources, so that their deletion completes, and adds them to the
+// purge pass. It returns the number of resources whose finalizers were removed, resources which are already gone
+// are not counted.
+func dropFinalizers(ctx context.Context, remoteClient client.Client,
+	resources *unstructured.UnstructuredList, pass *purgePass,
+) (int, error) {
+	patch := client.RawPatch(types.MergePatchType, []byte(`{"metadata":{"finalizers":null}}`))
+	finalized := 0
+	for index := range resources.Items {
+		resource := &resources.Items[index]
+		if err := remoteClient.Patch(ctx, resource, patch); err != nil {
+			if util.IsNotFound(err) {
+				continue
+			}
+			return finalized, fmt.Errorf("failed updating resource %s/%s: %w",
+				resource.GetNamespace(), resource.GetName(), err)
+		}
+		pass.addForceFinalized(resource)
+		finalized++
+	}
+	return finalized, nil
+}
+
+// observeResources updates the purge status of the CRD with the number of its resources found in the cluster.
+// Resources which disappear without their finalizers being removed are counted as deleted cleanly.
+func observeResources(purge *v1beta2.PurgeStatus, crdName string, count int) {
+	if count == 0 && findPurgedResources(purge, crdName) == nil {
+		return
+	}
+	purged := purgedResources(purge, crdName)
+	if total := count + purged.Deleted + purged.ForceFinalized; total > purged.Found {
+		purged.Found = total
+	}
+	purged.Deleted = purged.Found - purged.ForceFinalized - count
+	purged.Remaining = min(purged.Remaining, count)
+}
+
+func purgedResources(purge *v1beta2.PurgeStatus, crdName string) *v1beta2.PurgedResources {
+	if purged := findPurgedResources(purge, crdName); purged != nil {
+		return purged
+	}
+	purge.Resources = append(purge.Resources, v1beta2.PurgedResources{CRD: crdName})
+	return &purge.Resources[len(purge.Resources)-1]
+}
+
+func findPurgedResources(purge *v1beta2.PurgeStatus, crdName string) *v1beta2.PurgedResources {
+	for index := range purge.Resources {
+		if purge.Resources[index].CRD == crdName {
+			return &purge.Resources[index]
+		}
+	}
+	return nil
+}
+
+// purgeSummary sums up the resources of all CRDs in the purge status.
+func purgeSummary(purge *v1beta2.PurgeStatus) (int, int, int) {
+	var deleted, forceFinalized, remaining int
+	for _, purged := range purge.Resources {
+		deleted += purged.Deleted
+		forceFinalized += purged.ForceFinalized
+		remaining += purged.Remaining
+	}
+	return deleted, forceFinalized, remaining
+}
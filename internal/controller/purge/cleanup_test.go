@@ -0,0 +1,136 @@
+package purge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/matcher"
+)
+
+const testFinalizer = "purge.reconciler/test"
+
+var (
+	issuerGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Issuer"}
+	backupGVK = schema.GroupVersionKind{Group: "velero.io", Version: "v1", Kind: "Backup"}
+)
+
+func TestPurgeStage_ForceFinalizesAfterDeletionTimeout(t *testing.T) {
+	remoteClient := fake.NewClientBuilder().
+		WithObjects(newIssuer("clean"), newIssuer("stuck", testFinalizer)).
+		Build()
+	reconciler := &Reconciler{Policy: Policy{ForceFinalize: matcher.CreateCRDMatcherFrom("*")}}
+	pass := newPurgePass(&v1beta2.PurgeStatus{})
+
+	requeueAfter, err := reconciler.purgeStage(context.Background(), pass, remoteClient, issuerStage(), "")
+
+	require.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	assert.Equal(t, []v1beta2.PurgedResources{
+		{CRD: "issuers.cert-manager.io", Found: 2, Deleted: 0, ForceFinalized: 1},
+	}, pass.status.Resources)
+	assert.Equal(t, []v1beta2.PurgedObject{
+		{APIVersion: "cert-manager.io/v1", Kind: "Issuer", Namespace: "kcp-system", Name: "stuck"},
+	}, pass.forceFinalized)
+	assert.Equal(t, issuerGVK, pass.gvks["issuers.cert-manager.io"])
+	assert.Empty(t, listIssuers(t, remoteClient))
+
+	_, err = reconciler.purgeStage(context.Background(), pass, remoteClient, issuerStage(), "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []v1beta2.PurgedResources{
+		{CRD: "issuers.cert-manager.io", Found: 2, Deleted: 1, ForceFinalized: 1},
+	}, pass.status.Resources)
+}
+
+func TestPurgeStage_WaitsForDeletionTimeout(t *testing.T) {
+	remoteClient := fake.NewClientBuilder().WithObjects(newIssuer("stuck", testFinalizer)).Build()
+	reconciler := &Reconciler{Policy: Policy{
+		ForceFinalize:   matcher.CreateCRDMatcherFrom("*"),
+		DeletionTimeout: time.Hour,
+	}}
+	pass := newPurgePass(&v1beta2.PurgeStatus{})
+
+	requeueAfter, err := reconciler.purgeStage(context.Background(), pass, remoteClient, issuerStage(), "")
+
+	require.NoError(t, err)
+	assert.Equal(t, deletionPollInterval, requeueAfter)
+	issuers := listIssuers(t, remoteClient)
+	require.Len(t, issuers, 1)
+	assert.NotNil(t, issuers[0].GetDeletionTimestamp())
+	assert.Equal(t, []string{testFinalizer}, issuers[0].GetFinalizers())
+}
+
+func TestPurgeStage_ReportsRemainingResourcesNotForceFinalized(t *testing.T) {
+	remoteClient := fake.NewClientBuilder().WithObjects(newIssuer("stuck", testFinalizer)).Build()
+	reconciler := &Reconciler{Policy: Policy{}}
+	pass := newPurgePass(&v1beta2.PurgeStatus{})
+
+	requeueAfter, err := reconciler.purgeStage(context.Background(), pass, remoteClient, issuerStage(), "")
+
+	require.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	assert.Equal(t, []v1beta2.PurgedResources{
+		{CRD: "issuers.cert-manager.io", Found: 1, Remaining: 1},
+	}, pass.status.Resources)
+	assert.Len(t, listIssuers(t, remoteClient), 1)
+}
+
+func TestPerformCleanup_KeepsResourcesOfCRDsNotInstalledByModules(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	issuerCRD := issuerStage().crds[0]
+	issuerCRD.SetAnnotations(map[string]string{shared.OwnedByAnnotation: "kcp-system/cert-manager"})
+	backupCRD := newCRD("backups", backupGVK.Group, backupGVK.Kind, apiextensionsv1.NamespaceScoped)
+	backupCRD.Spec.Versions = []apiextensionsv1.CustomResourceDefinitionVersion{{Name: backupGVK.Version, Storage: true}}
+	backup := &unstructured.Unstructured{}
+	backup.SetGroupVersionKind(backupGVK)
+	backup.SetName("daily")
+	backup.SetNamespace("kcp-system")
+	remoteClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(&issuerCRD, &backupCRD, newIssuer("issuer"), backup).
+		Build()
+	reconciler := &Reconciler{}
+	pass := newPurgePass(&v1beta2.PurgeStatus{})
+
+	requeueAfter, err := reconciler.performCleanup(context.Background(), &v1beta2.Kyma{}, pass, remoteClient, "")
+
+	require.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	assert.Empty(t, listIssuers(t, remoteClient))
+	require.NoError(t, remoteClient.Get(context.Background(), client.ObjectKeyFromObject(backup), backup))
+}
+
+func issuerStage() stage {
+	crd := newCRD("issuers", issuerGVK.Group, issuerGVK.Kind, apiextensionsv1.NamespaceScoped)
+	crd.Spec.Versions = []apiextensionsv1.CustomResourceDefinitionVersion{{Name: issuerGVK.Version, Storage: true}}
+	return stage{group: issuerGVK.Group, crds: []apiextensionsv1.CustomResourceDefinition{crd}}
+}
+
+func newIssuer(name string, finalizers ...string) *unstructured.Unstructured {
+	issuer := &unstructured.Unstructured{}
+	issuer.SetGroupVersionKind(issuerGVK)
+	issuer.SetName(name)
+	issuer.SetNamespace("kcp-system")
+	issuer.SetFinalizers(finalizers)
+	return issuer
+}
+
+func listIssuers(t *testing.T, remoteClient client.Client) []unstructured.Unstructured {
+	t.Helper()
+	issuers := &unstructured.UnstructuredList{}
+	issuers.SetGroupVersionKind(issuerGVK)
+	require.NoError(t, remoteClient.List(context.Background(), issuers))
+	return issuers.Items
+}
@@ -18,14 +18,12 @@ package purge
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
-	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/schema"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -37,7 +35,6 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/internal/remote"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
-	"github.com/kyma-project/lifecycle-manager/pkg/matcher"
 	"github.com/kyma-project/lifecycle-manager/pkg/status"
 	"github.com/kyma-project/lifecycle-manager/pkg/util"
 )
@@ -45,14 +42,18 @@ import (
 const (
 	setFinalizerFailure    event.Reason = "SettingPurgeFinalizerFailed"
 	removeFinalizerFailure event.Reason = "RemovingPurgeFinalizerFailed"
+	purgeCompleted         event.Reason = "PurgeCompleted"
+	purgeIncomplete        event.Reason = "PurgeIncomplete"
 )
 
+var ErrResourcesRemaining = errors.New("resources remain in the cluster")
+
 type Reconciler struct {
 	client.Client
 	event.Event
 	SkrContextFactory     remote.SkrContextProvider
 	PurgeFinalizerTimeout time.Duration
-	SkipCRDs              matcher.CRDMatcherFunc
+	Policy                Policy
 	IsManagedKyma         bool
 	Metrics               *metrics.PurgeMetrics
 }
@@ -85,7 +86,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.handleSkrNotFoundError(ctx, kyma, err)
 	}
 
-	return r.handlePurge(ctx, kyma, skrContext.Client, start)
+	return r.handlePurge(ctx, kyma, skrContext, start)
 }
 
 func (r *Reconciler) UpdateStatus(ctx context.Context, kyma *v1beta2.Kyma, state shared.State, message string) error {
@@ -152,20 +153,44 @@ func (r *Reconciler) handleCleanupError(ctx context.Context, kyma *v1beta2.Kyma,
 	return ctrl.Result{}, err
 }
 
-func (r *Reconciler) handlePurge(ctx context.Context, kyma *v1beta2.Kyma, remoteClient client.Client, start time.Time) (ctrl.Result, error) {
+func (r *Reconciler) handlePurge(ctx context.Context, kyma *v1beta2.Kyma, skrContext *remote.SkrContext, start time.Time) (ctrl.Result, error) {
 	logger := logf.FromContext(ctx)
 
-	r.Metrics.UpdatePurgeCount()
-
-	handledResources, err := r.performCleanup(ctx, remoteClient)
-	if len(handledResources) > 0 {
-		logger.Info(fmt.Sprintf("Removed all finalizers for Kyma %s related resources %s", kyma.GetName(), strings.Join(handledResources, ", ")))
+	if kyma.Status.Purge == nil {
+		r.Metrics.UpdatePurgeCount()
+		kyma.Status.Purge = &v1beta2.PurgeStatus{StartedAt: apimetav1.NewTime(start)}
 	}
+
+	pass := newPurgePass(kyma.Status.Purge)
+	requeueAfter, err := r.performCleanup(ctx, kyma, pass, skrContext.Client, skrContext.TenantNamespace())
 	if err != nil {
+		if statusErr := r.updatePurgeStatus(ctx, kyma); statusErr != nil {
+			logger.Error(statusErr, "failed updating purge status for Kyma "+kyma.GetName())
+		}
+		r.handleReport(ctx, kyma, pass, err)
 		return r.handleCleanupError(ctx, kyma, err)
 	}
 	r.Metrics.DeletePurgeError(ctx, kyma, metrics.ErrCleanup)
 
+	if requeueAfter > 0 {
+		if err := r.updatePurgeStatus(ctx, kyma); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.handleReport(ctx, kyma, pass, nil)
+		logger.V(log.DebugLevel).Info(fmt.Sprintf("Waiting for deletion of resources of Kyma %s, requeue after %s",
+			kyma.GetName(), requeueAfter))
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if kyma.Status.Purge.CompletedAt == nil {
+		kyma.Status.Purge.CompletedAt = &apimetav1.Time{Time: time.Now()}
+		if err := r.updatePurgeStatus(ctx, kyma); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.handleReport(ctx, kyma, pass, nil)
+		r.recordPurgeCompleted(ctx, kyma)
+	}
+
 	dropped, err := r.dropPurgeFinalizer(ctx, kyma)
 	if dropped {
 		logger.Info("Removed purge finalizer for Kyma " + kyma.GetName())
@@ -175,10 +200,41 @@ func (r *Reconciler) handlePurge(ctx context.Context, kyma *v1beta2.Kyma, remote
 	}
 	r.Metrics.DeletePurgeError(ctx, kyma, metrics.ErrPurgeFinalizerRemoval)
 
-	r.Metrics.UpdatePurgeTime(time.Since(start))
+	r.Metrics.UpdatePurgeTime(time.Since(kyma.Status.Purge.StartedAt.Time))
 	return ctrl.Result{}, nil
 }
 
+// handleReport records the purge pass in the PurgeReport. Failures are logged and exposed as metric only, so that
+// the report never blocks the purge.
+func (r *Reconciler) handleReport(ctx context.Context, kyma *v1beta2.Kyma, pass *purgePass, purgeErr error) {
+	if err := r.recordReport(ctx, kyma, pass, purgeErr); err != nil {
+		logf.FromContext(ctx).Error(err, "failed recording purge report for Kyma "+kyma.GetName())
+		r.Metrics.SetPurgeError(ctx, kyma, metrics.ErrPurgeReport)
+		return
+	}
+	r.Metrics.DeletePurgeError(ctx, kyma, metrics.ErrPurgeReport)
+}
+
+func (r *Reconciler) updatePurgeStatus(ctx context.Context, kyma *v1beta2.Kyma) error {
+	if err := r.Status().Update(ctx, kyma); err != nil {
+		return fmt.Errorf("failed updating purge status: %w", err)
+	}
+	return nil
+}
+
+// recordPurgeCompleted reports the outcome of the purge in the log, events and metrics.
+func (r *Reconciler) recordPurgeCompleted(ctx context.Context, kyma *v1beta2.Kyma) {
+	deleted, forceFinalized, remaining := purgeSummary(kyma.Status.Purge)
+	summary := fmt.Sprintf("deleted %d, force-finalized %d, remaining %d resources",
+		deleted, forceFinalized, remaining)
+	logf.FromContext(ctx).Info(fmt.Sprintf("Purge completed for Kyma %s: %s", kyma.GetName(), summary))
+	r.Event.Normal(kyma, purgeCompleted, summary)
+	if remaining > 0 {
+		r.Event.Warning(kyma, purgeIncomplete, fmt.Errorf("%d %w", remaining, ErrResourcesRemaining))
+	}
+	r.Metrics.UpdatePurgedResources(deleted, forceFinalized, remaining)
+}
+
 func (r *Reconciler) ensurePurgeFinalizer(ctx context.Context, kyma *v1beta2.Kyma) error {
 	if controllerutil.AddFinalizer(kyma, shared.PurgeFinalizer) {
 		if err := r.Update(ctx, kyma); err != nil {
@@ -205,79 +261,3 @@ func (r *Reconciler) calculateRequeueAfterTime(kyma *v1beta2.Kyma) time.Duration
 	}
 	return 0
 }
-
-func (r *Reconciler) performCleanup(ctx context.Context, remoteClient client.Client) ([]string, error) {
-	crdList := apiextensionsv1.CustomResourceDefinitionList{}
-	if err := remoteClient.List(ctx, &crdList); err != nil {
-		return nil, fmt.Errorf("failed fetching CRDs from remote cluster: %w", err)
-	}
-
-	var allHandledResources []string
-	for _, crd := range crdList.Items {
-		if shouldSkip(crd, r.SkipCRDs) {
-			continue
-		}
-
-		staleResources, err := getAllRemainingCRs(ctx, remoteClient, crd)
-		if err != nil {
-			return allHandledResources, fmt.Errorf("failed fetching stale resources from remote cluster: %w", err)
-		}
-
-		handledResources, err := dropFinalizers(ctx, remoteClient, staleResources)
-		if err != nil {
-			return allHandledResources, fmt.Errorf("failed removing finalizers from stale resources: %w", err)
-		}
-
-		allHandledResources = append(allHandledResources, handledResources...)
-	}
-
-	return allHandledResources, nil
-}
-
-func shouldSkip(crd apiextensionsv1.CustomResourceDefinition, matcher matcher.CRDMatcherFunc) bool {
-	if crd.Spec.Group == v1beta2.GroupVersion.Group && crd.Spec.Names.Kind == string(shared.KymaKind) {
-		return true
-	}
-	return matcher(crd)
-}
-
-func getAllRemainingCRs(ctx context.Context, remoteClient client.Client,
-	crd apiextensionsv1.CustomResourceDefinition,
-) (unstructured.UnstructuredList, error) {
-	staleResources := unstructured.UnstructuredList{}
-
-	// Since there are multiple possible versions, we are choosing storage version
-	var gvk schema.GroupVersionKind
-	for _, version := range crd.Spec.Versions {
-		if version.Storage {
-			gvk = schema.GroupVersionKind{
-				Group:   crd.Spec.Group,
-				Kind:    crd.Spec.Names.Kind,
-				Version: version.Name,
-			}
-			break
-		}
-	}
-	staleResources.SetGroupVersionKind(gvk)
-
-	if err := remoteClient.List(ctx, &staleResources); err != nil {
-		return unstructured.UnstructuredList{}, fmt.Errorf("failed fetching resources: %w", err)
-	}
-
-	return staleResources, nil
-}
-
-func dropFinalizers(ctx context.Context, remoteClient client.Client,
-	staleResources unstructured.UnstructuredList,
-) ([]string, error) {
-	handledResources := make([]string, 0, len(staleResources.Items))
-	for index := range staleResources.Items {
-		resource := staleResources.Items[index]
-		resource.SetFinalizers(nil)
-		if err := remoteClient.Update(ctx, &resource); err != nil {
-			return handledResources, fmt.Errorf("failed updating resource: %w", err)
-		}
-		handledResources = append(handledResources, fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName()))
-	}
-	return handledResources, nil
-}
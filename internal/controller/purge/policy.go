@@ -0,0 +1,180 @@
+package purge
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/matcher"
+)
+
+const groupTimeoutPairCount = 2
+
+var ErrInvalidGroupDeletionTimeout = errors.New("invalid group deletion timeout")
+
+// Policy determines how the custom resources remaining in the SKR cluster of a deleted Kyma are cleaned up.
+// Only the resources of the CRDs installed by module Manifests, of the module CRs, and of the CRDs matched by Include
+// are purged. The resources are deleted one API group after the other. Only if the resources of a group are not deleted within
+// the deletion timeout of the group, their finalizers are removed, and only for the CRDs matched by ForceFinalize.
+type Policy struct {
+	// Include matches the CRDs not installed by modules whose resources are purged as well.
+	Include matcher.CRDMatcherFunc
+	// Skip matches the CRDs whose resources are left untouched.
+	Skip matcher.CRDMatcherFunc
+	// ForceFinalize matches the CRDs whose resources get their finalizers removed if they are not deleted in time.
+	ForceFinalize matcher.CRDMatcherFunc
+	// DeletionOrder lists the API groups whose resources are deleted first, in the given order. The groups of the
+	// module CRs follow, as the resources of the module CRs depend on the other groups, and then all other groups.
+	DeletionOrder []string
+	// DeletionTimeout is the time the resources of a group get to be deleted.
+	DeletionTimeout time.Duration
+	// GroupDeletionTimeouts overrides the DeletionTimeout per API group.
+	GroupDeletionTimeouts map[string]time.Duration
+}
+
+// stage holds the CRDs of an API group whose resources are deleted together.
+type stage struct {
+	group string
+	crds  []apiextensionsv1.CustomResourceDefinition
+}
+
+func (p Policy) deletionTimeout(group string) time.Duration {
+	if timeout, found := p.GroupDeletionTimeouts[group]; found {
+		return timeout
+	}
+	return p.DeletionTimeout
+}
+
+// stages groups the CRDs whose resources are purged by API group, in the order in which they are deleted. The CRDs
+// not installed by modules and not matched by Include, the Kyma CRD, the skipped CRDs and the CRDs of the module CRs
+// of orphaned modules are left out. For a tenant of a shared SKR cluster, only the namespaced CRDs are purged.
+func (p Policy) stages(crds []apiextensionsv1.CustomResourceDefinition, kyma *v1beta2.Kyma,
+	tenantNamespace string,
+) []stage {
+	moduleCRs, orphanedModuleCRs := moduleCRGroupKinds(kyma)
+	moduleCRGroups := map[string]bool{}
+	for groupKind := range moduleCRs {
+		moduleCRGroups[groupKind.Group] = true
+	}
+	crdsPerGroup := map[string][]apiextensionsv1.CustomResourceDefinition{}
+	for _, crd := range crds {
+		groupKind := schema.GroupKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}
+		if p.shouldSkip(crd) || orphanedModuleCRs[groupKind] || !(moduleCRs[groupKind] || p.shouldInclude(crd)) {
+			continue
+		}
+		if tenantNamespace != "" && crd.Spec.Scope != apiextensionsv1.NamespaceScoped {
+			continue
+		}
+		crdsPerGroup[crd.Spec.Group] = append(crdsPerGroup[crd.Spec.Group], crd)
+	}
+
+	groups := make([]string, 0, len(crdsPerGroup))
+	for group := range crdsPerGroup {
+		groups = append(groups, group)
+	}
+	slices.SortFunc(groups, func(first, second string) int {
+		if rank := groupRank(first, p.DeletionOrder, moduleCRGroups) -
+			groupRank(second, p.DeletionOrder, moduleCRGroups); rank != 0 {
+			return rank
+		}
+		return strings.Compare(first, second)
+	})
+
+	stages := make([]stage, 0, len(groups))
+	for _, group := range groups {
+		groupCrds := crdsPerGroup[group]
+		slices.SortFunc(groupCrds, func(first, second apiextensionsv1.CustomResourceDefinition) int {
+			return strings.Compare(first.Name, second.Name)
+		})
+		stages = append(stages, stage{group: group, crds: groupCrds})
+	}
+	return stages
+}
+
+func (p Policy) shouldSkip(crd apiextensionsv1.CustomResourceDefinition) bool {
+	if crd.Spec.Group == v1beta2.GroupVersion.Group && crd.Spec.Names.Kind == string(shared.KymaKind) {
+		return true
+	}
+	return p.Skip != nil && p.Skip(crd)
+}
+
+// shouldInclude returns true for the CRDs installed by a module Manifest and the CRDs matched by Include.
+func (p Policy) shouldInclude(crd apiextensionsv1.CustomResourceDefinition) bool {
+	if _, found := crd.GetAnnotations()[shared.OwnedByAnnotation]; found {
+		return true
+	}
+	return p.Include != nil && p.Include(crd)
+}
+
+func (p Policy) shouldForceFinalize(crd apiextensionsv1.CustomResourceDefinition) bool {
+	return p.ForceFinalize != nil && p.ForceFinalize(crd)
+}
+
+// groupRank ranks the groups of the deletion order first, then the groups of the module CRs, then all others.
+func groupRank(group string, deletionOrder []string, moduleCRGroups map[string]bool) int {
+	if index := slices.Index(deletionOrder, group); index >= 0 {
+		return index
+	}
+	if moduleCRGroups[group] {
+		return len(deletionOrder)
+	}
+	return len(deletionOrder) + 1
+}
+
+// moduleCRGroupKinds returns the kinds of the module CRs of the Kyma, and the kinds of the module CRs of modules
+// with the Orphan DeletionPolicy, whose resources are kept in the cluster.
+func moduleCRGroupKinds(kyma *v1beta2.Kyma) (map[schema.GroupKind]bool, map[schema.GroupKind]bool) {
+	moduleCRs := map[schema.GroupKind]bool{}
+	orphaned := map[schema.GroupKind]bool{}
+	for _, module := range kyma.Status.Modules {
+		if module.Resource == nil {
+			continue
+		}
+		groupKind := schema.FromAPIVersionAndKind(module.Resource.APIVersion, module.Resource.Kind).GroupKind()
+		if kyma.GetModuleDeletionPolicy(module.Name) == v1beta2.DeletionPolicyOrphan {
+			orphaned[groupKind] = true
+			continue
+		}
+		moduleCRs[groupKind] = true
+	}
+	return moduleCRs, orphaned
+}
+
+// ParseGroupDeletionTimeouts parses a comma-separated list of 'group=timeout' pairs into a map of timeouts per group,
+// e.g. "cert-manager.io=5m,gateway.kyma-project.io=30s".
+func ParseGroupDeletionTimeouts(input string) (map[string]time.Duration, error) {
+	timeouts := map[string]time.Duration{}
+	for _, pair := range strings.Split(input, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		groupTimeout := strings.Split(pair, "=")
+		if len(groupTimeout) != groupTimeoutPairCount {
+			return nil, fmt.Errorf("%w: %q is not in the format 'group=timeout'", ErrInvalidGroupDeletionTimeout, pair)
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(groupTimeout[1]))
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("%w: %q has no valid timeout", ErrInvalidGroupDeletionTimeout, pair)
+		}
+		timeouts[strings.TrimSpace(groupTimeout[0])] = timeout
+	}
+	return timeouts, nil
+}
+
+// ParseDeletionOrder parses a comma-separated list of API groups into the deletion order.
+func ParseDeletionOrder(input string) []string {
+	var groups []string
+	for _, group := range strings.Split(input, ",") {
+		if group = strings.TrimSpace(group); group != "" {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
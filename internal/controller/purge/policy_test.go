@@ -0,0 +1,156 @@
+package purge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/matcher"
+)
+
+func TestPolicy_Stages_OrdersGroups(t *testing.T) {
+	policy := Policy{
+		Skip:          matcher.CreateCRDMatcherFrom("destinationrules.networking.istio.io"),
+		DeletionOrder: []string{"gateway.kyma-project.io"},
+	}
+	kyma := &v1beta2.Kyma{
+		Status: v1beta2.KymaStatus{
+			Modules: []v1beta2.ModuleStatus{
+				{Name: "serverless", Resource: &v1beta2.TrackingObject{
+					PartialMeta: v1beta2.PartialMeta{Name: "default"},
+					TypeMeta:    apimetav1.TypeMeta{APIVersion: "operator.kyma-project.io/v1alpha1", Kind: "Serverless"},
+				}},
+			},
+		},
+	}
+	crds := []apiextensionsv1.CustomResourceDefinition{
+		moduleCRD("issuers", "cert-manager.io", "Issuer", apiextensionsv1.NamespaceScoped),
+		moduleCRD("certificates", "cert-manager.io", "Certificate", apiextensionsv1.NamespaceScoped),
+		newCRD("serverlesses", "operator.kyma-project.io", "Serverless", apiextensionsv1.NamespaceScoped),
+		moduleCRD("apirules", "gateway.kyma-project.io", "APIRule", apiextensionsv1.NamespaceScoped),
+		moduleCRD("destinationrules", "networking.istio.io", "DestinationRule", apiextensionsv1.NamespaceScoped),
+		moduleCRD("kymas", "operator.kyma-project.io", "Kyma", apiextensionsv1.NamespaceScoped),
+	}
+
+	stages := policy.stages(crds, kyma, "")
+
+	require.Len(t, stages, 3)
+	assert.Equal(t, "gateway.kyma-project.io", stages[0].group)
+	assert.Equal(t, "operator.kyma-project.io", stages[1].group)
+	assert.Equal(t, []string{"serverlesses.operator.kyma-project.io"}, crdNames(stages[1].crds))
+	assert.Equal(t, "cert-manager.io", stages[2].group)
+	assert.Equal(t, []string{"certificates.cert-manager.io", "issuers.cert-manager.io"}, crdNames(stages[2].crds))
+}
+
+func TestPolicy_Stages_SkipsModuleCRsOfOrphanedModules(t *testing.T) {
+	kyma := &v1beta2.Kyma{
+		Spec: v1beta2.KymaSpec{
+			Modules: []v1beta2.Module{{Name: "serverless", DeletionPolicy: v1beta2.DeletionPolicyOrphan}},
+		},
+		Status: v1beta2.KymaStatus{
+			Modules: []v1beta2.ModuleStatus{
+				{Name: "serverless", Resource: &v1beta2.TrackingObject{
+					TypeMeta: apimetav1.TypeMeta{APIVersion: "operator.kyma-project.io/v1alpha1", Kind: "Serverless"},
+				}},
+			},
+		},
+	}
+	crds := []apiextensionsv1.CustomResourceDefinition{
+		newCRD("serverlesses", "operator.kyma-project.io", "Serverless", apiextensionsv1.NamespaceScoped),
+	}
+
+	assert.Empty(t, Policy{}.stages(crds, kyma, ""))
+}
+
+func TestPolicy_Stages_SkipsClusterScopedCRDsForTenants(t *testing.T) {
+	crds := []apiextensionsv1.CustomResourceDefinition{
+		moduleCRD("clusterissuers", "cert-manager.io", "ClusterIssuer", apiextensionsv1.ClusterScoped),
+		moduleCRD("issuers", "cert-manager.io", "Issuer", apiextensionsv1.NamespaceScoped),
+	}
+
+	stages := Policy{}.stages(crds, &v1beta2.Kyma{}, "tenant")
+
+	require.Len(t, stages, 1)
+	assert.Equal(t, []string{"issuers.cert-manager.io"}, crdNames(stages[0].crds))
+}
+
+func TestPolicy_Stages_SkipsCRDsNotInstalledByModules(t *testing.T) {
+	crds := []apiextensionsv1.CustomResourceDefinition{
+		moduleCRD("issuers", "cert-manager.io", "Issuer", apiextensionsv1.NamespaceScoped),
+		newCRD("backups", "velero.io", "Backup", apiextensionsv1.NamespaceScoped),
+		newCRD("ingressroutes", "traefik.io", "IngressRoute", apiextensionsv1.NamespaceScoped),
+	}
+	policy := Policy{Include: matcher.CreateCRDMatcherFrom("ingressroutes.traefik.io")}
+
+	stages := policy.stages(crds, &v1beta2.Kyma{}, "")
+
+	require.Len(t, stages, 2)
+	assert.Equal(t, []string{"issuers.cert-manager.io"}, crdNames(stages[0].crds))
+	assert.Equal(t, []string{"ingressroutes.traefik.io"}, crdNames(stages[1].crds))
+}
+
+func TestPolicy_DeletionTimeout(t *testing.T) {
+	policy := Policy{
+		DeletionTimeout:       time.Minute,
+		GroupDeletionTimeouts: map[string]time.Duration{"cert-manager.io": 5 * time.Minute},
+	}
+
+	assert.Equal(t, 5*time.Minute, policy.deletionTimeout("cert-manager.io"))
+	assert.Equal(t, time.Minute, policy.deletionTimeout("gateway.kyma-project.io"))
+}
+
+func TestParseGroupDeletionTimeouts(t *testing.T) {
+	timeouts, err := ParseGroupDeletionTimeouts("cert-manager.io=5m, gateway.kyma-project.io=30s,")
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{
+		"cert-manager.io":         5 * time.Minute,
+		"gateway.kyma-project.io": 30 * time.Second,
+	}, timeouts)
+}
+
+func TestParseGroupDeletionTimeouts_ReturnsErrorForInvalidInput(t *testing.T) {
+	for _, input := range []string{"cert-manager.io", "cert-manager.io=5", "cert-manager.io=-1m", "a=1m=2m"} {
+		_, err := ParseGroupDeletionTimeouts(input)
+		require.ErrorIs(t, err, ErrInvalidGroupDeletionTimeout, input)
+	}
+}
+
+func TestParseDeletionOrder(t *testing.T) {
+	assert.Equal(t, []string{"gateway.kyma-project.io", "cert-manager.io"},
+		ParseDeletionOrder(" gateway.kyma-project.io,,cert-manager.io "))
+	assert.Empty(t, ParseDeletionOrder(""))
+}
+
+func newCRD(plural, group, kind string, scope apiextensionsv1.ResourceScope) apiextensionsv1.CustomResourceDefinition {
+	return apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: apimetav1.ObjectMeta{Name: plural + "." + group},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: plural, Kind: kind},
+			Scope: scope,
+		},
+	}
+}
+
+// moduleCRD returns a CRD installed by a module Manifest.
+func moduleCRD(plural, group, kind string, scope apiextensionsv1.ResourceScope,
+) apiextensionsv1.CustomResourceDefinition {
+	crd := newCRD(plural, group, kind, scope)
+	crd.SetAnnotations(map[string]string{shared.OwnedByAnnotation: "kcp-system/module-manifest"})
+	return crd
+}
+
+func crdNames(crds []apiextensionsv1.CustomResourceDefinition) []string {
+	names := make([]string, 0, len(crds))
+	for _, crd := range crds {
+		names = append(names, crd.Name)
+	}
+	return names
+}
@@ -0,0 +1,196 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// +kubebuilder:rbac:groups=operator.kyma-project.io,resources=purgereports,verbs=get;list;watch;create;update;delete
+
+const (
+	// maxReportedObjects caps the force-finalized custom resources listed in a PurgeReport, to keep it small.
+	maxReportedObjects = 500
+	// maxReportedErrors caps the errors listed in a PurgeReport to the latest ones.
+	maxReportedErrors = 20
+	// reportPruneInterval is the interval in which expired PurgeReports are removed.
+	reportPruneInterval = time.Hour
+)
+
+// reportLabels are the labels of the Kyma which are copied to its PurgeReport, to relate it to the runtime.
+var reportLabels = []string{
+	shared.InstanceIDLabel,
+	shared.GlobalAccountIDLabel,
+	shared.RuntimeIDLabel,
+	shared.RegionLabel,
+	shared.PlanLabel,
+}
+
+// purgePass collects the outcome of one pass of the purge, which is recorded in the purge status of the Kyma and
+// added to its PurgeReport.
+type purgePass struct {
+	status         *v1beta2.PurgeStatus
+	gvks           map[string]schema.GroupVersionKind
+	forceFinalized []v1beta2.PurgedObject
+}
+
+func newPurgePass(status *v1beta2.PurgeStatus) *purgePass {
+	return &purgePass{status: status, gvks: map[string]schema.GroupVersionKind{}}
+}
+
+func (p *purgePass) addForceFinalized(resource *unstructured.Unstructured) {
+	p.forceFinalized = append(p.forceFinalized, v1beta2.PurgedObject{
+		APIVersion: resource.GetAPIVersion(),
+		Kind:       resource.GetKind(),
+		Namespace:  resource.GetNamespace(),
+		Name:       resource.GetName(),
+	})
+}
+
+// ReportName returns the name of the PurgeReport of the purge of the Kyma, which is unique per purge run.
+func ReportName(kyma *v1beta2.Kyma) string {
+	return fmt.Sprintf("%s-%d", kyma.GetName(), kyma.Status.Purge.StartedAt.Unix())
+}
+
+// recordReport adds the outcome of the purge pass to the PurgeReport of the Kyma, which is created by the first pass
+// of a purge.
+func (r *Reconciler) recordReport(ctx context.Context, kyma *v1beta2.Kyma, pass *purgePass, purgeErr error) error {
+	report := &v1beta2.PurgeReport{}
+	err := r.Get(ctx, client.ObjectKey{Name: ReportName(kyma), Namespace: kyma.GetNamespace()}, report)
+	if err != nil && !util.IsNotFound(err) {
+		return fmt.Errorf("failed getting purge report: %w", err)
+	}
+
+	if err != nil {
+		report = newReport(kyma)
+		updateReport(report, kyma, pass, purgeErr)
+		if err := r.Create(ctx, report); err != nil {
+			return fmt.Errorf("failed creating purge report: %w", err)
+		}
+		return nil
+	}
+
+	previous := report.Spec.DeepCopy()
+	updateReport(report, kyma, pass, purgeErr)
+	if equality.Semantic.DeepEqual(previous, &report.Spec) {
+		return nil
+	}
+	if err := r.Update(ctx, report); err != nil {
+		return fmt.Errorf("failed updating purge report: %w", err)
+	}
+	return nil
+}
+
+func newReport(kyma *v1beta2.Kyma) *v1beta2.PurgeReport {
+	labels := map[string]string{shared.KymaName: kyma.GetName()}
+	for _, label := range reportLabels {
+		if value, found := kyma.GetLabels()[label]; found {
+			labels[label] = value
+		}
+	}
+	return &v1beta2.PurgeReport{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      ReportName(kyma),
+			Namespace: kyma.GetNamespace(),
+			Labels:    labels,
+		},
+	}
+}
+
+func updateReport(report *v1beta2.PurgeReport, kyma *v1beta2.Kyma, pass *purgePass, purgeErr error) {
+	purge := kyma.Status.Purge
+	report.Spec.KymaName = kyma.GetName()
+	report.Spec.StartedAt = purge.StartedAt
+	report.Spec.CompletedAt = purge.CompletedAt.DeepCopy()
+
+	resources := make([]v1beta2.PurgeReportResources, 0, len(purge.Resources))
+	for _, purged := range purge.Resources {
+		resource := v1beta2.PurgeReportResources{PurgedResources: purged}
+		if gvk, found := pass.gvks[purged.CRD]; found {
+			resource.Group, resource.Version, resource.Kind = gvk.Group, gvk.Version, gvk.Kind
+		} else if previous := findReportResources(report.Spec.Resources, purged.CRD); previous != nil {
+			resource.Group, resource.Version, resource.Kind = previous.Group, previous.Version, previous.Kind
+		}
+		resources = append(resources, resource)
+	}
+	report.Spec.Resources = resources
+
+	report.Spec.ForceFinalized = append(report.Spec.ForceFinalized, pass.forceFinalized...)
+	report.Spec.ForceFinalized = report.Spec.ForceFinalized[:min(len(report.Spec.ForceFinalized), maxReportedObjects)]
+
+	if purgeErr != nil {
+		errs := report.Spec.Errors
+		if len(errs) == 0 || errs[len(errs)-1] != purgeErr.Error() {
+			errs = append(errs, purgeErr.Error())
+		}
+		report.Spec.Errors = errs[max(len(errs)-maxReportedErrors, 0):]
+	}
+}
+
+func findReportResources(resources []v1beta2.PurgeReportResources, crdName string) *v1beta2.PurgeReportResources {
+	for index := range resources {
+		if resources[index].CRD == crdName {
+			return &resources[index]
+		}
+	}
+	return nil
+}
+
+// ReportPruner removes the PurgeReports whose retention period is over. The retention period starts with the
+// completion of the purge, or with the creation of the PurgeReport if the purge never completed.
+type ReportPruner struct {
+	client    client.Client
+	retention time.Duration
+}
+
+func NewReportPruner(client client.Client, retention time.Duration) *ReportPruner {
+	return &ReportPruner{client: client, retention: retention}
+}
+
+func (p *ReportPruner) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("purge-report-pruner")
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := p.Prune(ctx, time.Now()); err != nil {
+			logger.Error(err, "failed to prune purge reports")
+		}
+	}, reportPruneInterval)
+	return nil
+}
+
+func (p *ReportPruner) NeedLeaderElection() bool {
+	return true
+}
+
+// Prune removes the PurgeReports whose retention period is over at the given time.
+func (p *ReportPruner) Prune(ctx context.Context, now time.Time) error {
+	reports := &v1beta2.PurgeReportList{}
+	if err := p.client.List(ctx, reports); err != nil {
+		return fmt.Errorf("failed listing purge reports: %w", err)
+	}
+	for index := range reports.Items {
+		report := &reports.Items[index]
+		retainedSince := report.GetCreationTimestamp().Time
+		if report.Spec.CompletedAt != nil {
+			retainedSince = report.Spec.CompletedAt.Time
+		}
+		if now.Before(retainedSince.Add(p.retention)) {
+			continue
+		}
+		if err := p.client.Delete(ctx, report); err != nil && !util.IsNotFound(err) {
+			return fmt.Errorf("failed deleting purge report %s: %w", report.GetName(), err)
+		}
+	}
+	return nil
+}
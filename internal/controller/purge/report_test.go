@@ -0,0 +1,125 @@
+package purge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+var errRemoteUnavailable = errors.New("remote cluster unavailable")
+
+func TestRecordReport_CreatesAndUpdatesReport(t *testing.T) {
+	kcpClient := fake.NewClientBuilder().WithScheme(newScheme(t)).Build()
+	reconciler := &Reconciler{Client: kcpClient}
+	kyma := newPurgedKyma()
+
+	pass := newPurgePass(kyma.Status.Purge)
+	pass.gvks["issuers.cert-manager.io"] = issuerGVK
+	require.NoError(t, reconciler.recordReport(context.Background(), kyma, pass, errRemoteUnavailable))
+
+	kyma.Status.Purge.Resources[0].ForceFinalized = 1
+	kyma.Status.Purge.CompletedAt = &apimetav1.Time{Time: kyma.Status.Purge.StartedAt.Add(time.Minute)}
+	pass = newPurgePass(kyma.Status.Purge)
+	pass.addForceFinalized(newIssuer("stuck", testFinalizer))
+	require.NoError(t, reconciler.recordReport(context.Background(), kyma, pass, nil))
+
+	report := &v1beta2.PurgeReport{}
+	require.NoError(t, kcpClient.Get(context.Background(),
+		client.ObjectKey{Name: "kyma-sample-1700000000", Namespace: "kcp-system"}, report))
+	assert.Equal(t, map[string]string{
+		shared.KymaName:        "kyma-sample",
+		shared.InstanceIDLabel: "instance",
+	}, report.GetLabels())
+	assert.Equal(t, "kyma-sample", report.Spec.KymaName)
+	assert.NotNil(t, report.Spec.CompletedAt)
+	assert.Equal(t, []v1beta2.PurgeReportResources{{
+		Group:   "cert-manager.io",
+		Version: "v1",
+		Kind:    "Issuer",
+		PurgedResources: v1beta2.PurgedResources{
+			CRD: "issuers.cert-manager.io", Found: 2, Deleted: 1, ForceFinalized: 1,
+		},
+	}}, report.Spec.Resources)
+	assert.Equal(t, []v1beta2.PurgedObject{
+		{APIVersion: "cert-manager.io/v1", Kind: "Issuer", Namespace: "kcp-system", Name: "stuck"},
+	}, report.Spec.ForceFinalized)
+	assert.Equal(t, []string{errRemoteUnavailable.Error()}, report.Spec.Errors)
+}
+
+func TestUpdateReport_CapsObjectsAndErrors(t *testing.T) {
+	kyma := newPurgedKyma()
+	report := newReport(kyma)
+	for attempt := range maxReportedObjects + 1 {
+		pass := newPurgePass(kyma.Status.Purge)
+		pass.addForceFinalized(newIssuer("stuck", testFinalizer))
+		updateReport(report, kyma, pass, fmt.Errorf("attempt %d: %w", attempt, errRemoteUnavailable))
+	}
+
+	assert.Len(t, report.Spec.ForceFinalized, maxReportedObjects)
+	require.Len(t, report.Spec.Errors, maxReportedErrors)
+	assert.Equal(t, fmt.Sprintf("attempt %d: %s", maxReportedObjects, errRemoteUnavailable),
+		report.Spec.Errors[maxReportedErrors-1])
+}
+
+func TestReportPruner_RemovesExpiredReports(t *testing.T) {
+	now := time.Now()
+	expired := newPurgeReport("expired", now.Add(-3*time.Hour), &apimetav1.Time{Time: now.Add(-2 * time.Hour)})
+	retained := newPurgeReport("retained", now.Add(-3*time.Hour), &apimetav1.Time{Time: now.Add(-time.Minute)})
+	incomplete := newPurgeReport("incomplete", now.Add(-3*time.Hour), nil)
+	kcpClient := fake.NewClientBuilder().WithScheme(newScheme(t)).
+		WithObjects(expired, retained, incomplete).
+		Build()
+
+	require.NoError(t, NewReportPruner(kcpClient, time.Hour).Prune(context.Background(), now))
+
+	reports := &v1beta2.PurgeReportList{}
+	require.NoError(t, kcpClient.List(context.Background(), reports))
+	require.Len(t, reports.Items, 1)
+	assert.Equal(t, "retained", reports.Items[0].GetName())
+}
+
+func newPurgedKyma() *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      "kyma-sample",
+			Namespace: "kcp-system",
+			Labels:    map[string]string{shared.InstanceIDLabel: "instance", shared.ChannelLabel: "regular"},
+		},
+		Status: v1beta2.KymaStatus{
+			Purge: &v1beta2.PurgeStatus{
+				StartedAt: apimetav1.Unix(1700000000, 0),
+				Resources: []v1beta2.PurgedResources{{CRD: "issuers.cert-manager.io", Found: 2, Deleted: 1}},
+			},
+		},
+	}
+}
+
+func newPurgeReport(name string, created time.Time, completedAt *apimetav1.Time) *v1beta2.PurgeReport {
+	return &v1beta2.PurgeReport{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "kcp-system",
+			CreationTimestamp: apimetav1.Time{Time: created},
+		},
+		Spec: v1beta2.PurgeReportSpec{CompletedAt: completedAt},
+	}
+}
+
+func newScheme(t *testing.T) *machineryruntime.Scheme {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	return scheme
+}
@@ -0,0 +1,198 @@
+package crd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups=operator.kyma-project.io,resources=modulereleasemetas,verbs=update
+
+const resourceVersionPairCount = 2
+
+var ErrNoStorageVersion = errors.New("CRD has no storage version")
+
+// MigrationProgress is the progress of the storage version migration of a CRD.
+type MigrationProgress struct {
+	// StorageVersion is the version the objects are migrated to.
+	StorageVersion string `json:"storageVersion"`
+	// Continue is the continue token of the next batch of objects to migrate.
+	Continue string `json:"continue,omitempty"`
+	// Migrated is the number of migrated objects.
+	Migrated int64 `json:"migrated"`
+	// Completed is true once all objects are migrated.
+	Completed bool `json:"completed"`
+}
+
+// ProgressStore persists the progress of storage version migrations, so that an interrupted migration is resumed.
+type ProgressStore interface {
+	Load(ctx context.Context, crdName string) (MigrationProgress, error)
+	Save(ctx context.Context, crdName string, progress MigrationProgress) error
+}
+
+// StorageVersionMigration re-persists all objects of a CRD in its storage version by updating them without changes,
+// in batches. Only once all objects are migrated, the old versions are dropped from the stored versions of the CRD.
+type StorageVersionMigration struct {
+	clnt      client.Client
+	batchSize int64
+	progress  ProgressStore
+}
+
+// NewStorageVersionMigration creates a migration listing the given number of objects per batch. Without a progress
+// store, each migration starts from the first object.
+func NewStorageVersionMigration(clnt client.Client, batchSize int64, progress ProgressStore,
+) *StorageVersionMigration {
+	return &StorageVersionMigration{clnt: clnt, batchSize: batchSize, progress: progress}
+}
+
+// RequiresMigration returns true if the given versions, or any version other than the storage version if none are
+// given, are still stored for the CRD.
+func RequiresMigration(crd *apiextensionsv1.CustomResourceDefinition, versionsToDrop ...string) bool {
+	storageVersion, err := StorageVersion(crd)
+	if err != nil {
+		return false
+	}
+	return len(staleVersions(crd, storageVersion, versionsToDrop)) > 0
+}
+
+// Migrate migrates the objects of the CRD to its storage version and drops the given versions, or all versions other
+// than the storage version if none are given, from its stored versions.
+func (m *StorageVersionMigration) Migrate(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition,
+	versionsToDrop ...string,
+) error {
+	storageVersion, err := StorageVersion(crd)
+	if err != nil {
+		return err
+	}
+	if len(staleVersions(crd, storageVersion, versionsToDrop)) == 0 {
+		return nil
+	}
+
+	progress, err := m.loadProgress(ctx, crd.Name)
+	if err != nil {
+		return err
+	}
+	if progress.StorageVersion != storageVersion {
+		progress = MigrationProgress{StorageVersion: storageVersion}
+	}
+	gvk := schema.GroupVersionKind{
+		Group: crd.Spec.Group, Version: storageVersion, Kind: crd.Spec.Names.Kind + "List",
+	}
+	for !progress.Completed {
+		if err := m.migrateBatch(ctx, gvk, &progress); err != nil {
+			return fmt.Errorf("failed to migrate %s to %s: %w", crd.Name, storageVersion, err)
+		}
+		if err := m.saveProgress(ctx, crd.Name, progress); err != nil {
+			return err
+		}
+	}
+
+	// the CRD is read again, as its stored versions may have changed during the migration
+	if err := m.clnt.Get(ctx, client.ObjectKeyFromObject(crd), crd); err != nil {
+		return fmt.Errorf("failed to get CRD %s: %w", crd.Name, err)
+	}
+	stale := staleVersions(crd, storageVersion, versionsToDrop)
+	crd.Status.StoredVersions = slices.DeleteFunc(crd.Status.StoredVersions, func(version string) bool {
+		return slices.Contains(stale, version)
+	})
+	if err := m.clnt.Status().Update(ctx, crd); err != nil {
+		return fmt.Errorf("failed to drop stored versions of CRD %s: %w", crd.Name, err)
+	}
+	return nil
+}
+
+func (m *StorageVersionMigration) migrateBatch(ctx context.Context, gvk schema.GroupVersionKind,
+	progress *MigrationProgress,
+) error {
+	objects := &unstructured.UnstructuredList{}
+	objects.SetGroupVersionKind(gvk)
+	err := m.clnt.List(ctx, objects, client.Limit(m.batchSize), client.Continue(progress.Continue))
+	if apierrors.IsResourceExpired(err) {
+		// the continue token is outdated, the objects are migrated again from the start
+		progress.Continue = ""
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	for i := range objects.Items {
+		// an update without changes stores the object in the storage version
+		err := m.clnt.Update(ctx, &objects.Items[i])
+		if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+			return fmt.Errorf("failed to update %s %s: %w", objects.Items[i].GetKind(),
+				client.ObjectKeyFromObject(&objects.Items[i]), err)
+		}
+	}
+	progress.Migrated += int64(len(objects.Items))
+	progress.Continue = objects.GetContinue()
+	progress.Completed = progress.Continue == ""
+	return nil
+}
+
+func (m *StorageVersionMigration) loadProgress(ctx context.Context, crdName string) (MigrationProgress, error) {
+	if m.progress == nil {
+		return MigrationProgress{}, nil
+	}
+	progress, err := m.progress.Load(ctx, crdName)
+	if err != nil {
+		return MigrationProgress{}, fmt.Errorf("failed to load migration progress of CRD %s: %w", crdName, err)
+	}
+	return progress, nil
+}
+
+func (m *StorageVersionMigration) saveProgress(ctx context.Context, crdName string, progress MigrationProgress,
+) error {
+	if m.progress == nil {
+		return nil
+	}
+	if err := m.progress.Save(ctx, crdName, progress); err != nil {
+		return fmt.Errorf("failed to save migration progress of CRD %s: %w", crdName, err)
+	}
+	return nil
+}
+
+// StorageVersion returns the version of the CRD in which objects are persisted.
+func StorageVersion(crd *apiextensionsv1.CustomResourceDefinition) (string, error) {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrNoStorageVersion, crd.Name)
+}
+
+func staleVersions(crd *apiextensionsv1.CustomResourceDefinition, storageVersion string,
+	versionsToDrop []string,
+) []string {
+	var stale []string
+	for _, stored := range crd.Status.StoredVersions {
+		if stored == storageVersion {
+			continue
+		}
+		if len(versionsToDrop) == 0 || slices.Contains(versionsToDrop, stored) {
+			stale = append(stale, stored)
+		}
+	}
+	return stale
+}
+
+// ParseStorageVersionsMap parses a comma-separated list of 'kind:version' pairs into a map of versions per kind.
+func ParseStorageVersionsMap(versions string) map[string]string {
+	versionsToBeDroppedMap := map[string]string{}
+	for _, pair := range strings.Split(versions, ",") {
+		if kv := strings.Split(pair, ":"); len(kv) == resourceVersionPairCount {
+			versionsToBeDroppedMap[kv[0]] = kv[1]
+		}
+	}
+
+	return versionsToBeDroppedMap
+}
@@ -0,0 +1,66 @@
+package crd
+
+import (
+	"context"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/pkg/log"
+)
+
+// StorageVersionMigrationRunnable migrates the objects of the Lifecycle Manager CRDs in KCP to their storage version
+// once the manager becomes the leader, and drops the given versions from the stored versions of the CRDs afterward.
+type StorageVersionMigrationRunnable struct {
+	clnt                client.Client
+	migration           *StorageVersionMigration
+	versionsToBeDropped map[string]string
+}
+
+// NewStorageVersionMigrationRunnable creates the runnable for the versions to be dropped per kind, in the format of
+// ParseStorageVersionsMap. The client should not be cached, so that all objects are migrated.
+func NewStorageVersionMigrationRunnable(clnt client.Client, versionsToBeDropped string, batchSize int64,
+	progress ProgressStore,
+) *StorageVersionMigrationRunnable {
+	return &StorageVersionMigrationRunnable{
+		clnt:                clnt,
+		migration:           NewStorageVersionMigration(clnt, batchSize, progress),
+		versionsToBeDropped: ParseStorageVersionsMap(versionsToBeDropped),
+	}
+}
+
+// Start migrates the CRDs one by one. A failed migration is logged and retried on the next start, it does not stop
+// the manager.
+func (r *StorageVersionMigrationRunnable) Start(ctx context.Context) error {
+	logger := ctrl.Log.WithName("storage-version-migration")
+	crdList := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := r.clnt.List(ctx, crdList); err != nil {
+		logger.V(log.InfoLevel).Error(err, "unable to list CRDs")
+		return nil
+	}
+
+	for i := range crdList.Items {
+		crd := &crdList.Items[i]
+		versionToBeDropped, crdFound := r.versionsToBeDropped[crd.Spec.Names.Kind]
+		if crd.Spec.Group != shared.OperatorGroup || !crdFound || !RequiresMigration(crd, versionToBeDropped) {
+			continue
+		}
+		logger.V(log.InfoLevel).Info(fmt.Sprintf("Migrating the objects of the %s CRD to drop the stored version %s",
+			crd.Spec.Names.Kind, versionToBeDropped))
+		if err := r.migration.Migrate(ctx, crd, versionToBeDropped); err != nil {
+			logger.V(log.InfoLevel).Error(err, fmt.Sprintf("Failed to migrate the %s CRD", crd.Spec.Names.Kind))
+			continue
+		}
+		logger.V(log.InfoLevel).Info(fmt.Sprintf("The new storedVersions of the %s CRD are %v",
+			crd.Spec.Names.Kind, crd.Status.StoredVersions))
+	}
+	return nil
+}
+
+// NeedLeaderElection makes sure that only one replica migrates the objects.
+func (r *StorageVersionMigrationRunnable) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,131 @@
+package crd_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/internal/crd"
+)
+
+func TestParseStorageVersionsMap(t *testing.T) {
+	versions := "Manifest:v1beta1,Watcher:v1beta1,ModuleTemplate:v1beta1,Kyma:v1beta1"
+
+	expectedOutput := map[string]string{
+		"Manifest":       "v1beta1",
+		"Watcher":        "v1beta1",
+		"ModuleTemplate": "v1beta1",
+		"Kyma":           "v1beta1",
+	}
+	assert.Equalf(t, expectedOutput, crd.ParseStorageVersionsMap(versions), "parseStorageVersionsMap(%v)",
+		versions)
+}
+
+func TestStorageVersionMigrationRunnable_MigratesAndDropsStoredVersion(t *testing.T) {
+	versionToBeDropped := "Manifest:v1beta1"
+	manifestCrd := newCrd("Manifest", "v1beta1", "v1beta2")
+	moduleTemplateCrd := newCrd("ModuleTemplate", "v1beta1", "v1beta2")
+	manifest := newObject("Manifest", "manifest")
+	fakeClient := fake.NewClientBuilder().WithScheme(newScheme(t)).
+		WithObjects(manifestCrd, moduleTemplateCrd, manifest).Build()
+	progressKey := client.ObjectKey{Name: "storage-version-migration", Namespace: "kcp-system"}
+
+	runnable := crd.NewStorageVersionMigrationRunnable(fakeClient, versionToBeDropped, 100,
+		crd.NewConfigMapProgressStore(fakeClient, progressKey))
+	require.NoError(t, runnable.Start(context.Background()))
+
+	assert.Equal(t, []string{"v1beta2"}, getCrd(t, fakeClient, manifestCrd).Status.StoredVersions)
+	assert.Equal(t, []string{"v1beta1", "v1beta2"}, getCrd(t, fakeClient, moduleTemplateCrd).Status.StoredVersions)
+	assert.NotEqual(t, manifest.GetResourceVersion(), getObject(t, fakeClient, manifest).GetResourceVersion(),
+		"the object should be updated to persist it in the storage version")
+
+	progress, err := crd.NewConfigMapProgressStore(fakeClient, progressKey).Load(context.Background(),
+		manifestCrd.Name)
+	require.NoError(t, err)
+	assert.Equal(t, crd.MigrationProgress{StorageVersion: "v1beta2", Migrated: 1, Completed: true}, progress)
+}
+
+func TestStorageVersionMigration_DropsAllStaleVersionsWithoutVersionsToDrop(t *testing.T) {
+	kymaCrd := newCrd("Kyma", "v1alpha1", "v1beta1", "v1beta2")
+	fakeClient := fake.NewClientBuilder().WithScheme(newScheme(t)).
+		WithObjects(kymaCrd, newObject("Kyma", "kyma-1"), newObject("Kyma", "kyma-2")).Build()
+
+	err := crd.NewStorageVersionMigration(fakeClient, 1, nil).Migrate(context.Background(), kymaCrd)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1beta2"}, getCrd(t, fakeClient, kymaCrd).Status.StoredVersions)
+}
+
+func TestStorageVersionMigration_SkipsMigratedCrd(t *testing.T) {
+	kymaCrd := newCrd("Kyma", "v1beta2")
+	kyma := newObject("Kyma", "kyma")
+	fakeClient := fake.NewClientBuilder().WithScheme(newScheme(t)).WithObjects(kymaCrd, kyma).Build()
+
+	require.False(t, crd.RequiresMigration(kymaCrd))
+	require.NoError(t, crd.NewStorageVersionMigration(fakeClient, 1, nil).Migrate(context.Background(), kymaCrd))
+
+	assert.Equal(t, kyma.GetResourceVersion(), getObject(t, fakeClient, kyma).GetResourceVersion())
+}
+
+func newScheme(t *testing.T) *machineryruntime.Scheme {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	require.NoError(t, apicorev1.AddToScheme(scheme))
+	return scheme
+}
+
+// newCrd returns a CRD with the given stored versions, the last one is the storage version.
+func newCrd(kind string, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+	versions := make([]apiextensionsv1.CustomResourceDefinitionVersion, 0, len(storedVersions))
+	for i, version := range storedVersions {
+		versions = append(versions, apiextensionsv1.CustomResourceDefinitionVersion{
+			Name: version, Served: true, Storage: i == len(storedVersions)-1,
+		})
+	}
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: apimetav1.ObjectMeta{Name: strings.ToLower(kind) + "s.operator.kyma-project.io"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Names:    apiextensionsv1.CustomResourceDefinitionNames{Kind: kind},
+			Group:    "operator.kyma-project.io",
+			Versions: versions,
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+	}
+}
+
+func newObject(kind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("operator.kyma-project.io/v1beta2")
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace("kcp-system")
+	return obj
+}
+
+func getCrd(t *testing.T, clnt client.Client,
+	crdItem *apiextensionsv1.CustomResourceDefinition,
+) *apiextensionsv1.CustomResourceDefinition {
+	t.Helper()
+	updatedCrd := &apiextensionsv1.CustomResourceDefinition{}
+	require.NoError(t, clnt.Get(context.Background(), client.ObjectKeyFromObject(crdItem), updatedCrd))
+	return updatedCrd
+}
+
+func getObject(t *testing.T, clnt client.Client, obj *unstructured.Unstructured) *unstructured.Unstructured {
+	t.Helper()
+	updatedObj := &unstructured.Unstructured{}
+	updatedObj.SetGroupVersionKind(obj.GroupVersionKind())
+	require.NoError(t, clnt.Get(context.Background(), client.ObjectKeyFromObject(obj), updatedObj))
+	return updatedObj
+}
@@ -0,0 +1,72 @@
+package crd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apicorev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// ConfigMapProgressStore keeps the progress of the storage version migrations in a ConfigMap, with one key per CRD.
+type ConfigMapProgressStore struct {
+	clnt client.Client
+	key  client.ObjectKey
+}
+
+func NewConfigMapProgressStore(clnt client.Client, key client.ObjectKey) *ConfigMapProgressStore {
+	return &ConfigMapProgressStore{clnt: clnt, key: key}
+}
+
+func (s *ConfigMapProgressStore) Load(ctx context.Context, crdName string) (MigrationProgress, error) {
+	configMap := &apicorev1.ConfigMap{}
+	if err := s.clnt.Get(ctx, s.key, configMap); err != nil {
+		if util.IsNotFound(err) {
+			return MigrationProgress{}, nil
+		}
+		return MigrationProgress{}, fmt.Errorf("failed to get ConfigMap %s: %w", s.key, err)
+	}
+	progress := MigrationProgress{}
+	data, found := configMap.Data[crdName]
+	if !found {
+		return progress, nil
+	}
+	if err := json.Unmarshal([]byte(data), &progress); err != nil {
+		return MigrationProgress{}, fmt.Errorf("failed to parse progress of CRD %s: %w", crdName, err)
+	}
+	return progress, nil
+}
+
+func (s *ConfigMapProgressStore) Save(ctx context.Context, crdName string, progress MigrationProgress) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("failed to serialize progress of CRD %s: %w", crdName, err)
+	}
+	configMap := &apicorev1.ConfigMap{}
+	err = s.clnt.Get(ctx, s.key, configMap)
+	if util.IsNotFound(err) {
+		configMap.SetName(s.key.Name)
+		configMap.SetNamespace(s.key.Namespace)
+		configMap.SetLabels(map[string]string{shared.ManagedBy: shared.OperatorName})
+		configMap.Data = map[string]string{crdName: string(data)}
+		if err := s.clnt.Create(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to create ConfigMap %s: %w", s.key, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get ConfigMap %s: %w", s.key, err)
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[crdName] = string(data)
+	if err := s.clnt.Update(ctx, configMap); err != nil {
+		return fmt.Errorf("failed to update ConfigMap %s: %w", s.key, err)
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/bluegreen"
 	"github.com/kyma-project/lifecycle-manager/internal/util/collections"
 )
 
@@ -51,3 +53,13 @@ func ManagedByOwnedBy(_ context.Context, obj Object, resources []*unstructured.U
 	}
 	return nil
 }
+
+// BlueGreenTransform prepares the resources of a manifest with a BlueGreen upgrade strategy to run side by side with
+// the resources of other versions of the module.
+func BlueGreenTransform(_ context.Context, obj Object, resources []*unstructured.Unstructured) error {
+	manifest, ok := obj.(*v1beta2.Manifest)
+	if !ok || !bluegreen.IsEnabled(manifest) {
+		return nil
+	}
+	return bluegreen.PrepareSideBySide(manifest, resources)
+}
@@ -105,6 +105,18 @@ func Test_defaultTransforms(t *testing.T) {
 				return true
 			},
 		},
+		{
+			"BlueGreenTransform ignores objects other than manifests",
+			declarativev2.BlueGreenTransform,
+			[]*unstructured.Unstructured{{Object: map[string]any{"apiVersion": "apps/v1", "kind": "Deployment"}}},
+			func(testingT assert.TestingT, err error, i ...interface{}) bool {
+				require.NoError(t, err)
+				unstructs, ok := i[0].([]*unstructured.Unstructured)
+				assert.True(testingT, ok)
+				assert.Empty(testingT, unstructs[0].GetLabels())
+				return true
+			},
+		},
 	}
 	for _, testCase := range tests {
 		t.Run(
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
 )
 
 const (
@@ -26,6 +27,7 @@ func DefaultOptions() *Options {
 			ManagedByOwnedBy,
 			KymaComponentTransform,
 			DisclaimerTransform,
+			BlueGreenTransform,
 		),
 		WithSingletonClientCache(NewMemoryClientCache()),
 		WithManifestCache(os.TempDir()),
@@ -49,6 +51,8 @@ type Options struct {
 	CustomStateCheck StateCheck
 
 	PostRenderTransforms []ObjectTransform
+
+	AuditRecorder *audit.Recorder
 }
 
 type Option interface {
@@ -176,3 +180,15 @@ type WithClientCacheKeyOption struct {
 func (o WithClientCacheKeyOption) Apply(options *Options) {
 	options.ClientCacheKeyFn = o.ClientCacheKeyFn
 }
+
+type WithAuditRecorderOption struct {
+	*audit.Recorder
+}
+
+func WithAuditRecorder(recorder *audit.Recorder) WithAuditRecorderOption {
+	return WithAuditRecorderOption{Recorder: recorder}
+}
+
+func (o WithAuditRecorderOption) Apply(options *Options) {
+	options.AuditRecorder = o.Recorder
+}
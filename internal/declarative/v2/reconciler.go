@@ -7,6 +7,7 @@ import (
 	"time"
 
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/cli-runtime/pkg/resource"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,13 +18,18 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal"
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/bluegreen"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/finalizer"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/hooks"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/labelsremoval"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/readoption"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/status"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/resources"
+	"github.com/kyma-project/lifecycle-manager/internal/tracing"
 	"github.com/kyma-project/lifecycle-manager/pkg/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/queue"
 	"github.com/kyma-project/lifecycle-manager/pkg/util"
@@ -80,7 +86,6 @@ type Reconciler struct {
 	managedLabelRemovalService ManagedByLabelRemoval
 }
 
-//nolint:funlen,cyclop,gocognit // Declarative pkg will be removed soon
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	startTime := time.Now()
 	defer r.recordReconciliationDuration(startTime, req.Name)
@@ -94,6 +99,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		r.ManifestMetrics.RecordRequeueReason(metrics.ManifestRetrieval, queue.UnexpectedRequeue)
 		return ctrl.Result{}, fmt.Errorf("manifestController: %w", err)
 	}
+
+	// The reconciliations rolling out a change continue the trace of the Kyma reconciliation that made it,
+	// once the Manifest is ready, the periodic reconciliations only link to it.
+	ctx, span := tracing.StartFromAnnotations(ctx, manifest, manifest.GetStatus().State != shared.StateReady,
+		"Manifest.Reconcile", tracing.ManifestKey.String(req.NamespacedName.String()),
+		tracing.ModuleKey.String(manifest.GetLabels()[shared.ModuleName]))
+	result, err := r.reconcile(ctx, manifest)
+	tracing.End(span, err)
+	return result, err
+}
+
+//nolint:funlen,cyclop,gocognit // Declarative pkg will be removed soon
+func (r *Reconciler) reconcile(ctx context.Context, manifest *v1beta2.Manifest) (ctrl.Result, error) {
 	manifestStatus := manifest.GetStatus()
 
 	if manifest.SkipReconciliation() {
@@ -139,7 +157,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
-	spec, err := r.specResolver.GetSpec(ctx, manifest)
+	specCtx, span := tracing.Start(ctx, "Manifest.GetSpec")
+	start := time.Now()
+	spec, err := r.specResolver.GetSpec(specCtx, manifest)
+	r.observePhase(manifest, metrics.ManifestPhaseSpecResolve, start, err)
+	tracing.End(span, err)
 	if err != nil {
 		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
 		if !manifest.GetDeletionTimestamp().IsZero() {
@@ -153,7 +175,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.updateManifest(ctx, manifest, metrics.ManifestInitSyncedOCIRef)
 	}
 
-	target, current, err := r.renderResources(ctx, skrClient, manifest, spec)
+	renderCtx, span := tracing.Start(ctx, "Manifest.RenderResources")
+	start = time.Now()
+	target, current, err := r.renderResources(renderCtx, skrClient, manifest, spec)
+	r.observePhase(manifest, metrics.ManifestPhaseRender, start, err)
+	tracing.End(span, err)
 	if err != nil {
 		if util.IsConnectionRelatedError(err) {
 			r.invalidateClientCache(ctx, manifest)
@@ -163,8 +189,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.finishReconcile(ctx, manifest, metrics.ManifestRenderResources, manifestStatus, err)
 	}
 
-	if err := r.pruneDiff(ctx, skrClient, manifest, current, target, spec); errors.Is(err,
-		resources.ErrDeletionNotFinished) {
+	if manifest.GetDeletionTimestamp().IsZero() && readoption.IsRequired(manifest) {
+		return r.readopt(ctx, skrClient, manifest, manifestStatus, target)
+	}
+
+	if manifest.GetDeletionTimestamp().IsZero() && spec.HooksPath != "" &&
+		requireUpdateSyncedOCIRefAnnotation(manifest, spec.OCIRef) &&
+		!status.HooksCompleted(manifest, status.ConditionTypePreUpgradeHooks, spec.OCIRef) {
+		return r.runPreUpgradeHooks(ctx, skrClient, manifest, manifestStatus, spec)
+	}
+
+	if manifest.GetDeletionTimestamp().IsZero() && bluegreen.IsEnabled(manifest) &&
+		requireUpdateSyncedOCIRefAnnotation(manifest, spec.OCIRef) {
+		return r.upgradeSideBySide(ctx, skrClient, manifest, manifestStatus, current, target, spec)
+	}
+
+	pruneCtx, span := tracing.Start(ctx, "Manifest.PruneDiff")
+	start = time.Now()
+	err = r.pruneDiff(pruneCtx, skrClient, manifest, current, target, spec)
+	r.observePhase(manifest, metrics.ManifestPhasePrune, start, ignoreRequeue(err, resources.ErrDeletionNotFinished))
+	tracing.End(span, err)
+	if errors.Is(err, resources.ErrDeletionNotFinished) {
 		r.ManifestMetrics.RecordRequeueReason(metrics.ManifestPruneDiffNotFinished, queue.IntendedRequeue)
 		return ctrl.Result{Requeue: true}, nil
 	} else if err != nil {
@@ -181,14 +226,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
-	if err := skrresources.SyncResources(ctx, skrClient, manifest, target); err != nil {
+	syncCtx, span := tracing.Start(ctx, "Manifest.SyncResources")
+	start = time.Now()
+	err = skrresources.SyncResources(syncCtx, skrClient, manifest, target)
+	r.observePhase(manifest, metrics.ManifestPhaseSSA, start, err)
+	tracing.End(span, err)
+	if err != nil {
 		if errors.Is(err, skrresources.ErrClientUnauthorized) {
 			r.invalidateClientCache(ctx, manifest)
 		}
 		return r.finishReconcile(ctx, manifest, metrics.ManifestSyncResources, manifestStatus, err)
 	}
 
-	if err := r.syncManifestState(ctx, skrClient, manifest, target); err != nil {
+	stateCtx, span := tracing.Start(ctx, "Manifest.SyncState")
+	start = time.Now()
+	err = r.syncManifestState(stateCtx, skrClient, manifest, target, spec)
+	r.observePhase(manifest, metrics.ManifestPhaseStateCheck, start, ignoreRequeue(err, finalizer.ErrRequeueRequired))
+	tracing.End(span, err)
+	if err != nil {
 		if errors.Is(err, finalizer.ErrRequeueRequired) {
 			r.ManifestMetrics.RecordRequeueReason(metrics.ManifestSyncResourcesEnqueueRequired, queue.IntendedRequeue)
 			return ctrl.Result{Requeue: true}, nil
@@ -204,12 +259,31 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	if !manifest.GetDeletionTimestamp().IsZero() {
+		if err := removeHookJobs(ctx, skrClient, manifest); err != nil {
+			return r.finishReconcile(ctx, manifest, metrics.ManifestReconcileFinished, manifestStatus, err)
+		}
 		return r.cleanupManifest(ctx, manifest, manifestStatus, metrics.ManifestReconcileFinished, nil)
 	}
 
 	return r.finishReconcile(ctx, manifest, metrics.ManifestReconcileFinished, manifestStatus, nil)
 }
 
+// observePhase records the duration and the error of the phase of the reconciliation of the manifest.
+func (r *Reconciler) observePhase(manifest *v1beta2.Manifest, phase metrics.ManifestPhase, start time.Time,
+	err error,
+) {
+	r.ManifestMetrics.ObservePhase(manifest.GetLabels()[shared.KymaName], manifest.GetLabels()[shared.ModuleName],
+		phase, time.Since(start), err)
+}
+
+// ignoreRequeue returns nil if the error only signals that the phase requires a requeue.
+func ignoreRequeue(err, requeueErr error) error {
+	if errors.Is(err, requeueErr) {
+		return nil
+	}
+	return err
+}
+
 func recordMandatoryModuleState(manifest *v1beta2.Manifest, r *Reconciler) {
 	if manifest.IsMandatoryModule() {
 		state := manifest.GetStatus().State
@@ -231,6 +305,223 @@ func (r *Reconciler) handleLabelsRemovalFinalizer(ctx context.Context, skrClient
 	return ctrl.Result{Requeue: true}, nil
 }
 
+// readopt adopts the existing resources of a module which is managed again. If the existing resources differ from the
+// rendered target, the differing fields are reported and the manifest waits in the ReadoptionPending state until the
+// re-adoption is confirmed. Once adopted, the managed-by labels are restored and the resources are synced as usual.
+func (r *Reconciler) readopt(ctx context.Context, skrClient Client, manifest *v1beta2.Manifest,
+	manifestStatus shared.Status, target []*resource.Info,
+) (ctrl.Result, error) {
+	objects, err := toUnstructured(target)
+	if err != nil {
+		return r.finishReconcile(ctx, manifest, metrics.ManifestReadoption, manifestStatus, err)
+	}
+	conflicts, err := readoption.FindConflicts(ctx, skrClient, objects)
+	if err != nil {
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
+		return r.finishReconcile(ctx, manifest, metrics.ManifestReadoption, manifestStatus, err)
+	}
+	if len(conflicts) > 0 && !readoption.IsConfirmed(manifest) {
+		pendingStatus := manifest.GetStatus().WithState(shared.StateReadoptionPending).
+			WithOperation(fmt.Sprintf("%s: %d existing resources differ, set the %s annotation to %s to overwrite them",
+				readoption.ErrConfirmationRequired, len(conflicts), shared.ReadoptionAnnotation,
+				shared.ReadoptionConfirmed))
+		pendingStatus.ReadoptionConflicts = conflicts
+		manifest.SetStatus(pendingStatus)
+		return r.finishReconcile(ctx, manifest, metrics.ManifestReadoption, manifestStatus, nil)
+	}
+
+	if moduleCR := modulecr.GetModuleCR(manifest); moduleCR != nil {
+		objects = append(objects, moduleCR)
+	}
+	if err := readoption.RestoreManagedByLabel(ctx, skrClient, objects); err != nil {
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
+		return r.finishReconcile(ctx, manifest, metrics.ManifestReadoption, manifestStatus, err)
+	}
+
+	reason := "existing resources do not differ from the desired state"
+	if len(conflicts) > 0 {
+		reason = fmt.Sprintf("overwriting %d differing existing resources is confirmed", len(conflicts))
+	}
+	readoptedStatus := manifest.GetStatus().WithState(shared.StateProcessing).
+		WithOperation("existing resources are re-adopted")
+	readoptedStatus.ReadoptionConflicts = nil
+	readoption.Complete(manifest)
+	if err := r.manifestClient.UpdateManifest(ctx, manifest); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.recordAudit(ctx, manifest, audit.OperationReadopt, reason)
+	manifest.SetStatus(readoptedStatus)
+	return r.finishReconcile(ctx, manifest, metrics.ManifestReadoption, manifestStatus, nil)
+}
+
+// runPreUpgradeHooks runs the pre-upgrade hooks of a new version before its resources are applied. The upgrade is
+// blocked until the hooks completed. Once they did, the post-upgrade hooks wait for the new version to become ready.
+func (r *Reconciler) runPreUpgradeHooks(ctx context.Context, skrClient Client, manifest *v1beta2.Manifest,
+	manifestStatus shared.Status, spec *Spec,
+) (ctrl.Result, error) {
+	completed, err := r.runUpgradeHooks(ctx, skrClient, manifest, spec, shared.HookPreUpgrade,
+		status.ConditionTypePreUpgradeHooks)
+	if err != nil {
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
+		return r.finishReconcile(ctx, manifest, metrics.ManifestUpgradeHooks, manifestStatus, nil)
+	}
+	if !completed {
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateProcessing).
+			WithOperation("waiting for pre-upgrade hooks to complete"))
+		return r.finishReconcile(ctx, manifest, metrics.ManifestUpgradeHooks, manifestStatus, nil)
+	}
+
+	status.SetHooksCondition(manifest, status.ConditionTypePostUpgradeHooks, spec.OCIRef,
+		status.ConditionReasonHooksPending, "waiting for the new version to become ready")
+	if err := r.manifestClient.PatchStatusIfDiffExist(ctx, manifest, manifestStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.ManifestMetrics.RecordRequeueReason(metrics.ManifestUpgradeHooks, queue.IntendedRequeue)
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// runUpgradeHooks runs the hooks of the phase for the version of the spec and reports them in the condition.
+// It returns true once the hooks completed. A failed pre-upgrade hook is recorded as a blocked upgrade. Once the
+// post-upgrade hooks completed, the hook Jobs of the upgrade are removed.
+func (r *Reconciler) runUpgradeHooks(ctx context.Context, skrClient Client, manifest *v1beta2.Manifest, spec *Spec,
+	phase string, conditionType status.ConditionType,
+) (bool, error) {
+	jobs, err := hooks.Load(spec.HooksPath, phase, spec.OCIRef, defaultNamespace(manifest),
+		manifest.GetLabels()[shared.ModuleName])
+	completed := false
+	if err == nil {
+		completed, err = hooks.Run(ctx, skrClient, jobs, time.Now())
+	}
+	if err == nil && completed && phase == shared.HookPostUpgrade {
+		err = removeHookJobs(ctx, skrClient, manifest)
+	}
+	switch {
+	case err != nil:
+		if phase == shared.HookPreUpgrade && !status.HooksFailed(manifest, conditionType, spec.OCIRef) {
+			r.recordAudit(ctx, manifest, audit.OperationUpgradeBlocked, err.Error())
+		}
+		status.SetHooksCondition(manifest, conditionType, spec.OCIRef, status.ConditionReasonHooksFailed,
+			err.Error())
+		return false, err
+	case completed:
+		status.SetHooksCondition(manifest, conditionType, spec.OCIRef, status.ConditionReasonHooksCompleted,
+			phase+" hooks completed")
+	default:
+		status.SetHooksCondition(manifest, conditionType, spec.OCIRef, status.ConditionReasonHooksRunning,
+			"waiting for "+phase+" hooks to complete")
+	}
+	return completed, nil
+}
+
+// removeHookJobs removes the hook Jobs of the module of the manifest. Without a tenant, the Jobs are looked up in all
+// namespaces, as a hook Job may declare its own namespace.
+func removeHookJobs(ctx context.Context, skrClient Client, manifest *v1beta2.Manifest) error {
+	return hooks.Remove(ctx, skrClient, manifest.GetLabels()[shared.ModuleName],
+		manifest.GetLabels()[shared.TenantNamespaceLabel])
+}
+
+// upgradeSideBySide upgrades a module with a BlueGreen upgrade strategy. The resources added by the new version are
+// applied alongside the resources of the previous version, which keep their state. Once the new version is Ready, all
+// its resources are applied and the resources of the previous version are removed. If the new version does not become
+// Ready in time, its added resources are removed instead.
+func (r *Reconciler) upgradeSideBySide(ctx context.Context, skrClient Client, manifest *v1beta2.Manifest,
+	manifestStatus shared.Status, current, target []*resource.Info, spec *Spec,
+) (ctrl.Result, error) {
+	if bluegreen.IsAborted(manifest, spec.OCIRef) {
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(bluegreen.ErrUpgradeAborted))
+		return r.finishReconcile(ctx, manifest, metrics.ManifestBlueGreenUpgrade, manifestStatus, nil)
+	}
+	bluegreen.Start(manifest, spec.OCIRef, current, target, time.Now())
+
+	sideBySide := bluegreen.AddedResources(manifest, target)
+	if err := skrresources.SyncResourcesSideBySide(ctx, skrClient, manifest, sideBySide); err != nil {
+		if errors.Is(err, skrresources.ErrClientUnauthorized) {
+			r.invalidateClientCache(ctx, manifest)
+		}
+		return r.finishReconcile(ctx, manifest, metrics.ManifestSyncResources, manifestStatus, err)
+	}
+
+	err := r.syncManifestState(ctx, skrClient, manifest, sideBySide, nil)
+	if errors.Is(err, finalizer.ErrRequeueRequired) {
+		r.ManifestMetrics.RecordRequeueReason(metrics.ManifestSyncResourcesEnqueueRequired, queue.IntendedRequeue)
+		return ctrl.Result{Requeue: true}, nil
+	}
+	if err = ignoreRequeue(err, errStateRequireUpdate); err != nil {
+		return r.finishReconcile(ctx, manifest, metrics.ManifestSyncState, manifestStatus, err)
+	}
+
+	if manifest.GetStatus().State == shared.StateReady {
+		return r.completeSideBySide(ctx, skrClient, manifest, manifestStatus, current, target, spec)
+	}
+	if bluegreen.IsTimedOut(manifest, time.Now()) {
+		return r.abortSideBySide(ctx, skrClient, manifest, manifestStatus)
+	}
+	return r.finishReconcile(ctx, manifest, metrics.ManifestBlueGreenUpgrade, manifestStatus, nil)
+}
+
+// completeSideBySide switches over to the Ready new version and removes the resources of the previous version.
+func (r *Reconciler) completeSideBySide(ctx context.Context, skrClient Client, manifest *v1beta2.Manifest,
+	manifestStatus shared.Status, current, target []*resource.Info, spec *Spec,
+) (ctrl.Result, error) {
+	if err := skrresources.SyncResourcesSideBySide(ctx, skrClient, manifest, target); err != nil {
+		return r.finishReconcile(ctx, manifest, metrics.ManifestSyncResources, manifestStatus, err)
+	}
+
+	err := r.pruneDiff(ctx, skrClient, manifest, current, target, spec)
+	if errors.Is(err, resources.ErrDeletionNotFinished) {
+		r.ManifestMetrics.RecordRequeueReason(metrics.ManifestPruneDiffNotFinished, queue.IntendedRequeue)
+		return ctrl.Result{Requeue: true}, nil
+	} else if err != nil {
+		return r.finishReconcile(ctx, manifest, metrics.ManifestPruneDiff, manifestStatus, err)
+	}
+
+	bluegreen.Complete(manifest, target, time.Now())
+	if err := r.manifestClient.PatchStatusIfDiffExist(ctx, manifest, manifestStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.recordAudit(ctx, manifest, audit.OperationApplied, "new version is Ready, the previous version is removed")
+	updateSyncedOCIRefAnnotation(manifest, spec.OCIRef)
+	return r.updateManifest(ctx, manifest, metrics.ManifestUpdateSyncedOCIRef)
+}
+
+// abortSideBySide removes the resources added by the new version, which did not become Ready in time, and keeps the
+// previous version.
+func (r *Reconciler) abortSideBySide(ctx context.Context, skrClient Client, manifest *v1beta2.Manifest,
+	manifestStatus shared.Status,
+) (ctrl.Result, error) {
+	converter := skrresources.NewResourceToInfoConverter(skrresources.ResourceInfoConverter(skrClient),
+		defaultNamespace(manifest))
+	added, err := converter.ResourcesToInfos(manifest.GetStatus().Upgrade.Added)
+	if err != nil {
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
+		return r.finishReconcile(ctx, manifest, metrics.ManifestBlueGreenUpgrade, manifestStatus, err)
+	}
+	err = resources.NewConcurrentCleanup(skrClient, manifest).DeleteDiffResources(ctx, added)
+	if errors.Is(err, resources.ErrDeletionNotFinished) {
+		r.ManifestMetrics.RecordRequeueReason(metrics.ManifestPruneDiffNotFinished, queue.IntendedRequeue)
+		return ctrl.Result{Requeue: true}, nil
+	} else if err != nil {
+		manifest.SetStatus(manifest.GetStatus().WithErr(err))
+		return r.finishReconcile(ctx, manifest, metrics.ManifestBlueGreenUpgrade, manifestStatus, err)
+	}
+
+	bluegreen.Abort(manifest, time.Now())
+	r.recordAudit(ctx, manifest, audit.OperationUpgradeAborted, bluegreen.ErrUpgradeAborted.Error())
+	return r.finishReconcile(ctx, manifest, metrics.ManifestBlueGreenUpgrade, manifestStatus, nil)
+}
+
+func toUnstructured(infos []*resource.Info) ([]*unstructured.Unstructured, error) {
+	objects := make([]*unstructured.Unstructured, 0, len(infos))
+	for _, info := range infos {
+		obj, ok := info.Object.(*unstructured.Unstructured)
+		if !ok {
+			return nil, common.ErrTypeAssert
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
 func (r *Reconciler) cleanupManifest(ctx context.Context, manifest *v1beta2.Manifest, manifestStatus shared.Status,
 	requeueReason metrics.ManifestRequeueReason, originalErr error,
 ) (ctrl.Result, error) {
@@ -245,7 +536,11 @@ func (r *Reconciler) cleanupManifest(ctx context.Context, manifest *v1beta2.Mani
 		finalizerRemoved = finalizer.RemoveRequiredFinalizers(manifest)
 	}
 	if finalizerRemoved {
-		return r.updateManifest(ctx, manifest, requeueReason)
+		result, err := r.updateManifest(ctx, manifest, requeueReason)
+		if err == nil && !manifest.IsUnmanaged() {
+			r.recordAudit(ctx, manifest, audit.OperationRemoved, "resources are removed from the SKR cluster")
+		}
+		return result, err
 	}
 	if manifest.GetStatus().State != shared.StateWarning {
 		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateDeleting).
@@ -264,6 +559,7 @@ func (r *Reconciler) cleanupMetrics(manifest *v1beta2.Manifest) error {
 		return fmt.Errorf("failed to get module name: %w", err)
 	}
 	r.ManifestMetrics.CleanupMetrics(manifest.GetName())
+	r.ManifestMetrics.RemovePhaseMetrics(kymaName, moduleName)
 
 	if manifest.IsMandatoryModule() {
 		r.MandatoryModuleMetrics.CleanupMetrics(kymaName, moduleName)
@@ -291,7 +587,7 @@ func (r *Reconciler) renderResources(ctx context.Context, skrClient Client, mani
 	var target, current ResourceList
 
 	converter := skrresources.NewResourceToInfoConverter(skrresources.ResourceInfoConverter(skrClient),
-		apimetav1.NamespaceDefault)
+		defaultNamespace(manifest))
 
 	if target, err = r.renderTargetResources(ctx, skrClient, converter, manifest, spec); err != nil {
 		manifest.SetStatus(manifestStatus.WithState(shared.StateError).WithErr(err))
@@ -307,8 +603,19 @@ func (r *Reconciler) renderResources(ctx context.Context, skrClient Client, mani
 	return target, current, nil
 }
 
+// defaultNamespace returns the namespace of the rendered resources without a namespace. Namespaced modules of a
+// tenant of a shared SKR cluster are installed into the tenant namespace.
+func defaultNamespace(manifest *v1beta2.Manifest) string {
+	if tenantNamespace := manifest.GetLabels()[shared.TenantNamespaceLabel]; tenantNamespace != "" {
+		return tenantNamespace
+	}
+	return apimetav1.NamespaceDefault
+}
+
+// syncManifestState syncs the module CR and derives the state of the manifest from the state of the manager. The
+// post-upgrade hooks of the spec run once the manager is ready, no hooks run without a spec.
 func (r *Reconciler) syncManifestState(ctx context.Context, skrClient Client, manifest *v1beta2.Manifest,
-	target []*resource.Info,
+	target []*resource.Info, spec *Spec,
 ) error {
 	manifestStatus := manifest.GetStatus()
 
@@ -327,17 +634,44 @@ func (r *Reconciler) syncManifestState(ctx context.Context, skrClient Client, ma
 		return nil
 	}
 
+	syncModuleCRStatus(ctx, skrClient, manifest)
+
 	managerState, err := r.checkManagerState(ctx, skrClient, target)
 	if err != nil {
-		manifest.SetStatus(manifestStatus.WithState(shared.StateError).WithErr(err))
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
 		return err
 	}
+	if managerState == shared.StateReady && spec != nil && spec.HooksPath != "" &&
+		status.RequirePostUpgradeHooks(manifest, spec.OCIRef) {
+		completed, err := r.runUpgradeHooks(ctx, skrClient, manifest, spec, shared.HookPostUpgrade,
+			status.ConditionTypePostUpgradeHooks)
+		if err != nil {
+			manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
+			return err
+		}
+		if !completed {
+			managerState = shared.StateProcessing
+		}
+	}
 	if status.RequireManifestStateUpdateAfterSyncResource(manifest, managerState) {
 		return errStateRequireUpdate
 	}
 	return nil
 }
 
+// syncModuleCRStatus copies the mapped status of the module CR into the manifest status. A module CR status which
+// cannot be read does not fail the reconciliation, the previous copy is kept instead.
+func syncModuleCRStatus(ctx context.Context, skrClient Client, manifest *v1beta2.Manifest) {
+	moduleCRStatus, err := modulecr.NewClient(skrClient).GetCRStatus(ctx, manifest)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to read the status of the module CR")
+		return
+	}
+	manifestStatus := manifest.GetStatus()
+	manifestStatus.ModuleCR = moduleCRStatus
+	manifest.SetStatus(manifestStatus)
+}
+
 func (r *Reconciler) checkManagerState(ctx context.Context, clnt Client, target []*resource.Info) (shared.State,
 	error,
 ) {
@@ -511,6 +845,10 @@ func (r *Reconciler) finishReconcile(ctx context.Context, manifest *v1beta2.Mani
 	if err := r.manifestClient.PatchStatusIfDiffExist(ctx, manifest, previousStatus); err != nil {
 		return ctrl.Result{}, err
 	}
+	if originalErr == nil && previousStatus.State != shared.StateReady &&
+		manifest.GetStatus().State == shared.StateReady {
+		r.recordAudit(ctx, manifest, audit.OperationApplied, "resources are applied to the SKR cluster")
+	}
 	if originalErr != nil {
 		r.ManifestMetrics.RecordRequeueReason(requeueReason, queue.UnexpectedRequeue)
 		return ctrl.Result{}, originalErr
@@ -520,6 +858,24 @@ func (r *Reconciler) finishReconcile(ctx context.Context, manifest *v1beta2.Mani
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
+func (r *Reconciler) recordAudit(ctx context.Context, manifest *v1beta2.Manifest, operation audit.Operation,
+	reason string,
+) {
+	auditRecord := audit.Record{
+		Operation:     operation,
+		KymaNamespace: manifest.GetNamespace(),
+		KymaName:      manifest.GetLabels()[shared.KymaName],
+		Module:        manifest.GetLabels()[shared.ModuleName],
+		ToVersion:     manifest.Spec.Version,
+		Trigger:       fmt.Sprintf("%s %s", shared.ManifestKind, manifest.GetName()),
+		Reason:        reason,
+	}
+	if operation == audit.OperationRemoved {
+		auditRecord.FromVersion, auditRecord.ToVersion = auditRecord.ToVersion, ""
+	}
+	r.AuditRecorder.Record(ctx, auditRecord)
+}
+
 func (r *Reconciler) ssaSpec(ctx context.Context, manifest *v1beta2.Manifest,
 	requeueReason metrics.ManifestRequeueReason,
 ) (ctrl.Result, error) {
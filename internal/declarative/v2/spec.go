@@ -14,4 +14,6 @@ type Spec struct {
 	ManifestName string
 	Path         string
 	OCIRef       string
+	// HooksPath is the path of the hooks layer. It is empty if the module has no hooks.
+	HooksPath string
 }
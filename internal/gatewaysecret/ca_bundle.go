@@ -0,0 +1,46 @@
+package gatewaysecret
+
+import (
+	"bytes"
+	"encoding/pem"
+)
+
+const certificateBlockType = "CERTIFICATE"
+
+// bundleCACertificates returns a PEM bundle with the new CA first, followed by the CA currently leading the
+// given bundle. CAs which were kept from earlier rotations are not carried over.
+func bundleCACertificates(newCA []byte, currentBundle []byte) []byte {
+	currentCA := leadingCACertificate(currentBundle)
+	if currentCA == nil || bytes.Equal(currentCA, leadingCACertificate(newCA)) {
+		return newCA
+	}
+
+	bundle := make([]byte, 0, len(newCA)+len(currentCA)+1)
+	bundle = append(bundle, newCA...)
+	if len(bundle) > 0 && bundle[len(bundle)-1] != '\n' {
+		bundle = append(bundle, '\n')
+	}
+	return append(bundle, currentCA...)
+}
+
+func leadingCACertificate(bundle []byte) []byte {
+	block, _ := pem.Decode(bundle)
+	if block == nil || block.Type != certificateBlockType {
+		return nil
+	}
+	return pem.EncodeToMemory(block)
+}
+
+func countCACertificates(bundle []byte) int {
+	count := 0
+	for {
+		var block *pem.Block
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return count
+		}
+		if block.Type == certificateBlockType {
+			count++
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+const caSecretCertKey = "tls.crt"
+
+var errInvalidCertificate = errors.New("invalid certificate")
+
+// CASecretRotationClient reads the validity of the CA certificate directly from the root CA secret.
+// It is used when the watcher certificates are issued without cert-manager.
+type CASecretRotationClient struct {
+	GatewaySecretRotationClient
+}
+
+func NewCASecretRotationClient(config *rest.Config) *CASecretRotationClient {
+	return &CASecretRotationClient{
+		GatewaySecretRotationClient: GatewaySecretRotationClient{
+			secretInterface: kubernetes.NewForConfigOrDie(config).CoreV1().Secrets(shared.IstioNamespace),
+		},
+	}
+}
+
+func (c *CASecretRotationClient) GetWatcherServingCertNotBefore(ctx context.Context) (*apimetav1.Time, error) {
+	caSecret, err := c.secretInterface.Get(ctx, shared.RootCASecretName, apimetav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CA secret %s: %w", shared.RootCASecretName, err)
+	}
+
+	notBefore, err := certificateNotBefore(caSecret.Data[caSecretCertKey])
+	if err != nil {
+		return nil, fmt.Errorf("invalid CA certificate of secret %s: %w", shared.RootCASecretName, err)
+	}
+	return notBefore, nil
+}
+
+func certificateNotBefore(pemData []byte) (*apimetav1.Time, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found: %w", errInvalidCertificate)
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	return &apimetav1.Time{Time: certificate.NotBefore}, nil
+}
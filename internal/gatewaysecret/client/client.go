@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
-	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	certmanagerclientv1 "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/typed/certmanager/v1"
 	apicorev1 "k8s.io/api/core/v1"
@@ -21,8 +21,12 @@ const (
 	kcpCACertName = "klm-watcher-serving"
 )
 
+// skrCertificateSelector selects the secrets of the client certificates issued for the SKR clusters.
+var skrCertificateSelector = fmt.Sprintf("%s=%s,%s", shared.ManagedBy, shared.OperatorName, shared.PurposeLabel)
+
 var errInvalidGatewaySecret = errors.New("invalid gateway secret")
 
+// GatewaySecretRotationClient reads the validity of the CA certificate from the cert-manager Certificate.
 type GatewaySecretRotationClient struct {
 	certificateInterface certmanagerclientv1.CertificateInterface
 	secretInterface      k8scorev1.SecretInterface
@@ -35,13 +39,40 @@ func NewGatewaySecretRotationClient(config *rest.Config) *GatewaySecretRotationC
 	}
 }
 
-func (c *GatewaySecretRotationClient) GetWatcherServingCert(ctx context.Context) (*certmanagerv1.Certificate, error) {
+func (c *GatewaySecretRotationClient) GetWatcherServingCertNotBefore(ctx context.Context) (*apimetav1.Time, error) {
 	caCert, err := c.certificateInterface.Get(ctx, kcpCACertName, apimetav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get CA certificate %s: %w", kcpCACertName, err)
 	}
 
-	return caCert, nil
+	return caCert.Status.NotBefore, nil
+}
+
+// ListSyncedSKRCertificatesNotBefore returns the notBefore time of the client certificate last synced to each SKR
+// cluster. It is nil for a certificate which was not synced yet.
+func (c *GatewaySecretRotationClient) ListSyncedSKRCertificatesNotBefore(ctx context.Context,
+) ([]*apimetav1.Time, error) {
+	secrets, err := c.secretInterface.List(ctx, apimetav1.ListOptions{LabelSelector: skrCertificateSelector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SKR certificate secrets: %w", err)
+	}
+
+	notBefores := make([]*apimetav1.Time, 0, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		if secret.Name == shared.RootCASecretName || secret.Name == shared.GatewaySecretName {
+			continue
+		}
+		notBefores = append(notBefores, syncedCertificateNotBefore(&secret))
+	}
+	return notBefores, nil
+}
+
+func syncedCertificateNotBefore(secret *apicorev1.Secret) *apimetav1.Time {
+	notBefore, err := time.Parse(time.RFC3339, secret.Annotations[shared.SyncedCertificateNotBeforeAnnotation])
+	if err != nil {
+		return nil
+	}
+	return &apimetav1.Time{Time: notBefore}
 }
 
 func (c *GatewaySecretRotationClient) GetGatewaySecret(ctx context.Context) (*apicorev1.Secret, error) {
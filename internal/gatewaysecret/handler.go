@@ -4,16 +4,20 @@ import (
 	"context"
 	"time"
 
-	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	apicorev1 "k8s.io/api/core/v1"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	"github.com/kyma-project/lifecycle-manager/pkg/util"
 )
 
+// Client abstracts the access to the gateway secret and to the validity of the CA certificate it is synced from,
+// so that the handler does not depend on how the CA certificate is issued.
 type Client interface {
-	GetWatcherServingCert(ctx context.Context) (*certmanagerv1.Certificate, error)
+	GetWatcherServingCertNotBefore(ctx context.Context) (*apimetav1.Time, error)
+	ListSyncedSKRCertificatesNotBefore(ctx context.Context) ([]*apimetav1.Time, error)
 	GetGatewaySecret(ctx context.Context) (*apicorev1.Secret, error)
 	CreateGatewaySecret(ctx context.Context, secret *apicorev1.Secret) error
 	UpdateGatewaySecret(ctx context.Context, secret *apicorev1.Secret) error
@@ -21,15 +25,29 @@ type Client interface {
 
 type TimeParserFunc func(secret *apicorev1.Secret) (time.Time, error)
 
+type RotationMetrics interface {
+	SetCARotationInProgress(inProgress bool)
+}
+
 type Handler struct {
 	client                Client
 	parseLastModifiedTime TimeParserFunc
+	caRotationGracePeriod time.Duration
+	metrics               RotationMetrics
 }
 
-func NewGatewaySecretHandler(client Client, timeParserFunc TimeParserFunc) *Handler {
+// NewGatewaySecretHandler returns a Handler that keeps the gateway secret in sync with the root secret.
+// If caRotationGracePeriod is greater than zero, the gateway secret trusts the previous CA next to the rotated one
+// until the grace period has passed and all SKR clusters use a client certificate issued by the rotated CA, so that
+// the certificates issued by the previous CA stay valid in the meantime.
+func NewGatewaySecretHandler(client Client, timeParserFunc TimeParserFunc, caRotationGracePeriod time.Duration,
+	metrics RotationMetrics,
+) *Handler {
 	return &Handler{
 		client:                client,
 		parseLastModifiedTime: timeParserFunc,
+		caRotationGracePeriod: caRotationGracePeriod,
+		metrics:               metrics,
 	}
 }
 
@@ -48,18 +66,39 @@ func (h *Handler) ManageGatewaySecret(ctx context.Context, rootSecret *apicorev1
 		return err
 	}
 
-	caCert, err := h.client.GetWatcherServingCert(ctx)
+	caCertNotBefore, err := h.client.GetWatcherServingCertNotBefore(ctx)
 	if err != nil {
 		return err
 	}
 
-	if h.requiresUpdate(gwSecret, caCert) {
-		copyDataFromRootSecret(gwSecret, rootSecret)
+	if h.requiresUpdate(gwSecret, caCertNotBefore) {
+		h.rotateDataFromRootSecret(gwSecret, rootSecret)
 		setLastModifiedToNow(gwSecret)
 
-		return h.client.UpdateGatewaySecret(ctx, gwSecret)
+		return h.updateGatewaySecret(ctx, gwSecret)
 	}
 
+	expired, err := h.previousCAExpired(ctx, gwSecret)
+	if err != nil {
+		return err
+	}
+	if expired {
+		// the lastModifiedAt annotation is kept, as it marks the CA rotation the SKR certificates are compared to
+		gwSecret.Data[caCrt] = rootSecret.Data[caCrt]
+
+		return h.updateGatewaySecret(ctx, gwSecret)
+	}
+
+	h.reportCARotation(gwSecret)
+	return nil
+}
+
+func (h *Handler) updateGatewaySecret(ctx context.Context, gwSecret *apicorev1.Secret) error {
+	if err := h.client.UpdateGatewaySecret(ctx, gwSecret); err != nil {
+		return err
+	}
+
+	h.reportCARotation(gwSecret)
 	return nil
 }
 
@@ -81,17 +120,66 @@ func (h *Handler) createGatewaySecretFromRootSecret(ctx context.Context, rootSec
 	return h.client.CreateGatewaySecret(ctx, newSecret)
 }
 
-func (h *Handler) requiresUpdate(gwSecret *apicorev1.Secret, caCert *certmanagerv1.Certificate) bool {
+func (h *Handler) requiresUpdate(gwSecret *apicorev1.Secret, caCertNotBefore *apimetav1.Time) bool {
 	// If the last modified time of the gateway secret is after the notBefore time of the CA certificate,
 	// then we don't need to update the gateway secret
 	if lastModified, err := h.parseLastModifiedTime(gwSecret); err == nil {
-		if caCert.Status.NotBefore != nil && lastModified.After(caCert.Status.NotBefore.Time) {
+		if caCertNotBefore != nil && lastModified.After(caCertNotBefore.Time) {
 			return false
 		}
 	}
 	return true
 }
 
+// rotateDataFromRootSecret copies the root secret data into the gateway secret. During the grace period,
+// the CA which was trusted so far is kept in the CA bundle after the rotated one.
+func (h *Handler) rotateDataFromRootSecret(gwSecret *apicorev1.Secret, rootSecret *apicorev1.Secret) {
+	previousCABundle := gwSecret.Data[caCrt]
+	copyDataFromRootSecret(gwSecret, rootSecret)
+
+	if h.caRotationGracePeriod > 0 {
+		gwSecret.Data[caCrt] = bundleCACertificates(rootSecret.Data[caCrt], previousCABundle)
+	}
+}
+
+// previousCAExpired fails closed: the previous CA is only removed once the grace period since the rotation has
+// passed and the client certificate synced to every SKR cluster has been issued after the rotation.
+func (h *Handler) previousCAExpired(ctx context.Context, gwSecret *apicorev1.Secret) (bool, error) {
+	if countCACertificates(gwSecret.Data[caCrt]) < 2 {
+		return false, nil
+	}
+
+	logger := logf.FromContext(ctx)
+	lastModified, err := h.parseLastModifiedTime(gwSecret)
+	if err != nil {
+		logger.Error(err, "failed to determine the CA rotation time of the gateway secret, keeping the previous CA")
+		return false, nil
+	}
+	if !time.Now().After(lastModified.Add(h.caRotationGracePeriod)) {
+		return false, nil
+	}
+
+	notBefores, err := h.client.ListSyncedSKRCertificatesNotBefore(ctx)
+	if err != nil {
+		return false, err
+	}
+	// the last modified time has a precision of seconds, as has the notBefore time of a certificate
+	for _, notBefore := range notBefores {
+		if notBefore == nil || notBefore.Time.Before(lastModified) {
+			logger.V(log.DebugLevel).Info("keeping the previous CA until all SKR clusters use re-issued certificates")
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (h *Handler) reportCARotation(gwSecret *apicorev1.Secret) {
+	if h.metrics == nil {
+		return
+	}
+	h.metrics.SetCARotationInProgress(countCACertificates(gwSecret.Data[caCrt]) > 1)
+}
+
 func setLastModifiedToNow(secret *apicorev1.Secret) {
 	if secret.Annotations == nil {
 		secret.Annotations = make(map[string]string)
@@ -2,11 +2,11 @@ package gatewaysecret_test
 
 import (
 	"context"
+	"encoding/pem"
 	"errors"
 	"testing"
 	"time"
 
-	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	apicorev1 "k8s.io/api/core/v1"
@@ -23,7 +23,7 @@ func TestManageGatewaySecret_WhenGetGatewaySecretReturnsError_ReturnsError(t *te
 	someError := errors.New("some-error")
 	mockClient.On("GetGatewaySecret", mock.Anything).Return(nil, someError)
 
-	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, nil)
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, nil, 0, nil)
 
 	// ACT
 	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{})
@@ -46,7 +46,7 @@ func TestManageGatewaySecret_WhenGetGatewaySecretReturnsNotFoundError_CreatesGat
 			"ca.crt":  []byte("value3"),
 		},
 	}
-	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, nil)
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, nil, 0, nil)
 
 	// ACT
 	err := handler.ManageGatewaySecret(context.TODO(), rootSecret)
@@ -75,7 +75,7 @@ func TestManageGatewaySecret_WhenGetGatewaySecretReturnsNotFoundErrorAndCreation
 	expectedError := errors.New("some-error")
 	mockClient.On("CreateGatewaySecret", mock.Anything, mock.Anything).Return(expectedError)
 
-	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, nil)
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, nil, 0, nil)
 
 	// ACT
 	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{})
@@ -93,7 +93,7 @@ func TestManageGatewaySecret_WhenGetGatewaySecretReturnsNotFoundError_CreatesGat
 	mockClient.On("GetGatewaySecret", mock.Anything).Return(nil, notFoundError())
 	mockClient.On("CreateGatewaySecret", mock.Anything, mock.Anything).Return(nil)
 
-	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, nil)
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, nil, 0, nil)
 
 	// ACT
 	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{})
@@ -113,9 +113,9 @@ func TestManageGatewaySecret_WhenWatcherServingCertReturnsError_ReturnsError(t *
 	mockClient := &ClientMock{}
 	mockClient.On("GetGatewaySecret", mock.Anything).Return(&apicorev1.Secret{}, nil)
 	expectedError := errors.New("some-error")
-	mockClient.On("GetWatcherServingCert", mock.Anything).Return(nil, expectedError)
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(nil, expectedError)
 
-	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, nil)
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, nil, 0, nil)
 
 	// ACT
 	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{})
@@ -124,26 +124,22 @@ func TestManageGatewaySecret_WhenWatcherServingCertReturnsError_ReturnsError(t *
 	require.Error(t, err)
 	require.ErrorIs(t, err, expectedError)
 	mockClient.AssertNumberOfCalls(t, "GetGatewaySecret", 1)
-	mockClient.AssertNumberOfCalls(t, "GetWatcherServingCert", 1)
+	mockClient.AssertNumberOfCalls(t, "GetWatcherServingCertNotBefore", 1)
 }
 
 func TestManageGatewaySecret_WhenRequiresUpdate_UpdatesGatewaySecretWithRootSecretData(t *testing.T) {
 	// ARRANGE
 	mockClient := &ClientMock{}
 	mockClient.On("GetGatewaySecret", mock.Anything).Return(&apicorev1.Secret{}, nil)
-	cert := &certmanagerv1.Certificate{
-		Status: certmanagerv1.CertificateStatus{
-			NotBefore: &apimetav1.Time{
-				Time: time.Now().Add(time.Hour),
-			},
-		},
+	notBefore := &apimetav1.Time{
+		Time: time.Now().Add(time.Hour),
 	}
-	mockClient.On("GetWatcherServingCert", mock.Anything).Return(cert, nil)
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(notBefore, nil)
 	mockClient.On("UpdateGatewaySecret", mock.Anything, mock.Anything).Return(nil)
 	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
 		return time.Now(), nil
 	}
-	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc)
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, 0, nil)
 	rootSecret := &apicorev1.Secret{
 		Data: map[string][]byte{
 			"tls.crt": []byte("value1"),
@@ -178,19 +174,15 @@ func TestManageGatewaySecret_WhenRequiresUpdate_UpdatesGatewaySecretWithUpdatedM
 		},
 	}
 	mockClient.On("GetGatewaySecret", mock.Anything).Return(gwSecret, nil)
-	cert := &certmanagerv1.Certificate{
-		Status: certmanagerv1.CertificateStatus{
-			NotBefore: &apimetav1.Time{
-				Time: time.Now().Add(time.Hour),
-			},
-		},
+	notBefore := &apimetav1.Time{
+		Time: time.Now().Add(time.Hour),
 	}
-	mockClient.On("GetWatcherServingCert", mock.Anything).Return(cert, nil)
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(notBefore, nil)
 	mockClient.On("UpdateGatewaySecret", mock.Anything, mock.Anything).Return(nil)
 	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
 		return time.Now(), nil
 	}
-	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc)
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, 0, nil)
 
 	// ACT
 	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{})
@@ -214,20 +206,16 @@ func TestManageGatewaySecret_WhenRequiresUpdateAndUpdateFails_ReturnsError(t *te
 	// ARRANGE
 	mockClient := &ClientMock{}
 	mockClient.On("GetGatewaySecret", mock.Anything).Return(&apicorev1.Secret{}, nil)
-	cert := &certmanagerv1.Certificate{
-		Status: certmanagerv1.CertificateStatus{
-			NotBefore: &apimetav1.Time{
-				Time: time.Now().Add(time.Hour),
-			},
-		},
+	notBefore := &apimetav1.Time{
+		Time: time.Now().Add(time.Hour),
 	}
-	mockClient.On("GetWatcherServingCert", mock.Anything).Return(cert, nil)
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(notBefore, nil)
 	expectedError := errors.New("some-error")
 	mockClient.On("UpdateGatewaySecret", mock.Anything, mock.Anything).Return(expectedError)
 	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
 		return time.Now(), nil
 	}
-	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc)
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, 0, nil)
 
 	// ACT
 	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{})
@@ -235,7 +223,7 @@ func TestManageGatewaySecret_WhenRequiresUpdateAndUpdateFails_ReturnsError(t *te
 	// ASSERT
 	require.Error(t, err)
 	require.ErrorIs(t, err, expectedError)
-	mockClient.AssertNumberOfCalls(t, "GetWatcherServingCert", 1)
+	mockClient.AssertNumberOfCalls(t, "GetWatcherServingCertNotBefore", 1)
 	mockClient.AssertNumberOfCalls(t, "UpdateGatewaySecret", 1)
 }
 
@@ -243,19 +231,15 @@ func TestManageGatewaySecret_WhenRequiresUpdateIsFalse_DoesNotUpdateGatewaySecre
 	// ARRANGE
 	mockClient := &ClientMock{}
 	mockClient.On("GetGatewaySecret", mock.Anything).Return(&apicorev1.Secret{}, nil)
-	cert := &certmanagerv1.Certificate{
-		Status: certmanagerv1.CertificateStatus{
-			NotBefore: &apimetav1.Time{
-				Time: time.Now().Add(-time.Hour),
-			},
-		},
+	notBefore := &apimetav1.Time{
+		Time: time.Now().Add(-time.Hour),
 	}
-	mockClient.On("GetWatcherServingCert", mock.Anything).Return(cert, nil)
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(notBefore, nil)
 	mockClient.On("UpdateGatewaySecret", mock.Anything, mock.Anything).Return(nil)
 	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
 		return time.Now(), nil
 	}
-	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc)
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, 0, nil)
 
 	// ACT
 	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{})
@@ -269,19 +253,15 @@ func TestManageGatewaySecret_WhenTimeParserFuncReturnsError_UpdatesGatewaySecret
 	// ARRANGE
 	mockClient := &ClientMock{}
 	mockClient.On("GetGatewaySecret", mock.Anything).Return(&apicorev1.Secret{}, nil)
-	cert := &certmanagerv1.Certificate{
-		Status: certmanagerv1.CertificateStatus{
-			NotBefore: &apimetav1.Time{
-				Time: time.Now().Add(time.Hour),
-			},
-		},
+	notBefore := &apimetav1.Time{
+		Time: time.Now().Add(time.Hour),
 	}
-	mockClient.On("GetWatcherServingCert", mock.Anything).Return(cert, nil)
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(notBefore, nil)
 	mockClient.On("UpdateGatewaySecret", mock.Anything, mock.Anything).Return(nil)
 	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
 		return time.Time{}, errors.New("some-error")
 	}
-	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc)
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, 0, nil)
 
 	// ACT
 	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{})
@@ -291,6 +271,226 @@ func TestManageGatewaySecret_WhenTimeParserFuncReturnsError_UpdatesGatewaySecret
 	mockClient.AssertNumberOfCalls(t, "UpdateGatewaySecret", 1)
 }
 
+func TestManageGatewaySecret_WhenRequiresUpdateWithGracePeriod_KeepsPreviousCAInBundle(t *testing.T) {
+	// ARRANGE
+	mockClient := &ClientMock{}
+	previousCA := pemCertificate("previous-ca")
+	gwSecret := &apicorev1.Secret{
+		Data: map[string][]byte{
+			"ca.crt": previousCA,
+		},
+	}
+	mockClient.On("GetGatewaySecret", mock.Anything).Return(gwSecret, nil)
+	notBefore := &apimetav1.Time{
+		Time: time.Now().Add(time.Hour),
+	}
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(notBefore, nil)
+	mockClient.On("UpdateGatewaySecret", mock.Anything, mock.Anything).Return(nil)
+	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
+		return time.Now(), nil
+	}
+	metrics := &rotationMetricsStub{}
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, time.Hour, metrics)
+	newCA := pemCertificate("new-ca")
+	rootSecret := &apicorev1.Secret{
+		Data: map[string][]byte{
+			"tls.crt": []byte("value1"),
+			"tls.key": []byte("value2"),
+			"ca.crt":  newCA,
+		},
+	}
+
+	// ACT
+	err := handler.ManageGatewaySecret(context.TODO(), rootSecret)
+
+	// ASSERT
+	require.NoError(t, err)
+	mockClient.AssertNumberOfCalls(t, "UpdateGatewaySecret", 1)
+	mockClient.AssertCalled(t, "UpdateGatewaySecret", mock.Anything, mock.MatchedBy(
+		func(secret *apicorev1.Secret) bool {
+			return string(secret.Data["tls.crt"]) == string(rootSecret.Data["tls.crt"]) &&
+				string(secret.Data["ca.crt"]) == string(newCA)+string(previousCA)
+		}))
+	require.True(t, metrics.inProgress)
+}
+
+func TestManageGatewaySecret_WhenGracePeriodIsNotOver_KeepsPreviousCA(t *testing.T) {
+	// ARRANGE
+	mockClient := &ClientMock{}
+	newCA := pemCertificate("new-ca")
+	gwSecret := &apicorev1.Secret{
+		Data: map[string][]byte{
+			"ca.crt": append(append([]byte{}, newCA...), pemCertificate("previous-ca")...),
+		},
+	}
+	mockClient.On("GetGatewaySecret", mock.Anything).Return(gwSecret, nil)
+	notBefore := &apimetav1.Time{
+		Time: time.Now().Add(-2 * time.Minute),
+	}
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(notBefore, nil)
+	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
+		return time.Now().Add(-time.Minute), nil
+	}
+	metrics := &rotationMetricsStub{}
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, time.Hour, metrics)
+
+	// ACT
+	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{Data: map[string][]byte{"ca.crt": newCA}})
+
+	// ASSERT
+	require.NoError(t, err)
+	mockClient.AssertNumberOfCalls(t, "UpdateGatewaySecret", 0)
+	require.True(t, metrics.inProgress)
+}
+
+func TestManageGatewaySecret_WhenGracePeriodIsOver_DropsPreviousCA(t *testing.T) {
+	// ARRANGE
+	mockClient := &ClientMock{}
+	newCA := pemCertificate("new-ca")
+	lastModifiedAt := time.Now().Add(-2 * time.Hour).Format(time.RFC3339)
+	gwSecret := &apicorev1.Secret{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Annotations: map[string]string{
+				shared.LastModifiedAtAnnotation: lastModifiedAt,
+			},
+		},
+		Data: map[string][]byte{
+			"ca.crt": append(append([]byte{}, newCA...), pemCertificate("previous-ca")...),
+		},
+	}
+	mockClient.On("GetGatewaySecret", mock.Anything).Return(gwSecret, nil)
+	notBefore := &apimetav1.Time{
+		Time: time.Now().Add(-3 * time.Hour),
+	}
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(notBefore, nil)
+	mockClient.On("ListSyncedSKRCertificatesNotBefore", mock.Anything).Return([]*apimetav1.Time{
+		{Time: time.Now().Add(-time.Hour)},
+	}, nil)
+	mockClient.On("UpdateGatewaySecret", mock.Anything, mock.Anything).Return(nil)
+	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
+		return time.Now().Add(-2 * time.Hour), nil
+	}
+	metrics := &rotationMetricsStub{inProgress: true}
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, time.Hour, metrics)
+
+	// ACT
+	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{Data: map[string][]byte{"ca.crt": newCA}})
+
+	// ASSERT
+	require.NoError(t, err)
+	mockClient.AssertNumberOfCalls(t, "UpdateGatewaySecret", 1)
+	mockClient.AssertCalled(t, "UpdateGatewaySecret", mock.Anything, mock.MatchedBy(
+		func(secret *apicorev1.Secret) bool {
+			return string(secret.Data["ca.crt"]) == string(newCA) &&
+				secret.Annotations[shared.LastModifiedAtAnnotation] == lastModifiedAt
+		}))
+	require.False(t, metrics.inProgress)
+}
+
+func TestManageGatewaySecret_WhenReissuedSKRCertificateIsNotSynced_KeepsPreviousCA(t *testing.T) {
+	// ARRANGE
+	mockClient := &ClientMock{}
+	newCA := pemCertificate("new-ca")
+	gwSecret := &apicorev1.Secret{
+		Data: map[string][]byte{
+			"ca.crt": append(append([]byte{}, newCA...), pemCertificate("previous-ca")...),
+		},
+	}
+	mockClient.On("GetGatewaySecret", mock.Anything).Return(gwSecret, nil)
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(
+		&apimetav1.Time{Time: time.Now().Add(-3 * time.Hour)}, nil)
+	mockClient.On("ListSyncedSKRCertificatesNotBefore", mock.Anything).Return([]*apimetav1.Time{
+		{Time: time.Now().Add(-time.Hour)},
+		{Time: time.Now().Add(-4 * time.Hour)},
+	}, nil)
+	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
+		return time.Now().Add(-2 * time.Hour), nil
+	}
+	metrics := &rotationMetricsStub{}
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, time.Hour, metrics)
+
+	// ACT
+	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{Data: map[string][]byte{"ca.crt": newCA}})
+
+	// ASSERT
+	require.NoError(t, err)
+	mockClient.AssertNumberOfCalls(t, "UpdateGatewaySecret", 0)
+	require.True(t, metrics.inProgress)
+}
+
+func TestManageGatewaySecret_WhenRotationTimeIsInvalid_KeepsPreviousCA(t *testing.T) {
+	// ARRANGE
+	mockClient := &ClientMock{}
+	newCA := pemCertificate("new-ca")
+	gwSecret := &apicorev1.Secret{
+		Data: map[string][]byte{
+			"ca.crt": append(append([]byte{}, newCA...), pemCertificate("previous-ca")...),
+		},
+	}
+	mockClient.On("GetGatewaySecret", mock.Anything).Return(gwSecret, nil)
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(
+		&apimetav1.Time{Time: time.Now().Add(-3 * time.Hour)}, nil)
+	var parseCalls int
+	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
+		parseCalls++
+		// the first call determines whether the gateway secret requires an update
+		if parseCalls == 1 {
+			return time.Now().Add(-2 * time.Hour), nil
+		}
+		return time.Time{}, errors.New("invalid lastModifiedAt annotation")
+	}
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, time.Hour, &rotationMetricsStub{})
+
+	// ACT
+	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{Data: map[string][]byte{"ca.crt": newCA}})
+
+	// ASSERT
+	require.NoError(t, err)
+	mockClient.AssertNumberOfCalls(t, "UpdateGatewaySecret", 0)
+	mockClient.AssertNotCalled(t, "ListSyncedSKRCertificatesNotBefore", mock.Anything)
+}
+
+type rotationMetricsStub struct {
+	inProgress bool
+}
+
+func (m *rotationMetricsStub) SetCARotationInProgress(inProgress bool) {
+	m.inProgress = inProgress
+}
+
+func pemCertificate(content string) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte(content)})
+}
+
 func notFoundError() error {
 	return apierrors.NewNotFound(apicorev1.Resource("secrets"), "not-found")
 }
+
+func TestManageGatewaySecret_WhenSKRCertificateWasNeverSynced_KeepsPreviousCA(t *testing.T) {
+	// ARRANGE
+	mockClient := &ClientMock{}
+	newCA := pemCertificate("new-ca")
+	gwSecret := &apicorev1.Secret{
+		Data: map[string][]byte{
+			"ca.crt": append(append([]byte{}, newCA...), pemCertificate("previous-ca")...),
+		},
+	}
+	mockClient.On("GetGatewaySecret", mock.Anything).Return(gwSecret, nil)
+	mockClient.On("GetWatcherServingCertNotBefore", mock.Anything).Return(
+		&apimetav1.Time{Time: time.Now().Add(-3 * time.Hour)}, nil)
+	mockClient.On("ListSyncedSKRCertificatesNotBefore", mock.Anything).Return([]*apimetav1.Time{
+		{Time: time.Now().Add(-time.Hour)},
+		nil,
+	}, nil)
+	var mockFunc gatewaysecret.TimeParserFunc = func(secret *apicorev1.Secret) (time.Time, error) {
+		return time.Now().Add(-2 * time.Hour), nil
+	}
+	handler := gatewaysecret.NewGatewaySecretHandler(mockClient, mockFunc, time.Hour, &rotationMetricsStub{})
+
+	// ACT
+	err := handler.ManageGatewaySecret(context.TODO(), &apicorev1.Secret{Data: map[string][]byte{"ca.crt": newCA}})
+
+	// ASSERT
+	require.NoError(t, err)
+	mockClient.AssertNumberOfCalls(t, "UpdateGatewaySecret", 0)
+}
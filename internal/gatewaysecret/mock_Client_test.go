@@ -5,11 +5,11 @@ package gatewaysecret_test
 import (
 	context "context"
 
-	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
-
 	mock "github.com/stretchr/testify/mock"
 
 	v1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ClientMock is an autogenerated mock type for the Client type
@@ -130,24 +130,82 @@ func (_c *ClientMock_GetGatewaySecret_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// GetWatcherServingCert provides a mock function with given fields: ctx
-func (_m *ClientMock) GetWatcherServingCert(ctx context.Context) (*certmanagerv1.Certificate, error) {
+// GetWatcherServingCertNotBefore provides a mock function with given fields: ctx
+func (_m *ClientMock) GetWatcherServingCertNotBefore(ctx context.Context) (*metav1.Time, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWatcherServingCertNotBefore")
+	}
+
+	var r0 *metav1.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*metav1.Time, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *metav1.Time); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*metav1.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientMock_GetWatcherServingCertNotBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWatcherServingCertNotBefore'
+type ClientMock_GetWatcherServingCertNotBefore_Call struct {
+	*mock.Call
+}
+
+// GetWatcherServingCertNotBefore is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ClientMock_Expecter) GetWatcherServingCertNotBefore(ctx interface{}) *ClientMock_GetWatcherServingCertNotBefore_Call {
+	return &ClientMock_GetWatcherServingCertNotBefore_Call{Call: _e.mock.On("GetWatcherServingCertNotBefore", ctx)}
+}
+
+func (_c *ClientMock_GetWatcherServingCertNotBefore_Call) Run(run func(ctx context.Context)) *ClientMock_GetWatcherServingCertNotBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ClientMock_GetWatcherServingCertNotBefore_Call) Return(_a0 *metav1.Time, _a1 error) *ClientMock_GetWatcherServingCertNotBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientMock_GetWatcherServingCertNotBefore_Call) RunAndReturn(run func(context.Context) (*metav1.Time, error)) *ClientMock_GetWatcherServingCertNotBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSyncedSKRCertificatesNotBefore provides a mock function with given fields: ctx
+func (_m *ClientMock) ListSyncedSKRCertificatesNotBefore(ctx context.Context) ([]*metav1.Time, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWatcherServingCert")
+		panic("no return value specified for ListSyncedSKRCertificatesNotBefore")
 	}
 
-	var r0 *certmanagerv1.Certificate
+	var r0 []*metav1.Time
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*certmanagerv1.Certificate, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*metav1.Time, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *certmanagerv1.Certificate); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) []*metav1.Time); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*certmanagerv1.Certificate)
+			r0 = ret.Get(0).([]*metav1.Time)
 		}
 	}
 
@@ -160,30 +218,30 @@ func (_m *ClientMock) GetWatcherServingCert(ctx context.Context) (*certmanagerv1
 	return r0, r1
 }
 
-// ClientMock_GetWatcherServingCert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWatcherServingCert'
-type ClientMock_GetWatcherServingCert_Call struct {
+// ClientMock_ListSyncedSKRCertificatesNotBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSyncedSKRCertificatesNotBefore'
+type ClientMock_ListSyncedSKRCertificatesNotBefore_Call struct {
 	*mock.Call
 }
 
-// GetWatcherServingCert is a helper method to define mock.On call
+// ListSyncedSKRCertificatesNotBefore is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *ClientMock_Expecter) GetWatcherServingCert(ctx interface{}) *ClientMock_GetWatcherServingCert_Call {
-	return &ClientMock_GetWatcherServingCert_Call{Call: _e.mock.On("GetWatcherServingCert", ctx)}
+func (_e *ClientMock_Expecter) ListSyncedSKRCertificatesNotBefore(ctx interface{}) *ClientMock_ListSyncedSKRCertificatesNotBefore_Call {
+	return &ClientMock_ListSyncedSKRCertificatesNotBefore_Call{Call: _e.mock.On("ListSyncedSKRCertificatesNotBefore", ctx)}
 }
 
-func (_c *ClientMock_GetWatcherServingCert_Call) Run(run func(ctx context.Context)) *ClientMock_GetWatcherServingCert_Call {
+func (_c *ClientMock_ListSyncedSKRCertificatesNotBefore_Call) Run(run func(ctx context.Context)) *ClientMock_ListSyncedSKRCertificatesNotBefore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *ClientMock_GetWatcherServingCert_Call) Return(_a0 *certmanagerv1.Certificate, _a1 error) *ClientMock_GetWatcherServingCert_Call {
+func (_c *ClientMock_ListSyncedSKRCertificatesNotBefore_Call) Return(_a0 []*metav1.Time, _a1 error) *ClientMock_ListSyncedSKRCertificatesNotBefore_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ClientMock_GetWatcherServingCert_Call) RunAndReturn(run func(context.Context) (*certmanagerv1.Certificate, error)) *ClientMock_GetWatcherServingCert_Call {
+func (_c *ClientMock_ListSyncedSKRCertificatesNotBefore_Call) RunAndReturn(run func(context.Context) ([]*metav1.Time, error)) *ClientMock_ListSyncedSKRCertificatesNotBefore_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,223 @@
+package bluegreen
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/resource"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+)
+
+// DefaultReadyTimeout is the time the new version has to become Ready if the upgrade strategy sets no ReadyTimeout.
+const DefaultReadyTimeout = 10 * time.Minute
+
+var ErrUpgradeAborted = errors.New("BlueGreen upgrade aborted as the new version did not become Ready in time")
+
+var invalidRevisionCharacters = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// IsEnabled returns true if the module of the manifest is upgraded side by side.
+func IsEnabled(manifest *v1beta2.Manifest) bool {
+	return manifest.Spec.UpgradeStrategy.IsBlueGreen()
+}
+
+// Revision returns the revision of the workloads of the manifest, which is derived from its version so that it is a
+// valid label value.
+func Revision(manifest *v1beta2.Manifest) string {
+	revision := invalidRevisionCharacters.ReplaceAllString(strings.ToLower(manifest.Spec.Version), "-")
+	return strings.Trim(revision, ".-")
+}
+
+// PrepareSideBySide prepares the rendered resources of the manifest to run side by side with the resources of other
+// versions of the module: Deployments and StatefulSets are named after the revision and select only the pods of the
+// revision, as do the Services listed as switch resources. All other resources are shared by the versions.
+func PrepareSideBySide(manifest *v1beta2.Manifest, objs []*unstructured.Unstructured) error {
+	revision := Revision(manifest)
+	if revision == "" {
+		return nil
+	}
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		switch {
+		case gvk.Group == "apps" && (gvk.Kind == "Deployment" || gvk.Kind == "StatefulSet"):
+			if err := prepareWorkload(obj, revision); err != nil {
+				return err
+			}
+		case gvk.Group == "" && gvk.Kind == "Service" && isSwitch(manifest, toResource(obj)):
+			if err := prepareService(obj, revision); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func prepareWorkload(obj *unstructured.Unstructured, revision string) error {
+	obj.SetName(obj.GetName() + "-" + strings.ReplaceAll(revision, ".", "-"))
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[shared.RevisionLabel] = revision
+	obj.SetLabels(labels)
+	for _, path := range [][]string{
+		{"spec", "selector", "matchLabels", shared.RevisionLabel},
+		{"spec", "template", "metadata", "labels", shared.RevisionLabel},
+	} {
+		if err := unstructured.SetNestedField(obj.Object, revision, path...); err != nil {
+			return fmt.Errorf("failed setting revision of %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func prepareService(obj *unstructured.Unstructured, revision string) error {
+	if _, found, _ := unstructured.NestedMap(obj.Object, "spec", "selector"); !found {
+		return nil
+	}
+	if err := unstructured.SetNestedField(obj.Object, revision, "spec", "selector", shared.RevisionLabel); err != nil {
+		return fmt.Errorf("failed setting revision of Service %s: %w", obj.GetName(), err)
+	}
+	return nil
+}
+
+// AddedResources returns the target resources which are applied while the new version starts, which are the
+// resources added by the upgrade. All resources which exist in the previous version, such as the switch resources,
+// CRDs and RBAC resources, are held back, so that they keep the state of the previous version until the new version
+// is Ready, and an aborted upgrade leaves them untouched.
+func AddedResources(manifest *v1beta2.Manifest, target []*resource.Info) []*resource.Info {
+	upgrade := manifest.GetStatus().Upgrade
+	if upgrade == nil {
+		return nil
+	}
+	added := resourceIDs(upgrade.Added)
+	targetResources := skrresources.NewInfoToResourceConverter().InfosToResources(target)
+	sideBySide := make([]*resource.Info, 0, len(upgrade.Added))
+	for index, info := range target {
+		if added[targetResources[index].ID()] {
+			sideBySide = append(sideBySide, info)
+		}
+	}
+	return sideBySide
+}
+
+// Start records the upgrade to the version of the manifest with the given ref in the status, unless it is recorded
+// already. The resources of the target which are not in the current resources are recorded as added.
+func Start(manifest *v1beta2.Manifest, ref string, current, target []*resource.Info, now time.Time) {
+	status := manifest.GetStatus()
+	if status.Upgrade != nil && status.Upgrade.Ref == ref {
+		return
+	}
+	converter := skrresources.NewInfoToResourceConverter()
+	existing := resourceIDs(converter.InfosToResources(current))
+	var added []shared.Resource
+	for _, res := range converter.InfosToResources(target) {
+		if !existing[res.ID()] {
+			added = append(added, res)
+		}
+	}
+	status.Upgrade = &shared.UpgradeStatus{
+		Version:   manifest.Spec.Version,
+		Ref:       ref,
+		Phase:     shared.UpgradePhaseProgressing,
+		Added:     added,
+		StartedAt: apimetav1.NewTime(now),
+	}
+	manifest.SetStatus(status)
+}
+
+// IsAborted returns true if the upgrade to the version with the given ref was aborted.
+func IsAborted(manifest *v1beta2.Manifest, ref string) bool {
+	upgrade := manifest.GetStatus().Upgrade
+	return upgrade != nil && upgrade.Ref == ref && upgrade.Phase == shared.UpgradePhaseAborted
+}
+
+// IsTimedOut returns true if the new version did not become Ready within the ReadyTimeout of the upgrade strategy.
+func IsTimedOut(manifest *v1beta2.Manifest, now time.Time) bool {
+	upgrade := manifest.GetStatus().Upgrade
+	if upgrade == nil {
+		return false
+	}
+	timeout := DefaultReadyTimeout
+	if strategy := manifest.Spec.UpgradeStrategy; strategy != nil && strategy.ReadyTimeout != nil {
+		timeout = strategy.ReadyTimeout.Duration
+	}
+	return now.After(upgrade.StartedAt.Add(timeout))
+}
+
+// Complete records the completion of the upgrade, after which the target resources are the only synced resources.
+func Complete(manifest *v1beta2.Manifest, target []*resource.Info, now time.Time) {
+	status := manifest.GetStatus()
+	status.Synced = skrresources.NewInfoToResourceConverter().InfosToResources(target)
+	manifest.SetStatus(status)
+	finish(manifest, shared.UpgradePhaseCompleted, now)
+}
+
+// Abort records the abortion of the upgrade, after which the added resources are no longer synced.
+func Abort(manifest *v1beta2.Manifest, now time.Time) {
+	status := manifest.GetStatus()
+	if status.Upgrade == nil {
+		return
+	}
+	added := resourceIDs(status.Upgrade.Added)
+	synced := make([]shared.Resource, 0, len(status.Synced))
+	for _, res := range status.Synced {
+		if !added[res.ID()] {
+			synced = append(synced, res)
+		}
+	}
+	status.Synced = synced
+	manifest.SetStatus(status.WithState(shared.StateError).WithErr(ErrUpgradeAborted))
+	finish(manifest, shared.UpgradePhaseAborted, now)
+}
+
+// finish sets the phase of the upgrade on a copy of the upgrade status, as the previous status shares the pointer and
+// is compared with the new status to detect changes.
+func finish(manifest *v1beta2.Manifest, phase shared.UpgradePhase, now time.Time) {
+	status := manifest.GetStatus()
+	if status.Upgrade == nil {
+		return
+	}
+	upgrade := status.Upgrade.DeepCopy()
+	upgrade.Phase = phase
+	finishedAt := apimetav1.NewTime(now)
+	upgrade.FinishedAt = &finishedAt
+	status.Upgrade = upgrade
+	manifest.SetStatus(status)
+}
+
+func isSwitch(manifest *v1beta2.Manifest, res shared.Resource) bool {
+	if manifest.Spec.UpgradeStrategy == nil {
+		return false
+	}
+	for _, switchResource := range manifest.Spec.UpgradeStrategy.Switch {
+		if switchResource.Group == res.Group && switchResource.Kind == res.Kind && switchResource.Name == res.Name &&
+			(switchResource.Namespace == "" || switchResource.Namespace == res.Namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+func toResource(obj *unstructured.Unstructured) shared.Resource {
+	return shared.Resource{
+		Name:             obj.GetName(),
+		Namespace:        obj.GetNamespace(),
+		GroupVersionKind: apimetav1.GroupVersionKind(obj.GroupVersionKind()),
+	}
+}
+
+func resourceIDs(resources []shared.Resource) map[string]bool {
+	ids := make(map[string]bool, len(resources))
+	for _, res := range resources {
+		ids[res.ID()] = true
+	}
+	return ids
+}
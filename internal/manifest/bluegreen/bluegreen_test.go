@@ -0,0 +1,212 @@
+package bluegreen_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/bluegreen"
+)
+
+func TestRevision(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{version: "1.2.3", want: "1.2.3"},
+		{version: "v1.2.3-RC.1", want: "v1.2.3-rc.1"},
+		{version: "1.2.3+build_7", want: "1.2.3-build-7"},
+		{version: "", want: ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.version, func(t *testing.T) {
+			manifest := newManifest(testCase.version)
+			assert.Equal(t, testCase.want, bluegreen.Revision(manifest))
+		})
+	}
+}
+
+func TestPrepareSideBySide(t *testing.T) {
+	manifest := newManifest("1.2.3")
+	deployment := newObject("apps", "Deployment", "manager")
+	service := newObject("", "Service", "manager-service")
+	require.NoError(t, unstructured.SetNestedField(service.Object, "manager", "spec", "selector", "app"))
+	otherService := newObject("", "Service", "metrics")
+	require.NoError(t, unstructured.SetNestedField(otherService.Object, "manager", "spec", "selector", "app"))
+	configMap := newObject("", "ConfigMap", "config")
+
+	require.NoError(t, bluegreen.PrepareSideBySide(manifest,
+		[]*unstructured.Unstructured{deployment, service, otherService, configMap}))
+
+	assert.Equal(t, "manager-1-2-3", deployment.GetName())
+	assert.Equal(t, "1.2.3", deployment.GetLabels()[shared.RevisionLabel])
+	selector, _, _ := unstructured.NestedString(deployment.Object,
+		"spec", "selector", "matchLabels", shared.RevisionLabel)
+	assert.Equal(t, "1.2.3", selector)
+	podLabel, _, _ := unstructured.NestedString(deployment.Object,
+		"spec", "template", "metadata", "labels", shared.RevisionLabel)
+	assert.Equal(t, "1.2.3", podLabel)
+	serviceSelector, _, _ := unstructured.NestedStringMap(service.Object, "spec", "selector")
+	assert.Equal(t, map[string]string{"app": "manager", shared.RevisionLabel: "1.2.3"}, serviceSelector)
+	otherSelector, _, _ := unstructured.NestedStringMap(otherService.Object, "spec", "selector")
+	assert.Equal(t, map[string]string{"app": "manager"}, otherSelector)
+	assert.Equal(t, "config", configMap.GetName())
+	assert.Empty(t, configMap.GetLabels())
+}
+
+func TestAddedResources(t *testing.T) {
+	manifest := newManifest("1.2.3")
+	service := newInfo("", "Service", "manager-service")
+	configMap := newInfo("", "ConfigMap", "config")
+	newDeployment := newInfo("apps", "Deployment", "manager-1-2-3")
+	current := []*resource.Info{newInfo("apps", "Deployment", "manager-1-2-2"), service, configMap}
+	target := []*resource.Info{newDeployment, service, configMap}
+
+	assert.Empty(t, bluegreen.AddedResources(manifest, target))
+
+	bluegreen.Start(manifest, "sha256:new", current, target, time.Now())
+	manifest.Status.Synced = append(manifest.Status.Synced, resourceOf(newDeployment))
+
+	assert.Equal(t, []*resource.Info{newDeployment}, bluegreen.AddedResources(manifest, target))
+}
+
+func TestAbort_KeepsChangedConfigMapOfPreviousVersion(t *testing.T) {
+	ctx := context.Background()
+	manifest := newManifest("1.2.3")
+	previousConfig := newObject("", "ConfigMap", "config")
+	require.NoError(t, unstructured.SetNestedField(previousConfig.Object, "previous", "data", "mode"))
+	skrClient := fake.NewClientBuilder().WithObjects(previousConfig.DeepCopy()).Build()
+	changedConfig := newObject("", "ConfigMap", "config")
+	require.NoError(t, unstructured.SetNestedField(changedConfig.Object, "changed", "data", "mode"))
+	newDeployment := newInfo("apps", "Deployment", "manager-1-2-3")
+	current := []*resource.Info{newInfo("apps", "Deployment", "manager-1-2-2"), newInfo("", "ConfigMap", "config")}
+	target := []*resource.Info{newDeployment, {Name: "config", Namespace: "kyma-system", Object: changedConfig}}
+	manifest.Status.Synced = []shared.Resource{resourceOf(current[0]), resourceOf(current[1])}
+
+	bluegreen.Start(manifest, "sha256:new", current, target, time.Now())
+	for _, info := range bluegreen.AddedResources(manifest, target) {
+		obj, _ := info.Object.(*unstructured.Unstructured)
+		require.NoError(t, skrClient.Create(ctx, obj.DeepCopy()))
+	}
+	bluegreen.Abort(manifest, time.Now())
+
+	config := newObject("", "ConfigMap", "config")
+	require.NoError(t, skrClient.Get(ctx, client.ObjectKeyFromObject(config), config))
+	mode, _, _ := unstructured.NestedString(config.Object, "data", "mode")
+	assert.Equal(t, "previous", mode)
+	assert.Equal(t, []shared.Resource{resourceOf(current[0]), resourceOf(current[1])}, manifest.Status.Synced)
+	assert.Equal(t, []shared.Resource{resourceOf(newDeployment)}, manifest.Status.Upgrade.Added)
+}
+
+func TestStart_RecordsAddedResourcesOnce(t *testing.T) {
+	manifest := newManifest("1.2.3")
+	startedAt := time.Now()
+	current := []*resource.Info{newInfo("apps", "Deployment", "manager-1-2-2"), newInfo("", "ConfigMap", "config")}
+	target := []*resource.Info{newInfo("apps", "Deployment", "manager-1-2-3"), newInfo("", "ConfigMap", "config")}
+
+	bluegreen.Start(manifest, "sha256:new", current, target, startedAt)
+	bluegreen.Start(manifest, "sha256:new", target, target, startedAt.Add(time.Minute))
+
+	upgrade := manifest.Status.Upgrade
+	require.NotNil(t, upgrade)
+	assert.Equal(t, "1.2.3", upgrade.Version)
+	assert.Equal(t, shared.UpgradePhaseProgressing, upgrade.Phase)
+	assert.True(t, startedAt.Equal(upgrade.StartedAt.Time))
+	require.Len(t, upgrade.Added, 1)
+	assert.Equal(t, "manager-1-2-3", upgrade.Added[0].Name)
+}
+
+func TestIsTimedOut(t *testing.T) {
+	manifest := newManifest("1.2.3")
+	startedAt := time.Now()
+	bluegreen.Start(manifest, "sha256:new", nil, nil, startedAt)
+
+	assert.False(t, bluegreen.IsTimedOut(manifest, startedAt.Add(bluegreen.DefaultReadyTimeout)))
+	assert.True(t, bluegreen.IsTimedOut(manifest, startedAt.Add(bluegreen.DefaultReadyTimeout+time.Second)))
+
+	manifest.Spec.UpgradeStrategy.ReadyTimeout = &apimetav1.Duration{Duration: time.Minute}
+	assert.True(t, bluegreen.IsTimedOut(manifest, startedAt.Add(2*time.Minute)))
+}
+
+func TestAbort_DropsAddedResourcesFromSynced(t *testing.T) {
+	manifest := newManifest("1.2.3")
+	current := []*resource.Info{newInfo("apps", "Deployment", "manager-1-2-2")}
+	target := []*resource.Info{newInfo("apps", "Deployment", "manager-1-2-3")}
+	bluegreen.Start(manifest, "sha256:new", current, target, time.Now())
+	manifest.Status.Synced = []shared.Resource{resourceOf(current[0]), resourceOf(target[0])}
+	previousUpgrade := manifest.Status.Upgrade
+
+	bluegreen.Abort(manifest, time.Now())
+
+	assert.Equal(t, []shared.Resource{resourceOf(current[0])}, manifest.Status.Synced)
+	assert.Equal(t, shared.StateError, manifest.Status.State)
+	assert.Equal(t, shared.UpgradePhaseAborted, manifest.Status.Upgrade.Phase)
+	assert.NotNil(t, manifest.Status.Upgrade.FinishedAt)
+	assert.Equal(t, shared.UpgradePhaseProgressing, previousUpgrade.Phase)
+	assert.True(t, bluegreen.IsAborted(manifest, "sha256:new"))
+	assert.False(t, bluegreen.IsAborted(manifest, "sha256:newer"))
+}
+
+func TestComplete_SyncsOnlyTarget(t *testing.T) {
+	manifest := newManifest("1.2.3")
+	target := []*resource.Info{newInfo("apps", "Deployment", "manager-1-2-3")}
+	bluegreen.Start(manifest, "sha256:new", nil, target, time.Now())
+	manifest.Status.Synced = []shared.Resource{
+		resourceOf(newInfo("apps", "Deployment", "manager-1-2-2")), resourceOf(target[0]),
+	}
+
+	bluegreen.Complete(manifest, target, time.Now())
+
+	assert.Equal(t, []shared.Resource{resourceOf(target[0])}, manifest.Status.Synced)
+	assert.Equal(t, shared.UpgradePhaseCompleted, manifest.Status.Upgrade.Phase)
+}
+
+func newManifest(version string) *v1beta2.Manifest {
+	return &v1beta2.Manifest{
+		Spec: v1beta2.ManifestSpec{
+			Version: version,
+			UpgradeStrategy: &v1beta2.UpgradeStrategy{
+				Type: v1beta2.UpgradeStrategyBlueGreen,
+				Switch: []v1beta2.SwitchResource{{
+					GroupVersionKind: apimetav1.GroupVersionKind{Version: "v1", Kind: "Service"},
+					Name:             "manager-service",
+				}},
+			},
+		},
+	}
+}
+
+func newObject(group, kind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	apiVersion := "v1"
+	if group != "" {
+		apiVersion = group + "/v1"
+	}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace("kyma-system")
+	return obj
+}
+
+func newInfo(group, kind, name string) *resource.Info {
+	return &resource.Info{Name: name, Namespace: "kyma-system", Object: newObject(group, kind, name)}
+}
+
+func resourceOf(info *resource.Info) shared.Resource {
+	return shared.Resource{
+		Name:             info.Name,
+		Namespace:        info.Namespace,
+		GroupVersionKind: apimetav1.GroupVersionKind(info.Object.GetObjectKind().GroupVersionKind()),
+	}
+}
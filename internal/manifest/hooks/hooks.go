@@ -0,0 +1,168 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+const (
+	// DefaultTimeout is the time a hook Job has to complete if it sets no timeout annotation.
+	DefaultTimeout = 10 * time.Minute
+	// refSuffixLength is the number of characters of the layer digest which are appended to the names of the Jobs.
+	refSuffixLength = 10
+)
+
+var (
+	ErrInvalidHook  = errors.New("invalid upgrade hook")
+	ErrHookFailed   = errors.New("upgrade hook failed")
+	ErrHookTimedOut = errors.New("upgrade hook did not complete in time")
+)
+
+// Load reads the Jobs of the given phase from the hooks layer at the path. The Jobs are named after the ref of the
+// version, so that they run once per upgrade, labeled with the module, and put into the namespace if they have none.
+// Only Jobs with a valid hook annotation are allowed in the hooks layer.
+func Load(path, phase, ref, namespace, module string) ([]*batchv1.Job, error) {
+	if path == "" {
+		return nil, nil
+	}
+	resources, err := internal.ParseManifestToObjects(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse hooks: %w", err)
+	}
+	var jobs []*batchv1.Job
+	for _, obj := range resources.Items {
+		gvk := obj.GroupVersionKind()
+		if gvk.Group != batchv1.GroupName || gvk.Kind != "Job" {
+			return nil, fmt.Errorf("%w: %s %s is not a Job", ErrInvalidHook, gvk.Kind, obj.GetName())
+		}
+		hookPhase := obj.GetAnnotations()[shared.HookAnnotation]
+		if hookPhase != shared.HookPreUpgrade && hookPhase != shared.HookPostUpgrade {
+			return nil, fmt.Errorf("%w: Job %s has no %s annotation with %s or %s", ErrInvalidHook, obj.GetName(),
+				shared.HookAnnotation, shared.HookPreUpgrade, shared.HookPostUpgrade)
+		}
+		if _, valid := timeout(obj.GetAnnotations()); !valid {
+			return nil, fmt.Errorf("%w: Job %s has no valid duration in the %s annotation", ErrInvalidHook,
+				obj.GetName(), shared.HookTimeoutAnnotation)
+		}
+		if hookPhase != phase {
+			continue
+		}
+		job := &batchv1.Job{}
+		if err := machineryruntime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, job); err != nil {
+			return nil, fmt.Errorf("%w: Job %s: %w", ErrInvalidHook, obj.GetName(), err)
+		}
+		job.SetName(job.GetName() + "-" + refSuffix(ref))
+		if job.GetNamespace() == "" {
+			job.SetNamespace(namespace)
+		}
+		labels := job.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[shared.ManagedBy] = shared.ManagedByLabelValue
+		labels[shared.ModuleName] = module
+		job.SetLabels(labels)
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// Run creates the Jobs which do not exist yet and returns true once all of them completed. It fails if one of the
+// Jobs failed or did not complete within its timeout, which starts with the creation of the Job.
+func Run(ctx context.Context, clnt client.Client, jobs []*batchv1.Job, now time.Time) (bool, error) {
+	completed := true
+	for _, job := range jobs {
+		existing := &batchv1.Job{}
+		err := clnt.Get(ctx, client.ObjectKeyFromObject(job), existing)
+		if util.IsNotFound(err) {
+			if err := clnt.Create(ctx, job.DeepCopy()); err != nil && !apierrors.IsAlreadyExists(err) {
+				return false, fmt.Errorf("failed to create hook Job %s/%s: %w", job.GetNamespace(), job.GetName(), err)
+			}
+			completed = false
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to get hook Job %s/%s: %w", job.GetNamespace(), job.GetName(), err)
+		}
+
+		if findCondition(existing, batchv1.JobComplete) != nil {
+			continue
+		}
+		if condition := findCondition(existing, batchv1.JobFailed); condition != nil {
+			return false, fmt.Errorf("%w: Job %s/%s: %s", ErrHookFailed, job.GetNamespace(), job.GetName(),
+				condition.Message)
+		}
+		jobTimeout, _ := timeout(job.GetAnnotations())
+		if now.After(existing.GetCreationTimestamp().Add(jobTimeout)) {
+			return false, fmt.Errorf("%w: Job %s/%s did not complete within %s", ErrHookTimedOut,
+				job.GetNamespace(), job.GetName(), jobTimeout)
+		}
+		completed = false
+	}
+	return completed, nil
+}
+
+// Remove deletes the hook Jobs of the module together with their pods. The Jobs are looked up in the namespace, or in
+// all namespaces if it is empty.
+func Remove(ctx context.Context, clnt client.Client, module, namespace string) error {
+	jobs := &batchv1.JobList{}
+	if err := clnt.List(ctx, jobs, client.InNamespace(namespace), client.MatchingLabels{
+		shared.ManagedBy:       shared.ManagedByLabelValue,
+		shared.ModuleName: module,
+	}); err != nil {
+		return fmt.Errorf("failed to list hook Jobs of module %s: %w", module, err)
+	}
+	for index := range jobs.Items {
+		job := &jobs.Items[index]
+		if err := clnt.Delete(ctx, job, client.PropagationPolicy(apimetav1.DeletePropagationBackground)); err != nil &&
+			!util.IsNotFound(err) {
+			return fmt.Errorf("failed to delete hook Job %s/%s: %w", job.GetNamespace(), job.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func findCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for index := range job.Status.Conditions {
+		condition := &job.Status.Conditions[index]
+		if condition.Type == conditionType && condition.Status == apicorev1.ConditionTrue {
+			return condition
+		}
+	}
+	return nil
+}
+
+// timeout returns the time a hook Job has to complete, and false if its timeout annotation is invalid.
+func timeout(annotations map[string]string) (time.Duration, bool) {
+	value, found := annotations[shared.HookTimeoutAnnotation]
+	if !found {
+		return DefaultTimeout, true
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, false
+	}
+	return duration, true
+}
+
+// refSuffix returns the beginning of the digest of the ref, without its algorithm.
+func refSuffix(ref string) string {
+	if _, digest, found := strings.Cut(ref, ":"); found {
+		ref = digest
+	}
+	return strings.ToLower(ref[:min(len(ref), refSuffixLength)])
+}
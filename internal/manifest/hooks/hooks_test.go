@@ -0,0 +1,222 @@
+package hooks_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/hooks"
+)
+
+const (
+	testRef    = "sha256:6f1ed002ab5595859014ebf0951522d9f2f4b0b3"
+	testModule = "template-operator"
+	hooksYAML = `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: backup
+  annotations:
+    operator.kyma-project.io/hook: pre-upgrade
+    operator.kyma-project.io/hook-timeout: 5m
+spec:
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: backup
+        image: busybox
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  namespace: module-system
+  annotations:
+    operator.kyma-project.io/hook: post-upgrade
+spec:
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: migrate
+        image: busybox
+`
+)
+
+func TestLoad(t *testing.T) {
+	path := writeHooks(t, hooksYAML)
+
+	preUpgrade, err := hooks.Load(path, shared.HookPreUpgrade, testRef, "kyma-system", testModule)
+	require.NoError(t, err)
+	require.Len(t, preUpgrade, 1)
+	assert.Equal(t, "backup-6f1ed002ab", preUpgrade[0].GetName())
+	assert.Equal(t, "kyma-system", preUpgrade[0].GetNamespace())
+	assert.Equal(t, shared.ManagedByLabelValue, preUpgrade[0].GetLabels()[shared.ManagedBy])
+
+	postUpgrade, err := hooks.Load(path, shared.HookPostUpgrade, testRef, "kyma-system", testModule)
+	require.NoError(t, err)
+	require.Len(t, postUpgrade, 1)
+	assert.Equal(t, "migrate-6f1ed002ab", postUpgrade[0].GetName())
+	assert.Equal(t, "module-system", postUpgrade[0].GetNamespace())
+}
+
+func TestLoad_WithoutHooksLayer(t *testing.T) {
+	jobs, err := hooks.Load("", shared.HookPreUpgrade, testRef, "kyma-system", testModule)
+
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+}
+
+func TestLoad_RejectsInvalidHooks(t *testing.T) {
+	tests := []struct {
+		name  string
+		hooks string
+	}{
+		{
+			name: "no Job",
+			hooks: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`,
+		},
+		{
+			name: "no hook annotation",
+			hooks: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: backup
+`,
+		},
+		{
+			name: "invalid timeout",
+			hooks: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: backup
+  annotations:
+    operator.kyma-project.io/hook: pre-upgrade
+    operator.kyma-project.io/hook-timeout: soon
+`,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := hooks.Load(writeHooks(t, testCase.hooks), shared.HookPreUpgrade, testRef, "kyma-system",
+				testModule)
+			require.ErrorIs(t, err, hooks.ErrInvalidHook)
+		})
+	}
+}
+
+func TestRun_CreatesJobsAndWaitsForCompletion(t *testing.T) {
+	skrClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	job := newJob(nil)
+
+	completed, err := hooks.Run(context.Background(), skrClient, []*batchv1.Job{job}, time.Now())
+	require.NoError(t, err)
+	assert.False(t, completed)
+	require.NoError(t, skrClient.Get(context.Background(), client.ObjectKeyFromObject(job), &batchv1.Job{}))
+
+	setCondition(t, skrClient, job, batchv1.JobComplete, "")
+	completed, err = hooks.Run(context.Background(), skrClient, []*batchv1.Job{job}, time.Now())
+	require.NoError(t, err)
+	assert.True(t, completed)
+}
+
+func TestRun_FailsForFailedJob(t *testing.T) {
+	skrClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	job := newJob(nil)
+	require.NoError(t, skrClient.Create(context.Background(), job.DeepCopy()))
+	setCondition(t, skrClient, job, batchv1.JobFailed, "BackoffLimitExceeded")
+
+	_, err := hooks.Run(context.Background(), skrClient, []*batchv1.Job{job}, time.Now())
+
+	require.ErrorIs(t, err, hooks.ErrHookFailed)
+	require.ErrorContains(t, err, "BackoffLimitExceeded")
+}
+
+func TestRun_FailsForTimedOutJob(t *testing.T) {
+	createdAt := time.Now().Add(-time.Hour)
+	existing := newJob(map[string]string{shared.HookTimeoutAnnotation: "30m"})
+	existing.SetCreationTimestamp(apimetav1.NewTime(createdAt))
+	skrClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+
+	completed, err := hooks.Run(context.Background(), skrClient,
+		[]*batchv1.Job{newJob(map[string]string{shared.HookTimeoutAnnotation: "2h"})}, time.Now())
+	require.NoError(t, err)
+	assert.False(t, completed)
+
+	_, err = hooks.Run(context.Background(), skrClient,
+		[]*batchv1.Job{newJob(map[string]string{shared.HookTimeoutAnnotation: "30m"})}, time.Now())
+	require.ErrorIs(t, err, hooks.ErrHookTimedOut)
+}
+
+func TestRemove_DeletesHookJobsOfModule(t *testing.T) {
+	jobOfModule := newJob(nil)
+	jobOfModule.SetLabels(map[string]string{
+		shared.ManagedBy: shared.ManagedByLabelValue, shared.ModuleName: testModule,
+	})
+	jobOfOtherModule := newJob(nil)
+	jobOfOtherModule.SetName("backup-other")
+	jobOfOtherModule.SetLabels(map[string]string{
+		shared.ManagedBy: shared.ManagedByLabelValue, shared.ModuleName: "other-module",
+	})
+	skrClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).
+		WithObjects(jobOfModule, jobOfOtherModule).Build()
+
+	require.NoError(t, hooks.Remove(context.Background(), skrClient, testModule, ""))
+
+	err := skrClient.Get(context.Background(), client.ObjectKeyFromObject(jobOfModule), &batchv1.Job{})
+	require.True(t, apierrors.IsNotFound(err))
+	require.NoError(t, skrClient.Get(context.Background(), client.ObjectKeyFromObject(jobOfOtherModule),
+		&batchv1.Job{}))
+}
+
+func writeHooks(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "hooks.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func newJob(annotations map[string]string) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:        "backup-6f1ed002ab",
+			Namespace:   "kyma-system",
+			Annotations: annotations,
+		},
+	}
+}
+
+func setCondition(t *testing.T, clnt client.Client, job *batchv1.Job, conditionType batchv1.JobConditionType,
+	message string,
+) {
+	t.Helper()
+	existing := &batchv1.Job{}
+	require.NoError(t, clnt.Get(context.Background(), client.ObjectKeyFromObject(job), existing))
+	existing.Status.Conditions = append(existing.Status.Conditions, batchv1.JobCondition{
+		Type:    conditionType,
+		Status:  apicorev1.ConditionTrue,
+		Message: message,
+	})
+	require.NoError(t, clnt.Status().Update(context.Background(), existing))
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/filemutex"
+	"github.com/kyma-project/lifecycle-manager/internal/tracing"
 	"github.com/kyma-project/lifecycle-manager/pkg/ocmextensions"
 )
 
@@ -40,12 +41,25 @@ func NewPathExtractor() *PathExtractor {
 
 func (p PathExtractor) GetPathFromRawManifest(ctx context.Context, imageSpec v1beta2.ImageSpec,
 	keyChain authn.Keychain,
+) (string, error) {
+	return p.getPathFromLayer(ctx, imageSpec, keyChain, v1beta2.RawManifestLayer)
+}
+
+// GetPathFromHooks returns the path of the hooks layer, which holds the Jobs run before and after an upgrade.
+func (p PathExtractor) GetPathFromHooks(ctx context.Context, imageSpec v1beta2.ImageSpec,
+	keyChain authn.Keychain,
+) (string, error) {
+	return p.getPathFromLayer(ctx, imageSpec, keyChain, v1beta2.HooksLayer)
+}
+
+func (p PathExtractor) getPathFromLayer(ctx context.Context, imageSpec v1beta2.ImageSpec,
+	keyChain authn.Keychain, layerName v1beta2.LayerName,
 ) (string, error) {
 	switch imageSpec.Type {
 	case v1beta2.OciRefType:
-		return p.GetPathForFetchedLayer(ctx, imageSpec, keyChain, string(v1beta2.RawManifestLayer)+".yaml")
+		return p.GetPathForFetchedLayer(ctx, imageSpec, keyChain, string(layerName)+".yaml")
 	case v1beta2.OciDirType:
-		tarFile, err := p.GetPathForFetchedLayer(ctx, imageSpec, keyChain, string(v1beta2.RawManifestLayer)+".tar")
+		tarFile, err := p.GetPathForFetchedLayer(ctx, imageSpec, keyChain, string(layerName)+".tar")
 		if err != nil {
 			return "", err
 		}
@@ -174,6 +188,14 @@ func (p PathExtractor) ExtractLayer(tarPath string) (string, error) {
 }
 
 func pullLayer(ctx context.Context, imageRef string, keyChain authn.Keychain) (containerregistryv1.Layer, error) {
+	ctx, span := tracing.Start(ctx, "Image.PullLayer", tracing.ImageKey.String(imageRef))
+	layer, err := pullLayerFromRegistry(ctx, imageRef, keyChain)
+	tracing.End(span, err)
+	return layer, err
+}
+
+func pullLayerFromRegistry(ctx context.Context, imageRef string, keyChain authn.Keychain,
+) (containerregistryv1.Layer, error) {
 	noSchemeImageRef := ocmextensions.NoSchemeURL(imageRef)
 	isInsecureLayer, err := regexp.MatchString("^http://", imageRef)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
@@ -57,7 +58,9 @@ func (m *ManifestClient) SsaSpec(ctx context.Context, obj client.Object) error {
 }
 
 func HasStatusDiff(first, second shared.Status) bool {
-	return first.State != second.State || first.LastOperation.Operation != second.LastOperation.Operation
+	return first.State != second.State || first.LastOperation.Operation != second.LastOperation.Operation ||
+		!equality.Semantic.DeepEqual(first.ModuleCR, second.ModuleCR) ||
+		!equality.Semantic.DeepEqual(first.ReadoptionConflicts, second.ReadoptionConflicts)
 }
 
 func resetNonPatchableField(obj client.Object) {
@@ -75,6 +75,22 @@ func Test_hasStatusDiff(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "Different Module CR Status",
+			args: args{
+				first: shared.Status{
+					State:         shared.StateReady,
+					LastOperation: shared.LastOperation{Operation: "resources are ready"},
+					ModuleCR:      &shared.ModuleCRStatus{State: shared.StateReady},
+				},
+				second: shared.Status{
+					State:         shared.StateReady,
+					LastOperation: shared.LastOperation{Operation: "resources are ready"},
+					ModuleCR:      &shared.ModuleCRStatus{State: shared.StateWarning, Message: "invalid configuration"},
+				},
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -74,6 +76,82 @@ func (c *Client) CheckCRDeletion(ctx context.Context, manifestCR *v1beta2.Manife
 	return resourceCR == nil, nil
 }
 
+// GetUserCreatedCRs lists all custom resources in the cluster of the CRDs synced by the manifest and of the kind
+// of its module CR, except for the module CR itself. If the manifest does not define a module CR, all custom
+// resources of these CRDs are returned. CRDs which do not exist in the cluster are skipped.
+func (c *Client) GetUserCreatedCRs(ctx context.Context, manifest *v1beta2.Manifest) ([]shared.Resource, error) {
+	gvks, err := c.getModuleGVKs(ctx, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []shared.Resource
+	for _, gvk := range gvks {
+		resourceList := &unstructured.UnstructuredList{}
+		resourceList.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := c.List(ctx, resourceList); err != nil {
+			if util.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list %s resources: %w", gvk.Kind, err)
+		}
+		for _, item := range resourceList.Items {
+			if isModuleCR(manifest, gvk, &item) {
+				continue
+			}
+			resources = append(resources, shared.Resource{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+				GroupVersionKind: apimetav1.GroupVersionKind{
+					Group:   gvk.Group,
+					Version: gvk.Version,
+					Kind:    gvk.Kind,
+				},
+			})
+		}
+	}
+	return resources, nil
+}
+
+// getModuleGVKs returns the kind of the module CR and the storage version kinds of the CRDs synced by the manifest.
+func (c *Client) getModuleGVKs(ctx context.Context, manifest *v1beta2.Manifest) ([]schema.GroupVersionKind, error) {
+	var gvks []schema.GroupVersionKind
+	if manifest.Spec.Resource != nil {
+		gvks = append(gvks, manifest.Spec.Resource.GroupVersionKind())
+	}
+	for _, synced := range manifest.Status.Synced {
+		if synced.Group != apiextensionsv1.GroupName || synced.Kind != "CustomResourceDefinition" {
+			continue
+		}
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := c.Get(ctx, client.ObjectKey{Name: synced.Name}, crd); err != nil {
+			if util.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to fetch CRD %s: %w", synced.Name, err)
+		}
+		for _, crdVersion := range crd.Spec.Versions {
+			if !crdVersion.Storage {
+				continue
+			}
+			gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: crdVersion.Name, Kind: crd.Spec.Names.Kind}
+			if !slices.ContainsFunc(gvks, func(known schema.GroupVersionKind) bool {
+				return known.GroupKind() == gvk.GroupKind()
+			}) {
+				gvks = append(gvks, gvk)
+			}
+		}
+	}
+	return gvks, nil
+}
+
+func isModuleCR(manifest *v1beta2.Manifest, gvk schema.GroupVersionKind, resource *unstructured.Unstructured) bool {
+	return manifest.Spec.Resource != nil &&
+		manifest.Spec.Resource.GroupVersionKind().GroupKind() == gvk.GroupKind() &&
+		resource.GetName() == manifest.Spec.Resource.GetName() &&
+		resource.GetNamespace() == manifest.Spec.Resource.GetNamespace()
+}
+
 // RemoveModuleCR deletes the module CR if available in the cluster.
 // It uses DeletePropagationBackground to delete module CR.
 // Only if module CR is not found (indicated by NotFound error), it continues to remove Manifest finalizer,
@@ -112,16 +190,24 @@ func (c *Client) deleteCR(ctx context.Context, manifest *v1beta2.Manifest) (bool
 
 // SyncModuleCR sync the manifest default custom resource status in the cluster, if not available it created the resource.
 // It is used to provide the controller with default data in the Runtime.
-func (c *Client) SyncModuleCR(ctx context.Context, manifest *v1beta2.Manifest) error {
+// GetModuleCR returns the module CR of the manifest as it is created in the SKR cluster, nil if there is none.
+func GetModuleCR(manifest *v1beta2.Manifest) *unstructured.Unstructured {
 	if manifest.Spec.Resource == nil {
 		return nil
 	}
-
 	resource := manifest.Spec.Resource.DeepCopy()
 	resource.SetLabels(collections.MergeMaps(resource.GetLabels(), map[string]string{
 		shared.ManagedBy: shared.ManagedByLabelValue,
 	}))
+	return resource
+}
+
+func (c *Client) SyncModuleCR(ctx context.Context, manifest *v1beta2.Manifest) error {
+	if manifest.Spec.Resource == nil {
+		return nil
+	}
 
+	resource := GetModuleCR(manifest)
 	if err := c.Get(ctx, client.ObjectKeyFromObject(resource), resource); err != nil && util.IsNotFound(err) {
 		if !manifest.GetDeletionTimestamp().IsZero() {
 			return nil
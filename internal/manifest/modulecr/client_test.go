@@ -2,11 +2,13 @@ package modulecr_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	templatev1alpha1 "github.com/kyma-project/template-operator/api/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -124,3 +126,90 @@ func TestClient_SyncModuleCR(t *testing.T) {
 	err = skrClient.Get(ctx, client.ObjectKey{Name: moduleName, Namespace: shared.DefaultRemoteNamespace}, resource)
 	require.NoError(t, err)
 }
+
+func TestClient_GetUserCreatedCRs(t *testing.T) {
+	// Given a manifest CR with a module CR and a user-created CR of the same kind
+	scheme := machineryruntime.NewScheme()
+	err := v1beta2.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	kcpClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	skrClient := modulecr.NewClient(kcpClient)
+	ctx := context.TODO()
+	manifest := testutils.NewTestManifest("test-manifest")
+	gvk := schema.GroupVersionKind{
+		Group:   templatev1alpha1.GroupVersion.Group,
+		Version: templatev1alpha1.GroupVersion.Version,
+		Kind:    string(templatev1alpha1.SampleKind),
+	}
+	moduleCR := unstructured.Unstructured{}
+	moduleCR.SetGroupVersionKind(gvk)
+	moduleCR.SetName("test-resource")
+	moduleCR.SetNamespace(shared.DefaultRemoteNamespace)
+	manifest.Spec.Resource = &moduleCR
+	err = kcpClient.Create(ctx, moduleCR.DeepCopy())
+	require.NoError(t, err)
+	userCR := unstructured.Unstructured{}
+	userCR.SetGroupVersionKind(gvk)
+	userCR.SetName("user-resource")
+	userCR.SetNamespace("default")
+	err = kcpClient.Create(ctx, &userCR)
+	require.NoError(t, err)
+
+	// When listing the user-created CRs
+	resources, err := skrClient.GetUserCreatedCRs(ctx, manifest)
+
+	// Then only the user-created CR is returned
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "user-resource", resources[0].Name)
+	assert.Equal(t, "default", resources[0].Namespace)
+	assert.Equal(t, gvk.Kind, resources[0].Kind)
+}
+
+func TestClient_GetUserCreatedCRs_ListsCRsOfAllSyncedCRDs(t *testing.T) {
+	// Given a manifest without a module CR which synced two CRDs with a CR each
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+
+	manifest := testutils.NewTestManifest("test-manifest")
+	var objects []client.Object
+	for _, kind := range []string{"Sample", "Backup"} {
+		crd := &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: apimetav1.ObjectMeta{Name: strings.ToLower(kind) + "s.example.com"},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: "example.com",
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: kind},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: "v1alpha1", Served: true},
+					{Name: "v1", Served: true, Storage: true},
+				},
+			},
+		}
+		userCR := &unstructured.Unstructured{}
+		userCR.SetGroupVersionKind(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: kind})
+		userCR.SetName("user-" + strings.ToLower(kind))
+		userCR.SetNamespace("default")
+		objects = append(objects, crd, userCR)
+		manifest.Status.Synced = append(manifest.Status.Synced, shared.Resource{
+			Name: crd.Name,
+			GroupVersionKind: apimetav1.GroupVersionKind{
+				Group: apiextensionsv1.GroupName, Version: "v1", Kind: "CustomResourceDefinition",
+			},
+		})
+	}
+	skrClient := modulecr.NewClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build())
+
+	// When listing the user-created CRs
+	resources, err := skrClient.GetUserCreatedCRs(context.TODO(), manifest)
+
+	// Then the CRs of both CRDs are returned
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	assert.Equal(t, "user-sample", resources[0].Name)
+	assert.Equal(t, "Sample", resources[0].Kind)
+	assert.Equal(t, "v1", resources[0].Version)
+	assert.Equal(t, "user-backup", resources[1].Name)
+	assert.Equal(t, "Backup", resources[1].Kind)
+}
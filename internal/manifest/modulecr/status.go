@@ -0,0 +1,113 @@
+package modulecr
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+const defaultStatePath = "status.state"
+
+// GetCRStatus fetches the module CR of the manifest and maps its status as declared in the manifest.
+// It returns nil if the manifest does not define a module CR or the module CR does not exist.
+func (c *Client) GetCRStatus(ctx context.Context, manifest *v1beta2.Manifest) (*shared.ModuleCRStatus, error) {
+	if manifest.Spec.Resource == nil {
+		return nil, nil
+	}
+	resourceCR, err := c.GetCR(ctx, manifest)
+	if err != nil {
+		if util.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return MapStatus(resourceCR, manifest.Spec.ResourceStatusMapping)
+}
+
+// MapStatus maps the status of the module CR with the given mapping, or with the default mapping reading the state
+// from .status.state if the mapping is nil. It returns nil if the module CR has no status to map.
+func MapStatus(resourceCR *unstructured.Unstructured,
+	mapping *v1beta2.ModuleCRStatusMapping,
+) (*shared.ModuleCRStatus, error) {
+	if mapping == nil {
+		mapping = &v1beta2.ModuleCRStatusMapping{}
+	}
+	statePath := mapping.StatePath
+	if statePath == "" {
+		statePath = defaultStatePath
+	}
+
+	status := &shared.ModuleCRStatus{}
+	if value, found := nestedValue(resourceCR, statePath); found {
+		status.State = mapState(value, mapping.States)
+	}
+	if mapping.MessagePath != "" {
+		status.Message, _ = nestedValue(resourceCR, mapping.MessagePath)
+	}
+	conditions, err := selectConditions(resourceCR, mapping.Conditions)
+	if err != nil {
+		return nil, err
+	}
+	status.Conditions = conditions
+
+	if status.State == "" && status.Message == "" && len(status.Conditions) == 0 {
+		return nil, nil
+	}
+	return status, nil
+}
+
+func nestedValue(resourceCR *unstructured.Unstructured, path string) (string, bool) {
+	value, found, err := unstructured.NestedFieldNoCopy(resourceCR.Object,
+		strings.Split(strings.TrimPrefix(path, "."), ".")...)
+	if err != nil || !found || value == nil {
+		return "", false
+	}
+	return fmt.Sprint(value), true
+}
+
+func mapState(value string, states []v1beta2.ModuleCRStateMapping) shared.State {
+	for _, state := range states {
+		if state.Value == value {
+			return state.MappedState
+		}
+	}
+	if state := shared.State(value); state.IsSupportedState() {
+		return state
+	}
+	return ""
+}
+
+func selectConditions(resourceCR *unstructured.Unstructured, conditionTypes []string) ([]apimetav1.Condition,
+	error,
+) {
+	if len(conditionTypes) == 0 {
+		return nil, nil
+	}
+	items, found, err := unstructured.NestedSlice(resourceCR.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil, nil //nolint:nilerr // a module CR without valid conditions has no conditions to copy
+	}
+
+	var conditions []apimetav1.Condition
+	for _, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok || !slices.Contains(conditionTypes, fmt.Sprint(object["type"])) {
+			continue
+		}
+		condition := apimetav1.Condition{}
+		if err := machineryruntime.DefaultUnstructuredConverter.FromUnstructured(object, &condition); err != nil {
+			return nil, fmt.Errorf("failed to convert condition %v of the module CR: %w", object["type"], err)
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
@@ -0,0 +1,82 @@
+package modulecr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
+)
+
+func TestMapStatus_DefaultMappingReadsState(t *testing.T) {
+	moduleCR := newModuleCR(map[string]any{"state": "Error", "message": "invalid configuration"})
+
+	status, err := modulecr.MapStatus(moduleCR, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, &shared.ModuleCRStatus{State: shared.StateError}, status)
+}
+
+func TestMapStatus_DefaultMappingIgnoresUnknownState(t *testing.T) {
+	moduleCR := newModuleCR(map[string]any{"state": "Healthy"})
+
+	status, err := modulecr.MapStatus(moduleCR, nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, status)
+}
+
+func TestMapStatus_CustomMapping(t *testing.T) {
+	moduleCR := newModuleCR(map[string]any{
+		"health": map[string]any{"phase": "Degraded", "reason": "invalid configuration"},
+		"conditions": []any{
+			map[string]any{
+				"type": "ConfigValid", "status": "False", "reason": "InvalidConfig",
+				"message": "spec.replicas must be positive", "lastTransitionTime": "2024-01-01T00:00:00Z",
+			},
+			map[string]any{
+				"type": "Installed", "status": "True", "reason": "Installed",
+				"message": "", "lastTransitionTime": "2024-01-01T00:00:00Z",
+			},
+		},
+	})
+	mapping := &v1beta2.ModuleCRStatusMapping{
+		StatePath:   ".status.health.phase",
+		MessagePath: "status.health.reason",
+		States:      []v1beta2.ModuleCRStateMapping{{Value: "Degraded", MappedState: shared.StateWarning}},
+		Conditions:  []string{"ConfigValid"},
+	}
+
+	status, err := modulecr.MapStatus(moduleCR, mapping)
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.StateWarning, status.State)
+	assert.Equal(t, "invalid configuration", status.Message)
+	require.Len(t, status.Conditions, 1)
+	assert.Equal(t, "ConfigValid", status.Conditions[0].Type)
+	assert.Equal(t, apimetav1.ConditionFalse, status.Conditions[0].Status)
+	assert.Equal(t, "spec.replicas must be positive", status.Conditions[0].Message)
+}
+
+func TestMapStatus_NoStatus(t *testing.T) {
+	moduleCR := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{}}}
+
+	status, err := modulecr.MapStatus(moduleCR, &v1beta2.ModuleCRStatusMapping{Conditions: []string{"Ready"}})
+
+	require.NoError(t, err)
+	assert.Nil(t, status)
+}
+
+func newModuleCR(status map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "operator.kyma-project.io/v1alpha1",
+		"kind":       "Sample",
+		"metadata":   map[string]any{"name": "sample", "namespace": "kyma-system"},
+		"status":     status,
+	}}
+}
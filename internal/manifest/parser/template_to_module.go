@@ -88,6 +88,7 @@ func (p *Parser) appendModuleWithInformation(module templatelookup.ModuleInfo, k
 			Template:    template,
 			Enabled:     module.Enabled,
 			IsUnmanaged: module.Unmanaged,
+			Criticality: resolveCriticality(module.Module, template),
 		})
 		return modules
 	}
@@ -99,12 +100,13 @@ func (p *Parser) appendModuleWithInformation(module templatelookup.ModuleInfo, k
 			Template:    template,
 			Enabled:     module.Enabled,
 			IsUnmanaged: module.Unmanaged,
+			Criticality: resolveCriticality(module.Module, template),
 		})
 		return modules
 	}
 	fqdn := descriptor.GetName()
 	name := common.CreateModuleName(fqdn, kyma.Name, module.Name)
-	setNameAndNamespaceIfEmpty(template, name, p.remoteSyncNamespace)
+	setNameAndNamespaceIfEmpty(template, name, p.moduleCRNamespace(kyma))
 	var manifest *v1beta2.Manifest
 	if manifest, err = p.newManifestFromTemplate(module.Module,
 		template.ModuleTemplate); err != nil {
@@ -114,6 +116,7 @@ func (p *Parser) appendModuleWithInformation(module templatelookup.ModuleInfo, k
 			Template:    template,
 			Enabled:     module.Enabled,
 			IsUnmanaged: module.Unmanaged,
+			Criticality: resolveCriticality(module.Module, template),
 		})
 		return modules
 	}
@@ -128,10 +131,35 @@ func (p *Parser) appendModuleWithInformation(module templatelookup.ModuleInfo, k
 		Manifest:    manifest,
 		Enabled:     module.Enabled,
 		IsUnmanaged: module.Unmanaged,
+		Criticality: resolveCriticality(module.Module, template),
 	})
 	return modules
 }
 
+// resolveCriticality prefers the criticality set in the Kyma spec over the one declared in the ModuleTemplate.
+// Mandatory modules are always critical.
+func resolveCriticality(module v1beta2.Module, template *templatelookup.ModuleTemplateInfo) v1beta2.ModuleCriticality {
+	if template != nil && template.ModuleTemplate != nil && template.IsMandatory() {
+		return v1beta2.ModuleCriticalityCritical
+	}
+	if module.Criticality != "" {
+		return module.Criticality
+	}
+	if template != nil && template.ModuleTemplate != nil && template.Spec.Criticality != "" {
+		return template.Spec.Criticality
+	}
+	return v1beta2.ModuleCriticalityCritical
+}
+
+// moduleCRNamespace returns the default namespace of the module CR, which is the tenant namespace for tenants of
+// a shared SKR cluster.
+func (p *Parser) moduleCRNamespace(kyma *v1beta2.Kyma) string {
+	if tenantNamespace := kyma.GetTenantNamespace(); tenantNamespace != "" {
+		return tenantNamespace
+	}
+	return p.remoteSyncNamespace
+}
+
 func setNameAndNamespaceIfEmpty(template *templatelookup.ModuleTemplateInfo, name, namespace string) {
 	if template.ModuleTemplate.Spec.Data == nil {
 		return
@@ -161,6 +189,7 @@ func (p *Parser) newManifestFromTemplate(
 	default:
 		if template.Spec.Data != nil {
 			manifest.Spec.Resource = template.Spec.Data.DeepCopy()
+			manifest.Spec.ResourceStatusMapping = template.Spec.ModuleCRStatus.DeepCopy()
 		}
 	}
 
@@ -183,6 +212,7 @@ func (p *Parser) newManifestFromTemplate(
 		return nil, fmt.Errorf("could not translate custom state check: %w", err)
 	}
 	manifest.Spec.Version = descriptor.Version
+	manifest.Spec.UpgradeStrategy = template.Spec.UpgradeStrategy.DeepCopy()
 	return manifest, nil
 }
 
@@ -229,6 +259,15 @@ func insertLayerIntoManifest(manifest *v1beta2.Manifest, layer img.Layer) error
 			Source: machineryruntime.RawExtension{Raw: installRaw},
 			Name:   string(layer.LayerName),
 		}
+	case v1beta2.HooksLayer:
+		hooksRaw, err := layer.ToInstallRaw()
+		if err != nil {
+			return fmt.Errorf("error while parsing hooks layer: %w", err)
+		}
+		manifest.Spec.Hooks = &v1beta2.InstallInfo{
+			Source: machineryruntime.RawExtension{Raw: hooksRaw},
+			Name:   string(layer.LayerName),
+		}
 	}
 
 	return nil
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+)
+
+func Test_resolveCriticality(t *testing.T) {
+	tests := []struct {
+		name     string
+		module   v1beta2.Module
+		template *templatelookup.ModuleTemplateInfo
+		expected v1beta2.ModuleCriticality
+	}{
+		{
+			name:     "module criticality overrides template criticality",
+			module:   v1beta2.Module{Name: "test-module", Criticality: v1beta2.ModuleCriticalityOptional},
+			template: newTemplateInfo(v1beta2.ModuleCriticalityCritical, false),
+			expected: v1beta2.ModuleCriticalityOptional,
+		},
+		{
+			name:     "template criticality is used if module has no criticality",
+			module:   v1beta2.Module{Name: "test-module"},
+			template: newTemplateInfo(v1beta2.ModuleCriticalityOptional, false),
+			expected: v1beta2.ModuleCriticalityOptional,
+		},
+		{
+			name:     "defaults to critical if neither module nor template set a criticality",
+			module:   v1beta2.Module{Name: "test-module"},
+			template: newTemplateInfo("", false),
+			expected: v1beta2.ModuleCriticalityCritical,
+		},
+		{
+			name:     "mandatory module is critical regardless of module criticality",
+			module:   v1beta2.Module{Name: "test-module", Criticality: v1beta2.ModuleCriticalityOptional},
+			template: newTemplateInfo(v1beta2.ModuleCriticalityOptional, true),
+			expected: v1beta2.ModuleCriticalityCritical,
+		},
+		{
+			name:     "module criticality is used for nil template",
+			module:   v1beta2.Module{Name: "test-module", Criticality: v1beta2.ModuleCriticalityOptional},
+			template: nil,
+			expected: v1beta2.ModuleCriticalityOptional,
+		},
+		{
+			name:     "defaults to critical for nil template",
+			module:   v1beta2.Module{Name: "test-module"},
+			template: nil,
+			expected: v1beta2.ModuleCriticalityCritical,
+		},
+		{
+			name:     "defaults to critical for template info without ModuleTemplate",
+			module:   v1beta2.Module{Name: "test-module"},
+			template: &templatelookup.ModuleTemplateInfo{},
+			expected: v1beta2.ModuleCriticalityCritical,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, resolveCriticality(testCase.module, testCase.template))
+		})
+	}
+}
+
+func newTemplateInfo(criticality v1beta2.ModuleCriticality, mandatory bool) *templatelookup.ModuleTemplateInfo {
+	return &templatelookup.ModuleTemplateInfo{
+		ModuleTemplate: &v1beta2.ModuleTemplate{
+			Spec: v1beta2.ModuleTemplateSpec{
+				Criticality: criticality,
+				Mandatory:   mandatory,
+			},
+		},
+	}
+}
@@ -0,0 +1,167 @@
+package readoption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+var ErrConfirmationRequired = errors.New("re-adoption overwrites fields of existing resources and must be confirmed")
+
+// IsRequired returns true if the existing resources of the module of the manifest need to be re-adopted.
+func IsRequired(manifest *v1beta2.Manifest) bool {
+	_, found := manifest.GetAnnotations()[shared.ReadoptionAnnotation]
+	return found
+}
+
+// IsConfirmed returns true if the re-adoption of the existing resources was confirmed, even if it overwrites fields.
+func IsConfirmed(manifest *v1beta2.Manifest) bool {
+	return manifest.GetAnnotations()[shared.ReadoptionAnnotation] == shared.ReadoptionConfirmed
+}
+
+// Complete removes the re-adoption annotation from the manifest.
+func Complete(manifest *v1beta2.Manifest) {
+	annotations := manifest.GetAnnotations()
+	delete(annotations, shared.ReadoptionAnnotation)
+	manifest.SetAnnotations(annotations)
+}
+
+// FindConflicts returns the existing resources of the target whose fields differ from the target and are overwritten
+// when the resources are applied. Fields which only exist in the cluster, such as defaulted fields, are no conflict.
+func FindConflicts(ctx context.Context, clnt client.Client, target []*unstructured.Unstructured,
+) ([]shared.ReadoptionConflict, error) {
+	var conflicts []shared.ReadoptionConflict
+	for _, desired := range target {
+		existing, err := getExisting(ctx, clnt, desired)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			continue
+		}
+		fields := ConflictingFields(desired.Object, existing.Object)
+		if len(fields) == 0 {
+			continue
+		}
+		conflicts = append(conflicts, shared.ReadoptionConflict{Resource: toResource(desired), Fields: fields})
+	}
+	return conflicts, nil
+}
+
+// ConflictingFields returns the paths of the fields of the desired object which are set to a different value in the
+// existing object. The status and the metadata, except for labels and annotations, are not compared.
+func ConflictingFields(desired, existing map[string]any) []string {
+	var fields []string
+	for key, value := range desired {
+		switch key {
+		case "apiVersion", "kind", "status":
+			continue
+		case "metadata":
+			desiredMeta, _ := value.(map[string]any)
+			existingMeta, _ := existing[key].(map[string]any)
+			for _, metaKey := range []string{"labels", "annotations"} {
+				fields = append(fields, conflictingValues("."+key+"."+metaKey,
+					desiredMeta[metaKey], existingMeta[metaKey])...)
+			}
+		default:
+			existingValue, found := existing[key]
+			if !found {
+				continue
+			}
+			fields = append(fields, conflictingValues("."+key, value, existingValue)...)
+		}
+	}
+	slices.Sort(fields)
+	return fields
+}
+
+func conflictingValues(path string, desired, existing any) []string {
+	if desired == nil || existing == nil {
+		return nil
+	}
+	switch desiredValue := desired.(type) {
+	case map[string]any:
+		existingValue, ok := existing.(map[string]any)
+		if !ok {
+			return []string{path}
+		}
+		var fields []string
+		for key, value := range desiredValue {
+			if existingField, found := existingValue[key]; found {
+				fields = append(fields, conflictingValues(path+"."+key, value, existingField)...)
+			}
+		}
+		return fields
+	case []any:
+		existingValue, ok := existing.([]any)
+		if !ok || len(existingValue) != len(desiredValue) {
+			return []string{path}
+		}
+		var fields []string
+		for index := range desiredValue {
+			fields = append(fields,
+				conflictingValues(path+"["+strconv.Itoa(index)+"]", desiredValue[index], existingValue[index])...)
+		}
+		return fields
+	default:
+		if !equality.Semantic.DeepEqual(desired, existing) {
+			return []string{path}
+		}
+		return nil
+	}
+}
+
+// RestoreManagedByLabel adds the managed-by label back to the existing resources of the target, which were released
+// when the module became unmanaged.
+func RestoreManagedByLabel(ctx context.Context, clnt client.Client, target []*unstructured.Unstructured) error {
+	patch := fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, shared.ManagedBy, shared.ManagedByLabelValue)
+	for _, desired := range target {
+		existing, err := getExisting(ctx, clnt, desired)
+		if err != nil {
+			return err
+		}
+		if existing == nil || existing.GetLabels()[shared.ManagedBy] == shared.ManagedByLabelValue {
+			continue
+		}
+		if err := clnt.Patch(ctx, existing, client.RawPatch(types.MergePatchType, []byte(patch))); err != nil {
+			return fmt.Errorf("failed to restore %s label of %s %s: %w", shared.ManagedBy, existing.GetKind(),
+				client.ObjectKeyFromObject(existing), err)
+		}
+	}
+	return nil
+}
+
+func getExisting(ctx context.Context, clnt client.Client, desired *unstructured.Unstructured,
+) (*unstructured.Unstructured, error) {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(desired.GroupVersionKind())
+	if err := clnt.Get(ctx, client.ObjectKeyFromObject(desired), existing); err != nil {
+		if util.IsNotFound(err) {
+			return nil, nil //nolint:nilnil // a resource which does not exist yet is simply created
+		}
+		return nil, fmt.Errorf("failed to get existing %s %s: %w", desired.GetKind(),
+			client.ObjectKeyFromObject(desired), err)
+	}
+	return existing, nil
+}
+
+func toResource(obj *unstructured.Unstructured) shared.Resource {
+	gvk := obj.GroupVersionKind()
+	return shared.Resource{
+		Name:             obj.GetName(),
+		Namespace:        obj.GetNamespace(),
+		GroupVersionKind: apimetav1.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+	}
+}
@@ -0,0 +1,134 @@
+package readoption_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/readoption"
+)
+
+func TestConflictingFields_IgnoresDefaultedAndMissingFields(t *testing.T) {
+	desired := newDeployment(1, "nginx:1.27")
+	existing := newDeployment(1, "nginx:1.27")
+	containers, _, _ := unstructured.NestedSlice(existing.Object, "spec", "template", "spec", "containers")
+	containers[0].(map[string]any)["imagePullPolicy"] = "IfNotPresent"
+	require.NoError(t, unstructured.SetNestedSlice(existing.Object, containers,
+		"spec", "template", "spec", "containers"))
+	desired.SetLabels(map[string]string{shared.ManagedBy: shared.ManagedByLabelValue})
+	existing.SetResourceVersion("42")
+	require.NoError(t, unstructured.SetNestedField(existing.Object, int64(1), "status", "readyReplicas"))
+
+	assert.Empty(t, readoption.ConflictingFields(desired.Object, existing.Object))
+}
+
+func TestConflictingFields_ReportsChangedFields(t *testing.T) {
+	desired := newDeployment(1, "nginx:1.27")
+	desired.SetLabels(map[string]string{"app": "nginx"})
+	existing := newDeployment(3, "nginx:1.26")
+	existing.SetLabels(map[string]string{"app": "web"})
+
+	assert.Equal(t, []string{
+		".metadata.labels.app",
+		".spec.replicas",
+		".spec.template.spec.containers[0].image",
+	}, readoption.ConflictingFields(desired.Object, existing.Object))
+}
+
+func TestConflictingFields_ReportsListsOfDifferentLength(t *testing.T) {
+	desired := newDeployment(1, "nginx:1.27")
+	existing := newDeployment(1, "nginx:1.27")
+	containers, _, _ := unstructured.NestedSlice(existing.Object, "spec", "template", "spec", "containers")
+	containers = append(containers, map[string]any{"name": "sidecar", "image": "envoy:1.31"})
+	require.NoError(t, unstructured.SetNestedSlice(existing.Object, containers,
+		"spec", "template", "spec", "containers"))
+
+	assert.Equal(t, []string{".spec.template.spec.containers"},
+		readoption.ConflictingFields(desired.Object, existing.Object))
+}
+
+func TestFindConflicts(t *testing.T) {
+	existing := newDeployment(3, "nginx:1.27")
+	skrClient := fake.NewClientBuilder().WithObjects(existing).Build()
+
+	conflicts, err := readoption.FindConflicts(context.Background(), skrClient, []*unstructured.Unstructured{
+		newDeployment(1, "nginx:1.27"),
+		newConfigMap("not-existing"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []shared.ReadoptionConflict{{
+		Resource: shared.Resource{
+			Name:             "nginx",
+			Namespace:        "kyma-system",
+			GroupVersionKind: apimetav1.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		},
+		Fields: []string{".spec.replicas"},
+	}}, conflicts)
+}
+
+func TestRestoreManagedByLabel(t *testing.T) {
+	released := newConfigMap("released")
+	released.SetLabels(map[string]string{"app": "nginx"})
+	skrClient := fake.NewClientBuilder().WithObjects(released).Build()
+
+	err := readoption.RestoreManagedByLabel(context.Background(), skrClient, []*unstructured.Unstructured{
+		newConfigMap("released"),
+		newConfigMap("not-existing"),
+	})
+
+	require.NoError(t, err)
+	restored := &unstructured.Unstructured{}
+	restored.SetGroupVersionKind(released.GroupVersionKind())
+	require.NoError(t, skrClient.Get(context.Background(), client.ObjectKeyFromObject(released), restored))
+	assert.Equal(t, map[string]string{"app": "nginx", shared.ManagedBy: shared.ManagedByLabelValue},
+		restored.GetLabels())
+}
+
+func TestReadoptionAnnotation(t *testing.T) {
+	manifest := &v1beta2.Manifest{}
+	assert.False(t, readoption.IsRequired(manifest))
+
+	manifest.SetAnnotations(map[string]string{shared.ReadoptionAnnotation: shared.ReadoptionRequired})
+	assert.True(t, readoption.IsRequired(manifest))
+	assert.False(t, readoption.IsConfirmed(manifest))
+
+	manifest.SetAnnotations(map[string]string{shared.ReadoptionAnnotation: shared.ReadoptionConfirmed})
+	assert.True(t, readoption.IsConfirmed(manifest))
+
+	readoption.Complete(manifest)
+	assert.False(t, readoption.IsRequired(manifest))
+}
+
+func newDeployment(replicas int64, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "nginx", "namespace": "kyma-system"},
+		"spec": map[string]any{
+			"replicas": replicas,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{"name": "nginx", "image": image}},
+				},
+			},
+		},
+	}}
+}
+
+func newConfigMap(name string) *unstructured.Unstructured {
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetName(name)
+	configMap.SetNamespace("kyma-system")
+	return configMap
+}
@@ -14,6 +14,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/kyma-project/lifecycle-manager/internal"
+	"github.com/kyma-project/lifecycle-manager/internal/tracing"
 )
 
 var (
@@ -98,7 +99,10 @@ func (c *ConcurrentDefaultSSA) serverSideApply(
 	start := time.Now()
 	logger := logf.FromContext(ctx, "owner", c.owner)
 	logger.V(internal.TraceLogLevel).Info("apply " + resource.ObjectName())
-	results <- c.serverSideApplyResourceInfo(ctx, resource)
+	ctx, span := tracing.Start(ctx, "SSA.Apply", tracing.ResourceKey.String(resource.ObjectName()))
+	err := c.serverSideApplyResourceInfo(ctx, resource)
+	tracing.End(span, err)
+	results <- err
 	logger.V(internal.TraceLogLevel).Info(
 		fmt.Sprintf("apply %s finished", resource.ObjectName()),
 		"time", time.Since(start),
@@ -16,6 +16,20 @@ var ErrWarningResourceSyncStateDiff = errors.New("resource syncTarget state diff
 
 func SyncResources(ctx context.Context, skrClient client.Client, manifest *v1beta2.Manifest,
 	target []*resource.Info,
+) error {
+	return syncResources(ctx, skrClient, manifest, target, false)
+}
+
+// SyncResourcesSideBySide applies the target resources like SyncResources, but keeps tracking the previously synced
+// resources, so that the resources of two versions of a module are synced side by side until one of them is removed.
+func SyncResourcesSideBySide(ctx context.Context, skrClient client.Client, manifest *v1beta2.Manifest,
+	target []*resource.Info,
+) error {
+	return syncResources(ctx, skrClient, manifest, target, true)
+}
+
+func syncResources(ctx context.Context, skrClient client.Client, manifest *v1beta2.Manifest,
+	target []*resource.Info, keepSynced bool,
 ) error {
 	manifestStatus := manifest.GetStatus()
 
@@ -26,6 +40,9 @@ func SyncResources(ctx context.Context, skrClient client.Client, manifest *v1bet
 
 	oldSynced := manifestStatus.Synced
 	newSynced := NewInfoToResourceConverter().InfosToResources(target)
+	if keepSynced {
+		newSynced = mergeResources(oldSynced, newSynced)
+	}
 	manifestStatus.Synced = newSynced
 
 	if HasDiff(oldSynced, newSynced) {
@@ -59,3 +76,17 @@ func HasDiff(oldResources []shared.Resource, newResources []shared.Resource) boo
 	}
 	return false
 }
+
+func mergeResources(resources []shared.Resource, added []shared.Resource) []shared.Resource {
+	merged := append([]shared.Resource{}, resources...)
+	existing := map[string]bool{}
+	for _, item := range resources {
+		existing[item.ID()] = true
+	}
+	for _, item := range added {
+		if !existing[item.ID()] {
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
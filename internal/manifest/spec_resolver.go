@@ -19,6 +19,7 @@ type KeyChainLookup interface {
 
 type PathExtractor interface {
 	GetPathFromRawManifest(ctx context.Context, imageSpec v1beta2.ImageSpec, keyChain authn.Keychain) (string, error)
+	GetPathFromHooks(ctx context.Context, imageSpec v1beta2.ImageSpec, keyChain authn.Keychain) (string, error)
 }
 
 type SpecResolver struct {
@@ -56,9 +57,37 @@ func (s *SpecResolver) GetSpec(ctx context.Context, manifest *v1beta2.Manifest)
 		return nil, fmt.Errorf("failed to extract raw manifest from layer digest: %w", err)
 	}
 
+	hooksPath, err := s.getHooksPath(ctx, manifest)
+	if err != nil {
+		return nil, err
+	}
+
 	return &declarativev2.Spec{
 		ManifestName: manifest.Spec.Install.Name,
 		Path:         rawManifestPath,
 		OCIRef:       imageSpec.Ref,
+		HooksPath:    hooksPath,
 	}, nil
 }
+
+// getHooksPath returns the path of the hooks layer of the manifest, or an empty path if the module has no hooks.
+func (s *SpecResolver) getHooksPath(ctx context.Context, manifest *v1beta2.Manifest) (string, error) {
+	if manifest.Spec.Hooks == nil {
+		return "", nil
+	}
+	var imageSpec v1beta2.ImageSpec
+	if err := yaml.Unmarshal(manifest.Spec.Hooks.Source.Raw, &imageSpec); err != nil {
+		return "", fmt.Errorf("failed to unmarshal hooks data: %w", err)
+	}
+
+	keyChain, err := s.keyChainLookup.Get(ctx, imageSpec)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch keyChain: %w", err)
+	}
+
+	hooksPath, err := s.manifestPathExtractor.GetPathFromHooks(ctx, imageSpec, keyChain)
+	if err != nil {
+		return "", fmt.Errorf("failed to extract hooks from layer digest: %w", err)
+	}
+	return hooksPath, nil
+}
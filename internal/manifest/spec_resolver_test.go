@@ -72,6 +72,34 @@ func Test_GetSpec(t *testing.T) {
 		require.Equal(t, expected, actual)
 	})
 
+	t.Run("should return a Spec with the hooks path", func(t *testing.T) {
+		// given
+		mockKeyChainLookup := &mockKeyChainLookup{}
+		mockPathExtractor := &mockPathExtractor{}
+		specResolver := manifest.NewSpecResolver(mockKeyChainLookup, mockPathExtractor)
+
+		manifestWithHooks := strings.Replace(testManifest, "  remote: true", `  hooks:
+    name: hooks
+    source:
+      name: kyma-project.io/module/template-operator
+      ref: sha256:6f1ed002ab5595859014ebf0951522d9f2f4b0b3e6b7e2b8b4a6f1c0b3f9e0a1
+      repo: http://k3d-registry.localhost:5000/component-descriptors
+      type: oci-ref
+  remote: true`, 1)
+
+		// when
+		ctx := context.TODO()
+		mft := v1beta2.Manifest{}
+		err := yaml.Unmarshal([]byte(manifestWithHooks), &mft)
+		require.NoError(t, err)
+
+		actual, err := specResolver.GetSpec(ctx, &mft)
+		require.NoError(t, err)
+
+		// then
+		require.Equal(t, path.Join(mockLocalFileCachePath, string(v1beta2.HooksLayer+".yaml")), actual.HooksPath)
+	})
+
 	t.Run("should return an error with incorrect render mode", func(t *testing.T) {
 		// given
 		mockKeyChainLookup := &mockKeyChainLookup{}
@@ -145,6 +173,15 @@ func (m *mockPathExtractor) GetPathFromRawManifest(ctx context.Context, imageSpe
 	return testPath(), nil
 }
 
+func (m *mockPathExtractor) GetPathFromHooks(ctx context.Context, imageSpec v1beta2.ImageSpec,
+	keyChain authn.Keychain,
+) (string, error) {
+	if m.mockError != nil {
+		return "", m.mockError
+	}
+	return path.Join(mockLocalFileCachePath, string(v1beta2.HooksLayer+".yaml")), nil
+}
+
 func testPath() string {
 	return path.Join(mockLocalFileCachePath, string(v1beta2.RawManifestLayer+".yaml"))
 }
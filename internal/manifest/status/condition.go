@@ -13,6 +13,10 @@ const (
 	ConditionTypeResources    ConditionType = "Resources"
 	ConditionTypeModuleCR     ConditionType = "ModuleCR"
 	ConditionTypeInstallation ConditionType = "Installation"
+	// ConditionTypePreUpgradeHooks reports the hooks which run before the resources of a new version are applied.
+	ConditionTypePreUpgradeHooks ConditionType = "PreUpgradeHooks"
+	// ConditionTypePostUpgradeHooks reports the hooks which run after a new version became ready.
+	ConditionTypePostUpgradeHooks ConditionType = "PostUpgradeHooks"
 )
 
 type ConditionReason string
@@ -21,6 +25,10 @@ const (
 	ConditionReasonResourcesAreAvailable ConditionReason = "ResourcesAvailable"
 	ConditionReasonModuleCRWarning       ConditionReason = "Warning"
 	ConditionReasonReady                 ConditionReason = "Ready"
+	ConditionReasonHooksPending          ConditionReason = "HooksPending"
+	ConditionReasonHooksRunning          ConditionReason = "HooksRunning"
+	ConditionReasonHooksCompleted        ConditionReason = "HooksCompleted"
+	ConditionReasonHooksFailed           ConditionReason = "HooksFailed"
 )
 
 func initInstallationCondition(manifest *v1beta2.Manifest) apimetav1.Condition {
@@ -64,3 +72,55 @@ func ConfirmInstallationCondition(manifest *v1beta2.Manifest) {
 		manifest.SetStatus(status.WithOperation(installationCondition.Message))
 	}
 }
+
+// SetHooksCondition reports the hooks of the version with the OCI ref. The conditions of the hooks of a previous
+// version are reset. The condition is only true once the hooks completed.
+func SetHooksCondition(manifest *v1beta2.Manifest, conditionType ConditionType, ref string,
+	reason ConditionReason, message string,
+) {
+	status := manifest.GetStatus()
+	if status.HooksRef != ref {
+		meta.RemoveStatusCondition(&status.Conditions, string(ConditionTypePreUpgradeHooks))
+		meta.RemoveStatusCondition(&status.Conditions, string(ConditionTypePostUpgradeHooks))
+		status.HooksRef = ref
+	}
+	conditionStatus := apimetav1.ConditionFalse
+	if reason == ConditionReasonHooksCompleted {
+		conditionStatus = apimetav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&status.Conditions, apimetav1.Condition{
+		Type:               string(conditionType),
+		Reason:             string(reason),
+		Status:             conditionStatus,
+		Message:            message,
+		ObservedGeneration: manifest.GetGeneration(),
+	})
+	manifest.SetStatus(status)
+}
+
+// HooksCompleted returns true if the hooks completed for the version with the OCI ref.
+func HooksCompleted(manifest *v1beta2.Manifest, conditionType ConditionType, ref string) bool {
+	condition := findHooksCondition(manifest, conditionType, ref)
+	return condition != nil && condition.Status == apimetav1.ConditionTrue
+}
+
+// HooksFailed returns true if the hooks failed for the version with the OCI ref.
+func HooksFailed(manifest *v1beta2.Manifest, conditionType ConditionType, ref string) bool {
+	condition := findHooksCondition(manifest, conditionType, ref)
+	return condition != nil && condition.Reason == string(ConditionReasonHooksFailed)
+}
+
+// RequirePostUpgradeHooks returns true if the manifest is upgraded to the version with the OCI ref with pre-upgrade
+// hooks, and the post-upgrade hooks did not complete yet.
+func RequirePostUpgradeHooks(manifest *v1beta2.Manifest, ref string) bool {
+	return HooksCompleted(manifest, ConditionTypePreUpgradeHooks, ref) &&
+		!HooksCompleted(manifest, ConditionTypePostUpgradeHooks, ref)
+}
+
+func findHooksCondition(manifest *v1beta2.Manifest, conditionType ConditionType, ref string) *apimetav1.Condition {
+	status := manifest.GetStatus()
+	if status.HooksRef != ref {
+		return nil
+	}
+	return meta.FindStatusCondition(status.Conditions, string(conditionType))
+}
@@ -38,3 +38,54 @@ func TestConfirmInstallationCondition(t *testing.T) {
 		t.Errorf("expected observed generation %d, got %d", manifest.GetGeneration(), condition.ObservedGeneration)
 	}
 }
+
+func TestRequirePostUpgradeHooks(t *testing.T) {
+	const ref, nextRef = "sha256:6f1ed002ab", "sha256:9c2a7e41d0"
+	manifest := &v1beta2.Manifest{}
+
+	if status.RequirePostUpgradeHooks(manifest, ref) {
+		t.Errorf("expected no post-upgrade hooks without completed pre-upgrade hooks")
+	}
+
+	status.SetHooksCondition(manifest, status.ConditionTypePreUpgradeHooks, ref,
+		status.ConditionReasonHooksCompleted, "pre-upgrade hooks completed")
+	manifest.SetGeneration(2)
+	if !status.RequirePostUpgradeHooks(manifest, ref) {
+		t.Errorf("expected post-upgrade hooks after completed pre-upgrade hooks of the ref")
+	}
+
+	status.SetHooksCondition(manifest, status.ConditionTypePostUpgradeHooks, ref,
+		status.ConditionReasonHooksCompleted, "post-upgrade hooks completed")
+	if status.RequirePostUpgradeHooks(manifest, ref) {
+		t.Errorf("expected no post-upgrade hooks after completed post-upgrade hooks")
+	}
+
+	if status.RequirePostUpgradeHooks(manifest, nextRef) {
+		t.Errorf("expected no post-upgrade hooks for a ref without completed pre-upgrade hooks")
+	}
+}
+
+func TestSetHooksCondition_ResetsConditionsOfPreviousRef(t *testing.T) {
+	const ref, nextRef = "sha256:6f1ed002ab", "sha256:9c2a7e41d0"
+	manifest := &v1beta2.Manifest{}
+	status.SetHooksCondition(manifest, status.ConditionTypePreUpgradeHooks, ref,
+		status.ConditionReasonHooksCompleted, "pre-upgrade hooks completed")
+	status.SetHooksCondition(manifest, status.ConditionTypePostUpgradeHooks, ref,
+		status.ConditionReasonHooksCompleted, "post-upgrade hooks completed")
+
+	status.SetHooksCondition(manifest, status.ConditionTypePreUpgradeHooks, nextRef,
+		status.ConditionReasonHooksFailed, "hook Job backup failed")
+
+	if manifest.GetStatus().HooksRef != nextRef {
+		t.Errorf("expected hooks ref %s, got %s", nextRef, manifest.GetStatus().HooksRef)
+	}
+	if len(manifest.GetStatus().Conditions) != 1 {
+		t.Errorf("expected only the condition of the next ref, got %v", manifest.GetStatus().Conditions)
+	}
+	if !status.HooksFailed(manifest, status.ConditionTypePreUpgradeHooks, nextRef) {
+		t.Errorf("expected failed pre-upgrade hooks for the next ref")
+	}
+	if status.HooksCompleted(manifest, status.ConditionTypePostUpgradeHooks, nextRef) {
+		t.Errorf("expected no completed post-upgrade hooks for the next ref")
+	}
+}
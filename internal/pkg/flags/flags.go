@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
+	"github.com/kyma-project/lifecycle-manager/internal/tracing"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 )
 
@@ -35,6 +37,9 @@ const (
 	DefaultCacheSyncTimeout                                             = 2 * time.Minute
 	DefaultLogLevel                                                     = log.WarnLevel
 	DefaultPurgeFinalizerTimeout                                        = 5 * time.Minute
+	DefaultPurgeDeletionTimeout                                         = 1 * time.Minute
+	DefaultPurgeForceFinalizeFor                                        = "*"
+	DefaultPurgeReportRetention                                         = 30 * 24 * time.Hour
 	DefaultMaxConcurrentManifestReconciles                              = 1
 	DefaultMaxConcurrentKymaReconciles                                  = 1
 	DefaultMaxConcurrentWatcherReconciles                               = 1
@@ -48,22 +53,41 @@ const (
 	DefaultSelfSignedCertRenewBefore                      time.Duration = 60 * 24 * time.Hour
 	DefaultSelfSignedCertificateRenewBuffer                             = 24 * time.Hour
 	DefaultSelfSignedCertKeySize                                        = 4096
+	DefaultCARotationGracePeriod                                        = 1 * time.Hour
+	DefaultCertificateProvider                                          = CertificateProviderCertManager
+	DefaultSelfSignedCertRenewInterval                                  = 10 * time.Minute
 	DefaultRemoteSyncNamespace                                          = shared.DefaultRemoteNamespace
 	DefaultMetricsAddress                                               = ":8080"
 	DefaultProbeAddress                                                 = ":8081"
 	DefaultKymaListenerAddress                                          = ":8082"
 	DefaultManifestListenerAddress                                      = ":8083"
 	DefaultPprofAddress                                                 = ":8084"
+	DefaultCatalogAPIAddress                                            = ":8443"
 	DefaultWatcherImageName                                             = "runtime-watcher"
 	DefaultWatcherImageRegistry                                         = "europe-docker.pkg.dev/kyma-project/prod"
 	DefaultWatcherResourcesPath                                         = "./skr-webhook"
 	DefaultWatcherResourceLimitsCPU                                     = "0.1"
 	DefaultWatcherResourceLimitsMemory                                  = "200Mi"
 	DefaultDropCrdStoredVersionMap                                      = "Manifest:v1beta1,Watcher:v1beta1,ModuleTemplate:v1beta1,Kyma:v1beta1"
+	DefaultStorageVersionMigrationBatchSize                             = 500
+	DefaultStorageVersionMigrationNamespace                             = "kcp-system"
 	DefaultMetricsCleanupIntervalInMinutes                              = 15
+	DefaultMetricsCardinality                                           = string(metrics.CardinalityPerKyma)
 	DefaultLeaderElectionLeaseDuration                                  = 180 * time.Second
 	DefaultLeaderElectionRenewDeadline                                  = 120 * time.Second
 	DefaultLeaderElectionRetryPeriod                                    = 3 * time.Second
+	DefaultShardCount                                                   = 1
+	DefaultShardLeaseNamespace                                          = "kcp-system"
+	DefaultShardLabelingInterval                                        = 1 * time.Minute
+	DefaultAuditWebhookTimeout                                          = 5 * time.Second
+	DefaultAuditWebhookBufferSize                                       = 1000
+	DefaultTracingExporter                                              = tracing.ExporterNone
+	DefaultTracingSampleRatio                                           = 1.0
+)
+
+const (
+	CertificateProviderCertManager = "cert-manager"
+	CertificateProviderBuiltin     = "builtin"
 )
 
 var (
@@ -72,8 +96,18 @@ var (
 	ErrWatcherDirNotExist                      = errors.New("failed to locate watcher resource manifest folder")
 	ErrLeaderElectionTimeoutConfig             = errors.New("configured leader-election-renew-deadline must be less than leader-election-lease-duration")
 	ErrInvalidSelfSignedCertKeyLength          = errors.New("invalid self-signed-cert-key-size: must be 4096")
+	ErrInvalidCARotationGracePeriod            = errors.New("invalid ca-rotation-grace-period: must not be negative")
+	ErrInvalidCertificateProvider              = errors.New("invalid certificate-provider: must be cert-manager or builtin")
 	ErrInvalidManifestRequeueJitterPercentage  = errors.New("invalid manifest requeue jitter percentage: must be between 0 and 0.05")
 	ErrInvalidManifestRequeueJitterProbability = errors.New("invalid manifest requeue jitter probability: must be between 0 and 1")
+	ErrInvalidShardCount                       = errors.New("invalid shard-count: must be at least 1")
+	ErrShardingRequiresLeaderElection          = errors.New("shard-count greater than 1 requires leader-elect")
+	ErrInvalidTracingExporter                  = errors.New("invalid tracing-exporter: must be none or otlp")
+	ErrInvalidTracingSampleRatio               = errors.New("invalid tracing-sample-ratio: must be between 0 and 1")
+	ErrInvalidMetricsCardinality               = errors.New("invalid metrics-cardinality: must be per-kyma or aggregated")
+	ErrInvalidStorageVersionMigrationBatchSize = errors.New("invalid storage-version-migration-batch-size: must be at least 1")
+	ErrInvalidPurgeDeletionTimeout             = errors.New("invalid purge-deletion-timeout: must not be negative")
+	ErrInvalidPurgeReportRetention             = errors.New("invalid purge-report-retention: must be positive")
 )
 
 //nolint:funlen // defines all program flags
@@ -116,6 +150,14 @@ func DefineFlagVar() *FlagVar {
 	flag.DurationVar(&flagVar.LeaderElectionRetryPeriod, "leader-election-retry-period",
 		DefaultLeaderElectionRetryPeriod,
 		"Configures the 'RetryPeriod' option of the controller-runtime library used to run the controller manager process.")
+	flag.IntVar(&flagVar.ShardCount, "shard-count", DefaultShardCount,
+		"The number of shards the Kymas and their Manifests are distributed across. "+
+			"Each replica reconciles one shard, acquired through the Lease of the shard. "+
+			"The Kymas of shards without a replica are rebalanced to the other shards.")
+	flag.StringVar(&flagVar.ShardLeaseNamespace, "shard-lease-namespace", DefaultShardLeaseNamespace,
+		"The namespace of the Leases of the shards.")
+	flag.DurationVar(&flagVar.ShardLabelingInterval, "shard-labeling-interval", DefaultShardLabelingInterval,
+		"The interval in which Kymas and Manifests without a shard label are assigned to the shard of the replica.")
 	flag.DurationVar(&flagVar.KymaRequeueSuccessInterval, "kyma-requeue-success-interval",
 		DefaultKymaRequeueSuccessInterval,
 		"determines the duration a Kyma in Ready state is enqueued for reconciliation.")
@@ -189,6 +231,33 @@ func DefineFlagVar() *FlagVar {
 		"Indicates the failure max delay in seconds")
 	flag.DurationVar(&flagVar.CacheSyncTimeout, "cache-sync-timeout", DefaultCacheSyncTimeout,
 		"Indicates the cache sync timeout in seconds")
+	flag.BoolVar(&flagVar.EnablePriorityQueue, "enable-priority-queue", false,
+		"Enabling priority-aware work queues for the Kyma and Manifest controllers")
+	flag.BoolVar(&flagVar.EnableCatalogAPI, "enable-catalog-api", false,
+		"Enabling the read-only module catalog API, served over HTTPS to authenticated and authorized clients")
+	flag.StringVar(&flagVar.CatalogAPIAddr, "catalog-api-bind-address", DefaultCatalogAPIAddress,
+		"The address the catalog API binds to.")
+	flag.StringVar(&flagVar.CatalogAPICertDir, "catalog-api-cert-dir", "",
+		"The directory of the tls.crt and tls.key serving certificate of the catalog API, "+
+			"a self-signed certificate is generated if empty")
+	flag.StringVar(&flagVar.AuditLogFile, "audit-log-file", "",
+		"Path of the file the module lifecycle audit records are appended to as JSON lines, disabled if empty")
+	flag.BoolVar(&flagVar.EnableAuditEvents, "enable-audit-events", false,
+		"Enabling Kubernetes Events on the Kyma for the module lifecycle audit records")
+	flag.StringVar(&flagVar.AuditWebhookURL, "audit-webhook-url", "",
+		"URL the module lifecycle audit records are posted to as JSON, disabled if empty")
+	flag.DurationVar(&flagVar.AuditWebhookTimeout, "audit-webhook-timeout", DefaultAuditWebhookTimeout,
+		"Timeout of the requests to the audit webhook")
+	flag.IntVar(&flagVar.AuditWebhookBufferSize, "audit-webhook-buffer-size", DefaultAuditWebhookBufferSize,
+		"Number of audit records buffered for the audit webhook, further records are dropped")
+	flag.StringVar(&flagVar.TracingExporter, "tracing-exporter", DefaultTracingExporter,
+		"The exporter of the OpenTelemetry traces: 'none' discards them, 'otlp' sends them to an OTLP gRPC receiver")
+	flag.StringVar(&flagVar.TracingOTLPEndpoint, "tracing-otlp-endpoint", "",
+		"The host and port of the OTLP gRPC receiver, the OTEL_EXPORTER_OTLP_ENDPOINT variable is used if empty")
+	flag.BoolVar(&flagVar.TracingOTLPInsecure, "tracing-otlp-insecure", false,
+		"Disabling TLS for the connection to the OTLP gRPC receiver")
+	flag.Float64Var(&flagVar.TracingSampleRatio, "tracing-sample-ratio", DefaultTracingSampleRatio,
+		"The ratio of the reconciliations that are traced, between 0 and 1")
 	flag.BoolVar(&flagVar.EnableDomainNameVerification, "enable-domain-name-pinning", true,
 		"Enabling verification of incoming listener request by comparing SAN with KymaCR-SKR-domain")
 	flag.IntVar(
@@ -203,6 +272,21 @@ func DefineFlagVar() *FlagVar {
 		"Indicates the SKR Purge Finalizers execution delay in seconds")
 	flag.StringVar(&flagVar.SkipPurgingFor, "skip-finalizer-purging-for", "", "Exclude the passed CRDs"+
 		" from finalizer removal. Example: 'ingressroutetcps.traefik.containo.us,*.helm.cattle.io'.")
+	flag.DurationVar(&flagVar.PurgeDeletionTimeout, "purge-deletion-timeout", DefaultPurgeDeletionTimeout,
+		"Indicates how long the SKR resources of an API group get to be deleted before their finalizers are removed")
+	flag.StringVar(&flagVar.PurgeGroupDeletionTimeouts, "purge-group-deletion-timeouts", "",
+		"Overrides the purge-deletion-timeout per API group. Example: 'cert-manager.io=5m,serverless.kyma-project.io=2m'.")
+	flag.StringVar(&flagVar.PurgeDeletionOrder, "purge-deletion-order", "",
+		"API groups whose SKR resources are purged first, in the given order."+
+			" Example: 'gateway.kyma-project.io,cert-manager.io'.")
+	flag.StringVar(&flagVar.PurgeIncludeFor, "purge-include-for", "",
+		"The CRDs not installed by modules whose SKR resources are purged as well."+
+			" Example: 'ingressroutetcps.traefik.containo.us,*.helm.cattle.io'.")
+	flag.StringVar(&flagVar.PurgeForceFinalizeFor, "purge-force-finalize-for", DefaultPurgeForceFinalizeFor,
+		"The CRDs whose SKR resources get their finalizers removed if they are not deleted within the deletion timeout."+
+			" Example: 'ingressroutetcps.traefik.containo.us,*.helm.cattle.io', '*' matches all CRDs.")
+	flag.DurationVar(&flagVar.PurgeReportRetention, "purge-report-retention", DefaultPurgeReportRetention,
+		"Indicates how long the PurgeReports are kept after the purge completed")
 	flag.StringVar(&flagVar.RemoteSyncNamespace, "sync-namespace", DefaultRemoteSyncNamespace,
 		"Name of the namespace for syncing remote Kyma and module catalog")
 	flag.StringVar(&flagVar.CaCertName, "ca-cert-name", DefaultCaCertName,
@@ -217,10 +301,27 @@ func DefineFlagVar() *FlagVar {
 		"The buffer duration to wait before confirm self-signed certificate not renewed")
 	flag.IntVar(&flagVar.SelfSignedCertKeySize, "self-signed-cert-key-size", DefaultSelfSignedCertKeySize,
 		"The key size for the self-signed certificate")
+	flag.DurationVar(&flagVar.CARotationGracePeriod, "ca-rotation-grace-period", DefaultCARotationGracePeriod,
+		"The minimum duration the gateway secret keeps trusting the previous CA after a CA rotation, "+
+			"so that SKR certificates can be re-issued without a gap. The previous CA is kept until all SKR "+
+			"certificates are re-issued. 0 drops the previous CA immediately.")
+	flag.StringVar(&flagVar.CertificateProvider, "certificate-provider", DefaultCertificateProvider,
+		"The provider issuing the SKR watcher certificates: 'cert-manager' creates cert-manager Certificates, "+
+			"'builtin' signs them with the CA from the root CA secret in the Istio Namespace.")
+	flag.DurationVar(&flagVar.SelfSignedCertRenewInterval, "self-signed-cert-renew-interval",
+		DefaultSelfSignedCertRenewInterval,
+		"The interval in which the builtin certificate provider checks the self-signed certificates for renewal")
 	flag.BoolVar(&flagVar.IsKymaManaged, "is-kyma-managed", false, "indicates whether Kyma is managed")
 	flag.StringVar(&flagVar.DropCrdStoredVersionMap, "drop-crd-stored-version-map", DefaultDropCrdStoredVersionMap,
 		"Specify the API versions to be dropped from the storage version. The input format should be a "+
-			"comma-separated list of API versions, where each API version is in the format 'kind:version'.")
+			"comma-separated list of API versions, where each API version is in the format 'kind:version'. "+
+			"The objects of the kind are migrated to the storage version before the version is dropped.")
+	flag.Int64Var(&flagVar.StorageVersionMigrationBatchSize, "storage-version-migration-batch-size",
+		DefaultStorageVersionMigrationBatchSize,
+		"The number of objects listed and migrated at once by the storage version migration.")
+	flag.StringVar(&flagVar.StorageVersionMigrationNamespace, "storage-version-migration-namespace",
+		DefaultStorageVersionMigrationNamespace,
+		"The namespace of the ConfigMap tracking the progress of the storage version migration.")
 	flag.StringVar(&flagVar.WatcherImageName, "skr-watcher-image-name", DefaultWatcherImageName,
 		`Image name to be used for the SKR watcher image.`)
 	flag.StringVar(&flagVar.WatcherImageTag, "skr-watcher-image-tag", "",
@@ -237,6 +338,9 @@ func DefineFlagVar() *FlagVar {
 	flag.IntVar(&flagVar.MetricsCleanupIntervalInMinutes, "metrics-cleanup-interval",
 		DefaultMetricsCleanupIntervalInMinutes,
 		"The interval at which the cleanup of non-existing kyma CRs metrics runs.")
+	flag.StringVar(&flagVar.MetricsCardinality, "metrics-cardinality", DefaultMetricsCardinality,
+		"Labels of the Kyma metrics: per-kyma labels them with the Kyma, "+
+			"aggregated drops the Kyma labels and counts the Kymas and modules per state.")
 	return flagVar
 }
 
@@ -288,6 +392,12 @@ type FlagVar struct {
 	InKCPMode                              bool
 	PurgeFinalizerTimeout                  time.Duration
 	SkipPurgingFor                         string
+	PurgeDeletionTimeout                   time.Duration
+	PurgeGroupDeletionTimeouts             string
+	PurgeDeletionOrder                     string
+	PurgeForceFinalizeFor                  string
+	PurgeIncludeFor                        string
+	PurgeReportRetention                   time.Duration
 	RemoteSyncNamespace                    string
 	CaCertName                             string
 	IsKymaManaged                          bool
@@ -295,7 +405,12 @@ type FlagVar struct {
 	SelfSignedCertRenewBefore              time.Duration
 	SelfSignedCertRenewBuffer              time.Duration
 	SelfSignedCertKeySize                  int
+	CARotationGracePeriod                  time.Duration
+	CertificateProvider                    string
+	SelfSignedCertRenewInterval            time.Duration
 	DropCrdStoredVersionMap                string
+	StorageVersionMigrationBatchSize       int64
+	StorageVersionMigrationNamespace       string
 	WatcherImageTag                        string
 	WatcherImageName                       string
 	WatcherImageRegistry                   string
@@ -303,8 +418,25 @@ type FlagVar struct {
 	WatcherResourceLimitsCPU               string
 	WatcherResourcesPath                   string
 	MetricsCleanupIntervalInMinutes        int
+	MetricsCardinality                     string
 	ManifestRequeueJitterProbability       float64
 	ManifestRequeueJitterPercentage        float64
+	ShardCount                             int
+	ShardLeaseNamespace                    string
+	ShardLabelingInterval                  time.Duration
+	EnablePriorityQueue                    bool
+	EnableCatalogAPI                       bool
+	CatalogAPIAddr                         string
+	CatalogAPICertDir                      string
+	AuditLogFile                           string
+	EnableAuditEvents                      bool
+	AuditWebhookURL                        string
+	AuditWebhookTimeout                    time.Duration
+	AuditWebhookBufferSize                 int
+	TracingExporter                        string
+	TracingOTLPEndpoint                    string
+	TracingOTLPInsecure                    bool
+	TracingSampleRatio                     float64
 }
 
 func (f FlagVar) Validate() error {
@@ -333,6 +465,14 @@ func (f FlagVar) Validate() error {
 		return ErrInvalidSelfSignedCertKeyLength
 	}
 
+	if f.CARotationGracePeriod < 0 {
+		return ErrInvalidCARotationGracePeriod
+	}
+
+	if f.CertificateProvider != CertificateProviderCertManager && f.CertificateProvider != CertificateProviderBuiltin {
+		return ErrInvalidCertificateProvider
+	}
+
 	if f.ManifestRequeueJitterProbability < 0 || f.ManifestRequeueJitterProbability > 0.05 {
 		return ErrInvalidManifestRequeueJitterPercentage
 	}
@@ -340,9 +480,44 @@ func (f FlagVar) Validate() error {
 		return ErrInvalidManifestRequeueJitterProbability
 	}
 
+	if f.ShardCount < 1 {
+		return ErrInvalidShardCount
+	}
+	if f.ShardCount > 1 && !f.EnableLeaderElection {
+		return ErrShardingRequiresLeaderElection
+	}
+
+	if f.TracingExporter != tracing.ExporterNone && f.TracingExporter != tracing.ExporterOTLP {
+		return ErrInvalidTracingExporter
+	}
+	if f.TracingSampleRatio < 0 || f.TracingSampleRatio > 1 {
+		return ErrInvalidTracingSampleRatio
+	}
+
+	if f.MetricsCardinality != string(metrics.CardinalityPerKyma) &&
+		f.MetricsCardinality != string(metrics.CardinalityAggregated) {
+		return ErrInvalidMetricsCardinality
+	}
+
+	if f.StorageVersionMigrationBatchSize < 1 {
+		return ErrInvalidStorageVersionMigrationBatchSize
+	}
+
+	if f.PurgeDeletionTimeout < 0 {
+		return ErrInvalidPurgeDeletionTimeout
+	}
+	if f.PurgeReportRetention <= 0 {
+		return ErrInvalidPurgeReportRetention
+	}
+
 	return nil
 }
 
+// IsSharded returns true if the Kymas are distributed across multiple shards.
+func (f FlagVar) IsSharded() bool {
+	return f.ShardCount > 1
+}
+
 func (f FlagVar) GetWatcherImage() string {
 	return fmt.Sprintf("%s/%s:%s", f.WatcherImageRegistry, f.WatcherImageName, f.WatcherImageTag)
 }
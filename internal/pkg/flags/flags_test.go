@@ -125,6 +125,21 @@ func Test_ConstantFlags(t *testing.T) {
 			constValue:    DefaultPurgeFinalizerTimeout.String(),
 			expectedValue: (5 * time.Minute).String(),
 		},
+		{
+			constName:     "DefaultPurgeDeletionTimeout",
+			constValue:    DefaultPurgeDeletionTimeout.String(),
+			expectedValue: (1 * time.Minute).String(),
+		},
+		{
+			constName:     "DefaultPurgeForceFinalizeFor",
+			constValue:    DefaultPurgeForceFinalizeFor,
+			expectedValue: "*",
+		},
+		{
+			constName:     "DefaultPurgeReportRetention",
+			constValue:    DefaultPurgeReportRetention.String(),
+			expectedValue: (720 * time.Hour).String(),
+		},
 		{
 			constName:     "DefaultMaxConcurrentManifestReconciles",
 			constValue:    strconv.Itoa(DefaultMaxConcurrentManifestReconciles),
@@ -195,11 +210,76 @@ func Test_ConstantFlags(t *testing.T) {
 			constValue:    strconv.Itoa(int(DefaultSelfSignedCertKeySize)),
 			expectedValue: "4096",
 		},
+		{
+			constName:     "DefaultCARotationGracePeriod",
+			constValue:    DefaultCARotationGracePeriod.String(),
+			expectedValue: (1 * time.Hour).String(),
+		},
+		{
+			constName:     "DefaultCertificateProvider",
+			constValue:    DefaultCertificateProvider,
+			expectedValue: "cert-manager",
+		},
+		{
+			constName:     "DefaultSelfSignedCertRenewInterval",
+			constValue:    DefaultSelfSignedCertRenewInterval.String(),
+			expectedValue: (10 * time.Minute).String(),
+		},
+		{
+			constName:     "DefaultShardCount",
+			constValue:    strconv.Itoa(DefaultShardCount),
+			expectedValue: "1",
+		},
+		{
+			constName:     "DefaultShardLeaseNamespace",
+			constValue:    DefaultShardLeaseNamespace,
+			expectedValue: "kcp-system",
+		},
+		{
+			constName:     "DefaultShardLabelingInterval",
+			constValue:    DefaultShardLabelingInterval.String(),
+			expectedValue: (1 * time.Minute).String(),
+		},
+		{
+			constName:     "DefaultAuditWebhookTimeout",
+			constValue:    DefaultAuditWebhookTimeout.String(),
+			expectedValue: (5 * time.Second).String(),
+		},
+		{
+			constName:     "DefaultAuditWebhookBufferSize",
+			constValue:    strconv.Itoa(DefaultAuditWebhookBufferSize),
+			expectedValue: "1000",
+		},
+		{
+			constName:     "DefaultTracingExporter",
+			constValue:    DefaultTracingExporter,
+			expectedValue: "none",
+		},
+		{
+			constName:     "DefaultStorageVersionMigrationBatchSize",
+			constValue:    strconv.Itoa(DefaultStorageVersionMigrationBatchSize),
+			expectedValue: "500",
+		},
+		{
+			constName:     "DefaultStorageVersionMigrationNamespace",
+			constValue:    DefaultStorageVersionMigrationNamespace,
+			expectedValue: "kcp-system",
+		},
+		{
+			constName:     "DefaultMetricsCardinality",
+			constValue:    DefaultMetricsCardinality,
+			expectedValue: "per-kyma",
+		},
 		{
 			constName:     "DefaultMetricsAddress",
 			constValue:    DefaultMetricsAddress,
 			expectedValue: ":8080",
 		},
+		{
+			constName:     "DefaultCatalogAPIAddress",
+			constValue:    DefaultCatalogAPIAddress,
+			expectedValue: ":8443",
+		},
 		{
 			constName:     "DefaultProbeAddress",
 			constValue:    DefaultProbeAddress,
@@ -349,6 +429,26 @@ func Test_Flags_Validate(t *testing.T) {
 			flags: newFlagVarBuilder().withSelfSignedCertKeySize(4096).build(),
 			err:   nil,
 		},
+		{
+			name:  "CARotationGracePeriod < 0",
+			flags: newFlagVarBuilder().withCARotationGracePeriod(-time.Second).build(),
+			err:   ErrInvalidCARotationGracePeriod,
+		},
+		{
+			name:  "CARotationGracePeriod 0",
+			flags: newFlagVarBuilder().withCARotationGracePeriod(0).build(),
+			err:   nil,
+		},
+		{
+			name:  "CertificateProvider builtin",
+			flags: newFlagVarBuilder().withCertificateProvider(CertificateProviderBuiltin).build(),
+			err:   nil,
+		},
+		{
+			name:  "CertificateProvider unknown",
+			flags: newFlagVarBuilder().withCertificateProvider("vault").build(),
+			err:   ErrInvalidCertificateProvider,
+		},
 		{
 			name:  "ManifestRequeueJitterProbability < 0",
 			flags: newFlagVarBuilder().withManifestRequeueJitterProbability(-1).build(),
@@ -379,6 +479,61 @@ func Test_Flags_Validate(t *testing.T) {
 			flags: newFlagVarBuilder().withManifestRequeueJitterPercentage(0.1).build(),
 			err:   nil,
 		},
+		{
+			name:  "ShardCount 0",
+			flags: newFlagVarBuilder().withShardCount(0).build(),
+			err:   ErrInvalidShardCount,
+		},
+		{
+			name:  "ShardCount 3 without leader election",
+			flags: newFlagVarBuilder().withShardCount(3).build(),
+			err:   ErrShardingRequiresLeaderElection,
+		},
+		{
+			name:  "ShardCount 3 with leader election",
+			flags: newFlagVarBuilder().withShardCount(3).withEnabledLeaderElection(true).build(),
+			err:   nil,
+		},
+		{
+			name:  "TracingExporter otlp",
+			flags: newFlagVarBuilder().withTracingExporter("otlp").build(),
+			err:   nil,
+		},
+		{
+			name:  "TracingExporter unknown",
+			flags: newFlagVarBuilder().withTracingExporter("jaeger").build(),
+			err:   ErrInvalidTracingExporter,
+		},
+		{
+			name:  "TracingSampleRatio greater than 1",
+			flags: newFlagVarBuilder().withTracingSampleRatio(1.5).build(),
+			err:   ErrInvalidTracingSampleRatio,
+		},
+		{
+			name:  "MetricsCardinality aggregated",
+			flags: newFlagVarBuilder().withMetricsCardinality("aggregated").build(),
+			err:   nil,
+		},
+		{
+			name:  "StorageVersionMigrationBatchSize 0",
+			flags: newFlagVarBuilder().withStorageVersionMigrationBatchSize(0).build(),
+			err:   ErrInvalidStorageVersionMigrationBatchSize,
+		},
+		{
+			name:  "PurgeDeletionTimeout negative",
+			flags: newFlagVarBuilder().withPurgeDeletionTimeout(-1 * time.Second).build(),
+			err:   ErrInvalidPurgeDeletionTimeout,
+		},
+		{
+			name:  "PurgeReportRetention 0",
+			flags: newFlagVarBuilder().withPurgeReportRetention(0).build(),
+			err:   ErrInvalidPurgeReportRetention,
+		},
+		{
+			name:  "MetricsCardinality unknown",
+			flags: newFlagVarBuilder().withMetricsCardinality("per-shoot").build(),
+			err:   ErrInvalidMetricsCardinality,
+		},
 	}
 
 	for _, tt := range tests {
@@ -413,8 +568,16 @@ func newFlagVarBuilder() *flagVarBuilder {
 		withLeaderElectionRenewDeadline(120 * time.Second).
 		withLeaderElectionLeaseDuration(180 * time.Second).
 		withSelfSignedCertKeySize(4096).
+		withCertificateProvider(CertificateProviderCertManager).
 		withManifestRequeueJitterProbability(0.01).
-		withManifestRequeueJitterPercentage(0.1)
+		withManifestRequeueJitterPercentage(0.1).
+		withShardCount(1).
+		withTracingExporter("none").
+		withTracingSampleRatio(1).
+		withMetricsCardinality("per-kyma").
+		withStorageVersionMigrationBatchSize(500).
+		withPurgeDeletionTimeout(time.Minute).
+		withPurgeReportRetention(DefaultPurgeReportRetention)
 }
 
 func (b *flagVarBuilder) build() FlagVar {
@@ -461,6 +624,16 @@ func (b *flagVarBuilder) withSelfSignedCertKeySize(size int) *flagVarBuilder {
 	return b
 }
 
+func (b *flagVarBuilder) withCARotationGracePeriod(duration time.Duration) *flagVarBuilder {
+	b.flags.CARotationGracePeriod = duration
+	return b
+}
+
+func (b *flagVarBuilder) withCertificateProvider(provider string) *flagVarBuilder {
+	b.flags.CertificateProvider = provider
+	return b
+}
+
 func (b *flagVarBuilder) withManifestRequeueJitterProbability(probability float64) *flagVarBuilder {
 	b.flags.ManifestRequeueJitterProbability = probability
 	return b
@@ -470,3 +643,43 @@ func (b *flagVarBuilder) withManifestRequeueJitterPercentage(percentage float64)
 	b.flags.ManifestRequeueJitterPercentage = percentage
 	return b
 }
+
+func (b *flagVarBuilder) withShardCount(count int) *flagVarBuilder {
+	b.flags.ShardCount = count
+	return b
+}
+
+func (b *flagVarBuilder) withTracingExporter(exporter string) *flagVarBuilder {
+	b.flags.TracingExporter = exporter
+	return b
+}
+
+func (b *flagVarBuilder) withTracingSampleRatio(ratio float64) *flagVarBuilder {
+	b.flags.TracingSampleRatio = ratio
+	return b
+}
+
+func (b *flagVarBuilder) withMetricsCardinality(cardinality string) *flagVarBuilder {
+	b.flags.MetricsCardinality = cardinality
+	return b
+}
+
+func (b *flagVarBuilder) withEnabledLeaderElection(enabled bool) *flagVarBuilder {
+	b.flags.EnableLeaderElection = enabled
+	return b
+}
+
+func (b *flagVarBuilder) withStorageVersionMigrationBatchSize(batchSize int64) *flagVarBuilder {
+	b.flags.StorageVersionMigrationBatchSize = batchSize
+	return b
+}
+
+func (b *flagVarBuilder) withPurgeDeletionTimeout(timeout time.Duration) *flagVarBuilder {
+	b.flags.PurgeDeletionTimeout = timeout
+	return b
+}
+
+func (b *flagVarBuilder) withPurgeReportRetention(retention time.Duration) *flagVarBuilder {
+	b.flags.PurgeReportRetention = retention
+	return b
+}
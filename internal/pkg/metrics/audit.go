@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	MetricAuditRecordsDropped = "lifecycle_mgr_audit_records_dropped_total"
+	dropReasonLabel           = "reason"
+)
+
+type AuditMetrics struct {
+	recordsDropped *prometheus.CounterVec
+}
+
+func NewAuditMetrics() *AuditMetrics {
+	auditMetrics := &AuditMetrics{
+		recordsDropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: MetricAuditRecordsDropped,
+			Help: "Indicates the number of audit records which were not delivered to the audit webhook by reason",
+		}, []string{dropReasonLabel}),
+	}
+	ctrlmetrics.Registry.MustRegister(auditMetrics.recordsDropped)
+	return auditMetrics
+}
+
+func (m *AuditMetrics) RecordDropped(reason string) {
+	m.recordsDropped.WithLabelValues(reason).Inc()
+}
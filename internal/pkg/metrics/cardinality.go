@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Cardinality selects whether the metrics about Kymas carry per-Kyma labels.
+type Cardinality string
+
+const (
+	// CardinalityPerKyma labels the metrics with the name of the Kyma, and the state gauges also with its shoot and
+	// instance ID.
+	CardinalityPerKyma Cardinality = "per-kyma"
+	// CardinalityAggregated drops the per-Kyma labels, the state gauges then count the Kymas and modules per state.
+	CardinalityAggregated Cardinality = "aggregated"
+)
+
+// withKymaLabel returns the given labels, followed by the Kyma name label in the per-Kyma cardinality.
+func (c Cardinality) withKymaLabel(labels ...string) []string {
+	if c == CardinalityAggregated {
+		return labels
+	}
+	return append(labels, KymaNameLabel)
+}
+
+// addKymaLabel adds the Kyma name to the given labels in the per-Kyma cardinality.
+func (c Cardinality) addKymaLabel(labels prometheus.Labels, kymaName string) prometheus.Labels {
+	if c != CardinalityAggregated {
+		labels[KymaNameLabel] = kymaName
+	}
+	return labels
+}
+
+// stateCounter counts the objects per state in an aggregated state gauge. Each object is counted with the labels
+// of its latest state only.
+type stateCounter struct {
+	mu      sync.Mutex
+	gauge   *prometheus.GaugeVec
+	current map[string]prometheus.Labels
+}
+
+func newStateCounter(gauge *prometheus.GaugeVec) *stateCounter {
+	return &stateCounter{gauge: gauge, current: make(map[string]prometheus.Labels)}
+}
+
+func (s *stateCounter) set(key string, labels prometheus.Labels) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if previous, ok := s.current[key]; ok {
+		if maps.Equal(previous, labels) {
+			return
+		}
+		s.gauge.With(previous).Dec()
+	}
+	s.gauge.With(labels).Inc()
+	s.current[key] = labels
+}
+
+// remove stops counting the objects whose key is the given key, or starts with the given key followed by a slash.
+func (s *stateCounter) remove(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for current, labels := range s.current {
+		if current == key || strings.HasPrefix(current, key+"/") {
+			s.gauge.With(labels).Dec()
+			delete(s.current, current)
+		}
+	}
+}
+
+// kymaNames returns the Kyma names the counted keys start with.
+func (s *stateCounter) kymaNames() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, 0, len(s.current))
+	for key := range s.current {
+		kymaName, _, _ := strings.Cut(key, "/")
+		names = append(names, kymaName)
+	}
+	return names
+}
@@ -10,11 +10,15 @@ import (
 )
 
 const (
-	shootIDLabel    = "shoot"
-	instanceIDLabel = "instance_id"
-	KymaNameLabel   = "kyma_name"
-	stateLabel      = "state"
-	moduleNameLabel = "module_name"
+	shootIDLabel     = "shoot"
+	instanceIDLabel  = "instance_id"
+	KymaNameLabel    = "kyma_name"
+	stateLabel       = "state"
+	moduleNameLabel  = "module_name"
+	criticalityLabel = "criticality"
+	versionLabel     = "version"
+	phaseLabel       = "phase"
+	causeLabel       = "cause"
 )
 
 const (
@@ -22,6 +26,11 @@ const (
 	requeueTypeLabel   = "requeue_type"
 )
 
+const (
+	controllerLabel = "controller"
+	priorityLabel   = "priority"
+)
+
 var (
 	errMissingShootAnnotation = fmt.Errorf("expected annotation '%s' not found", shared.SKRDomainAnnotation)
 	errShootAnnotationNoValue = fmt.Errorf("annotation '%s' has empty value", shared.SKRDomainAnnotation)
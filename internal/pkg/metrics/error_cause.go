@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ErrorCause is the classified cause of a failed reconciliation phase.
+type ErrorCause string
+
+const (
+	ErrorCauseAuth       ErrorCause = "auth"
+	ErrorCauseNetwork    ErrorCause = "network"
+	ErrorCauseConflict   ErrorCause = "conflict"
+	ErrorCauseValidation ErrorCause = "validation"
+	ErrorCauseOther      ErrorCause = "other"
+)
+
+// ClassifyError returns the cause of the given error, based on the API server status and the errors of the network
+// and registry clients it wraps.
+func ClassifyError(err error) ErrorCause {
+	switch {
+	case isAuthError(err):
+		return ErrorCauseAuth
+	case isNetworkError(err):
+		return ErrorCauseNetwork
+	case apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err):
+		return ErrorCauseConflict
+	case apierrors.IsInvalid(err) || apierrors.IsBadRequest(err):
+		return ErrorCauseValidation
+	default:
+		return ErrorCauseOther
+	}
+}
+
+func isAuthError(err error) bool {
+	if apierrors.IsUnauthorized(err) || apierrors.IsForbidden(err) {
+		return true
+	}
+	var registryErr *transport.Error
+	if errors.As(err, &registryErr) {
+		return registryErr.StatusCode == http.StatusUnauthorized || registryErr.StatusCode == http.StatusForbidden
+	}
+	// Fallback for errors which replace the client error, such as the unauthorized error of the server-side apply
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "unauthorized") || strings.Contains(message, "forbidden")
+}
+
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsServiceUnavailable(err)
+}
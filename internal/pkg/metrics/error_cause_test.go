@@ -0,0 +1,94 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
+)
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+	resource := schema.GroupResource{Group: "operator.kyma-project.io", Resource: "manifests"}
+	kind := schema.GroupKind{Group: "operator.kyma-project.io", Kind: "Manifest"}
+	tests := []struct {
+		name string
+		err  error
+		want metrics.ErrorCause
+	}{
+		{
+			name: "unauthorized API request",
+			err:  fmt.Errorf("failed to get kyma: %w", apierrors.NewUnauthorized("token expired")),
+			want: metrics.ErrorCauseAuth,
+		},
+		{
+			name: "forbidden API request",
+			err:  apierrors.NewForbidden(resource, "manifest", errors.New("denied")),
+			want: metrics.ErrorCauseAuth,
+		},
+		{
+			name: "unauthorized registry request",
+			err:  fmt.Errorf("failed to pull layer: %w", &transport.Error{StatusCode: http.StatusUnauthorized}),
+			want: metrics.ErrorCauseAuth,
+		},
+		{
+			name: "unauthorized server-side apply",
+			err:  errors.New("ServerSideApply is unauthorized"),
+			want: metrics.ErrorCauseAuth,
+		},
+		{
+			name: "connection refused",
+			err:  fmt.Errorf("failed to connect: %w", syscall.ECONNREFUSED),
+			want: metrics.ErrorCauseNetwork,
+		},
+		{
+			name: "unknown host",
+			err:  &net.DNSError{Err: "no such host", Name: "skr.example.com", IsNotFound: true},
+			want: metrics.ErrorCauseNetwork,
+		},
+		{
+			name: "deadline exceeded",
+			err:  fmt.Errorf("failed to sync: %w", context.DeadlineExceeded),
+			want: metrics.ErrorCauseNetwork,
+		},
+		{
+			name: "conflict",
+			err:  apierrors.NewConflict(resource, "manifest", errors.New("object has been modified")),
+			want: metrics.ErrorCauseConflict,
+		},
+		{
+			name: "already exists",
+			err:  apierrors.NewAlreadyExists(resource, "manifest"),
+			want: metrics.ErrorCauseConflict,
+		},
+		{
+			name: "invalid object",
+			err: apierrors.NewInvalid(kind, "manifest", field.ErrorList{
+				field.Required(field.NewPath("spec", "install"), ""),
+			}),
+			want: metrics.ErrorCauseValidation,
+		},
+		{
+			name: "other error",
+			err:  errors.New("failed to render manifest"),
+			want: metrics.ErrorCauseOther,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testCase.want, metrics.ClassifyError(testCase.err))
+		})
+	}
+}
@@ -3,6 +3,8 @@ package metrics
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/prometheus/client_golang/prometheus"
@@ -16,17 +18,42 @@ import (
 )
 
 const (
-	MetricKymaState     = "lifecycle_mgr_kyma_state"
-	MetricModuleState   = "lifecycle_mgr_module_state"
-	MetricRequeueReason = "lifecycle_mgr_requeue_reason_total"
+	MetricKymaState         = "lifecycle_mgr_kyma_state"
+	MetricModuleState       = "lifecycle_mgr_module_state"
+	MetricRequeueReason     = "lifecycle_mgr_requeue_reason_total"
+	MetricModuleTimeToReady = "lifecycle_mgr_module_time_to_ready_seconds"
+	MetricKymaPhaseDuration = "lifecycle_mgr_kyma_phase_duration_seconds"
 )
 
+const kymaControllerName = "kyma"
+
 type KymaMetrics struct {
-	KymaStateGauge   *prometheus.GaugeVec
-	moduleStateGauge *prometheus.GaugeVec
+	KymaStateGauge             *prometheus.GaugeVec
+	moduleStateGauge           *prometheus.GaugeVec
+	moduleTimeToReadyHistogram *prometheus.HistogramVec
+	phaseDurationHistogram     *prometheus.HistogramVec
+	// kymaStateCounter and moduleStateCounter count the Kymas and modules per state in the aggregated cardinality.
+	kymaStateCounter   *stateCounter
+	moduleStateCounter *stateCounter
 	*SharedMetrics
 }
 
+// moduleTimeToReadyBuckets range from 5 seconds to about 1.5 hours.
+var moduleTimeToReadyBuckets = prometheus.ExponentialBuckets(5, 2, 11) //nolint:mnd // bucket layout
+
+// phaseDurationBuckets range from 10 milliseconds to about 3 minutes.
+var phaseDurationBuckets = prometheus.ExponentialBuckets(0.01, 2, 15) //nolint:mnd // bucket layout
+
+// KymaPhase is a phase of the Kyma reconciliation whose duration and errors are recorded.
+type KymaPhase string
+
+const (
+	KymaPhaseTemplateLookup KymaPhase = "template_lookup"
+	KymaPhaseCatalogSync    KymaPhase = "catalog_sync"
+	KymaPhaseCrdSync        KymaPhase = "crd_sync"
+	KymaPhaseWebhookInstall KymaPhase = "webhook_install"
+)
+
 type KymaRequeueReason string
 
 const (
@@ -49,6 +76,7 @@ const (
 	RemoteModuleCatalogDeletion              KymaRequeueReason = "remote_module_catalog_deletion"
 	CleanupManifestCrs                       KymaRequeueReason = "manifest_crs_cleanup"
 	KymaDeletion                             KymaRequeueReason = "kyma_deletion"
+	KymaDeletionBlocked                      KymaRequeueReason = "kyma_deletion_blocked"
 	KymaRetrieval                            KymaRequeueReason = "kyma_retrieval"
 	KymaUnauthorized                         KymaRequeueReason = "kyma_unauthorized"
 )
@@ -64,15 +92,54 @@ func NewKymaMetrics(sharedMetrics *SharedMetrics) *KymaMetrics {
 		moduleStateGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: MetricModuleState,
 			Help: "Indicates the Status.state for modules of Kyma",
-		}, []string{moduleNameLabel, KymaNameLabel, stateLabel, shootIDLabel, instanceIDLabel}),
+		}, []string{moduleNameLabel, KymaNameLabel, stateLabel, shootIDLabel, instanceIDLabel, criticalityLabel}),
+
+		moduleTimeToReadyHistogram: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    MetricModuleTimeToReady,
+			Help:    "Indicates the time it took for modules of Kyma to become Ready after their installation or upgrade",
+			Buckets: moduleTimeToReadyBuckets,
+		}, []string{moduleNameLabel, versionLabel}),
+
+		phaseDurationHistogram: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    MetricKymaPhaseDuration,
+			Help:    "Indicates the duration of the phases of the Kyma reconciliation in seconds",
+			Buckets: phaseDurationBuckets,
+		}, sharedMetrics.cardinality.withKymaLabel(phaseLabel)),
+	}
+	if sharedMetrics.aggregated() {
+		kymaMetrics.KymaStateGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricKymaState,
+			Help: "Indicates the number of Kyma objects per Status.state",
+		}, []string{stateLabel})
+		kymaMetrics.moduleStateGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricModuleState,
+			Help: "Indicates the number of modules of Kymas per Status.state",
+		}, []string{moduleNameLabel, stateLabel, criticalityLabel})
+		kymaMetrics.kymaStateCounter = newStateCounter(kymaMetrics.KymaStateGauge)
+		kymaMetrics.moduleStateCounter = newStateCounter(kymaMetrics.moduleStateGauge)
 	}
 	ctrlmetrics.Registry.MustRegister(kymaMetrics.KymaStateGauge)
 	ctrlmetrics.Registry.MustRegister(kymaMetrics.moduleStateGauge)
+	ctrlmetrics.Registry.MustRegister(kymaMetrics.moduleTimeToReadyHistogram)
+	ctrlmetrics.Registry.MustRegister(kymaMetrics.phaseDurationHistogram)
 	return kymaMetrics
 }
 
 // UpdateAll sets both metrics 'lifecycle_mgr_kyma_state' and 'lifecycle_mgr_module_state' to new states.
+// In the aggregated cardinality, the Kyma and its modules are counted with their new states instead.
 func (k *KymaMetrics) UpdateAll(kyma *v1beta2.Kyma) error {
+	if k.aggregated() {
+		k.kymaStateCounter.set(kyma.Name, prometheus.Labels{stateLabel: string(kyma.Status.State)})
+		for _, moduleStatus := range kyma.Status.Modules {
+			k.moduleStateCounter.set(kyma.Name+"/"+moduleStatus.Name, prometheus.Labels{
+				moduleNameLabel:  moduleStatus.Name,
+				stateLabel:       string(moduleStatus.State),
+				criticalityLabel: string(criticalityOf(&moduleStatus)),
+			})
+		}
+		return nil
+	}
+
 	shootID, err := ExtractShootID(kyma)
 	if err != nil {
 		return fmt.Errorf("%w: %w", errMetric, err)
@@ -85,30 +152,74 @@ func (k *KymaMetrics) UpdateAll(kyma *v1beta2.Kyma) error {
 	k.setKymaStateGauge(kyma.Status.State, kyma.Name, shootID, instanceID)
 
 	for _, moduleStatus := range kyma.Status.Modules {
-		k.setModuleStateGauge(moduleStatus.State, moduleStatus.Name, kyma.Name, shootID, instanceID)
+		k.setModuleStateGauge(moduleStatus.State, moduleStatus.Name, kyma.Name, shootID, instanceID,
+			criticalityOf(&moduleStatus))
 	}
 	return nil
 }
 
-// CleanupMetrics deletes all 'lifecycle_mgr_kyma_state',
-// 'lifecycle_mgr_module_state' metrics for the matching Kyma.
+// CleanupMetrics deletes all 'lifecycle_mgr_kyma_state', 'lifecycle_mgr_module_state',
+// 'lifecycle_mgr_kyma_phase_duration_seconds' and 'lifecycle_mgr_reconcile_errors_total' metrics for the matching Kyma.
 func (k *KymaMetrics) CleanupMetrics(kymaName string) {
 	k.KymaStateGauge.DeletePartialMatch(prometheus.Labels{
 		KymaNameLabel: kymaName,
 	})
-	k.moduleStateGauge.DeletePartialMatch(prometheus.Labels{
-		KymaNameLabel: kymaName,
-	})
+	k.cleanupKymaSeries(kymaName)
+}
+
+// cleanupKymaSeries deletes the metrics for the matching Kyma except 'lifecycle_mgr_kyma_state' ones.
+func (k *KymaMetrics) cleanupKymaSeries(kymaName string) {
+	if k.aggregated() {
+		k.kymaStateCounter.remove(kymaName)
+		k.moduleStateCounter.remove(kymaName)
+		return
+	}
+	if k.moduleStateGauge != nil {
+		k.moduleStateGauge.DeletePartialMatch(prometheus.Labels{
+			KymaNameLabel: kymaName,
+		})
+	}
+	if k.phaseDurationHistogram != nil {
+		k.phaseDurationHistogram.DeletePartialMatch(prometheus.Labels{
+			KymaNameLabel: kymaName,
+		})
+	}
+	k.cleanupReconcileErrors(kymaName)
 }
 
 // RemoveModuleStateMetrics deletes all 'lifecycle_mgr_module_state' metrics for the matching module.
 func (k *KymaMetrics) RemoveModuleStateMetrics(kymaName, moduleName string) {
+	if k.aggregated() {
+		k.moduleStateCounter.remove(kymaName + "/" + moduleName)
+		return
+	}
 	k.moduleStateGauge.DeletePartialMatch(prometheus.Labels{
 		moduleNameLabel: moduleName,
 		KymaNameLabel:   kymaName,
 	})
 }
 
+// ObservePhase records the duration of the phase of the reconciliation of the matching Kyma in
+// 'lifecycle_mgr_kyma_phase_duration_seconds', and counts the error of the phase, if any,
+// in 'lifecycle_mgr_reconcile_errors_total'.
+func (k *KymaMetrics) ObservePhase(kymaName string, phase KymaPhase, duration time.Duration, err error) {
+	k.phaseDurationHistogram.With(k.cardinality.addKymaLabel(prometheus.Labels{
+		phaseLabel: string(phase),
+	}, kymaName)).Observe(duration.Seconds())
+	if err != nil {
+		k.recordReconcileError(kymaControllerName, string(phase), kymaName, err)
+	}
+}
+
+// ObserveModuleTimeToReady records in 'lifecycle_mgr_module_time_to_ready_seconds' the time it took for the module
+// in the given version to become Ready after its installation or upgrade.
+func (k *KymaMetrics) ObserveModuleTimeToReady(moduleName, version string, timeToReady time.Duration) {
+	k.moduleTimeToReadyHistogram.With(prometheus.Labels{
+		moduleNameLabel: moduleName,
+		versionLabel:    version,
+	}).Observe(timeToReady.Seconds())
+}
+
 func (k *KymaMetrics) setKymaStateGauge(newState shared.State, kymaName, shootID, instanceID string) {
 	states := shared.AllStates()
 	for _, state := range states {
@@ -122,20 +233,43 @@ func (k *KymaMetrics) setKymaStateGauge(newState shared.State, kymaName, shootID
 	}
 }
 
-func (k *KymaMetrics) setModuleStateGauge(newState shared.State, moduleName, kymaName, shootID, instanceID string) {
+func (k *KymaMetrics) setModuleStateGauge(newState shared.State, moduleName, kymaName, shootID, instanceID string,
+	criticality v1beta2.ModuleCriticality,
+) {
+	// a module changing its criticality must not keep reporting its state with the previous criticality
+	for _, otherCriticality := range []v1beta2.ModuleCriticality{
+		v1beta2.ModuleCriticalityCritical, v1beta2.ModuleCriticalityOptional,
+	} {
+		if otherCriticality != criticality {
+			k.moduleStateGauge.DeletePartialMatch(prometheus.Labels{
+				moduleNameLabel:  moduleName,
+				KymaNameLabel:    kymaName,
+				criticalityLabel: string(otherCriticality),
+			})
+		}
+	}
+
 	states := shared.AllStates()
 	for _, state := range states {
 		newValue := calcStateValue(state, newState)
 		k.moduleStateGauge.With(prometheus.Labels{
-			moduleNameLabel: moduleName,
-			KymaNameLabel:   kymaName,
-			shootIDLabel:    shootID,
-			instanceIDLabel: instanceID,
-			stateLabel:      string(state),
+			moduleNameLabel:  moduleName,
+			KymaNameLabel:    kymaName,
+			shootIDLabel:     shootID,
+			instanceIDLabel:  instanceID,
+			stateLabel:       string(state),
+			criticalityLabel: string(criticality),
 		}).Set(newValue)
 	}
 }
 
+func criticalityOf(moduleStatus *v1beta2.ModuleStatus) v1beta2.ModuleCriticality {
+	if moduleStatus.IsOptional() {
+		return v1beta2.ModuleCriticalityOptional
+	}
+	return v1beta2.ModuleCriticalityCritical
+}
+
 func calcStateValue(state, newState shared.State) float64 {
 	if state == newState {
 		return 1
@@ -148,32 +282,30 @@ func (k *KymaMetrics) RecordRequeueReason(kymaRequeueReason KymaRequeueReason, r
 }
 
 func (k *KymaMetrics) CleanupNonExistingKymaCrsMetrics(ctx context.Context, kcpClient client.Client) error {
-	currentLifecycleManagerMetrics, err := FetchLifecycleManagerMetrics()
+	currentLifecycleManagerMetrics, err := FetchLifecycleManagerMetrics(MetricKymaState, MetricModuleState,
+		MetricKymaPhaseDuration, MetricReconcileErrors)
 	if err != nil {
 		return err
 	}
-
-	if len(currentLifecycleManagerMetrics) == 0 {
-		return nil
+	currentKymaNames := make([]string, 0, len(currentLifecycleManagerMetrics))
+	for _, m := range currentLifecycleManagerMetrics {
+		currentKymaNames = append(currentKymaNames, getKymaNameFromLabels(m))
+	}
+	if k.aggregated() {
+		currentKymaNames = append(currentKymaNames, k.kymaStateCounter.kymaNames()...)
+		currentKymaNames = append(currentKymaNames, k.moduleStateCounter.kymaNames()...)
 	}
 
-	kymaCrsList := &v1beta2.KymaList{}
-	err = kcpClient.List(ctx, kymaCrsList)
+	nonExistingKymaNames, err := getNonExistingKymaNames(ctx, kcpClient, currentKymaNames)
 	if err != nil {
-		return fmt.Errorf("failed to fetch Kyma CRs, %w", err)
+		return err
 	}
-	kymaNames := getKymaNames(kymaCrsList)
-	for _, m := range currentLifecycleManagerMetrics {
-		currentKymaName := getKymaNameFromLabels(m)
-		if currentKymaName == "" {
-			continue
-		}
-		if _, exists := kymaNames[currentKymaName]; !exists {
-			logs.FromContext(ctx).Info("Deleting a metric for non-existing Kyma: " + currentKymaName)
-			k.KymaStateGauge.DeletePartialMatch(prometheus.Labels{
-				KymaNameLabel: currentKymaName,
-			})
-		}
+	for _, kymaName := range nonExistingKymaNames {
+		logs.FromContext(ctx).Info("Deleting a metric for non-existing Kyma: " + kymaName)
+		k.KymaStateGauge.DeletePartialMatch(prometheus.Labels{
+			KymaNameLabel: kymaName,
+		})
+		k.cleanupKymaSeries(kymaName)
 	}
 
 	logs.FromContext(ctx).Info("Finished running the metrics cleanup job")
@@ -181,19 +313,40 @@ func (k *KymaMetrics) CleanupNonExistingKymaCrsMetrics(ctx context.Context, kcpC
 	return nil
 }
 
-func FetchLifecycleManagerMetrics() ([]*prometheusclient.Metric, error) {
+// FetchLifecycleManagerMetrics returns the current series of the metrics with the given names.
+func FetchLifecycleManagerMetrics(metricNames ...string) ([]*prometheusclient.Metric, error) {
 	currentMetrics, err := ctrlmetrics.Registry.Gather()
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch current kyma metrics, %w", err)
 	}
 
+	var result []*prometheusclient.Metric
 	for _, metric := range currentMetrics {
-		if metric.GetName() == MetricKymaState {
-			return metric.GetMetric(), nil
+		if slices.Contains(metricNames, metric.GetName()) {
+			result = append(result, metric.GetMetric()...)
 		}
 	}
 
-	return nil, nil
+	return result, nil
+}
+
+// getNonExistingKymaNames returns the given Kyma names for which no Kyma CR exists, without duplicates.
+func getNonExistingKymaNames(ctx context.Context, kcpClient client.Client, currentKymaNames []string,
+) ([]string, error) {
+	currentKymaNames = slices.DeleteFunc(currentKymaNames, func(name string) bool { return name == "" })
+	if len(currentKymaNames) == 0 {
+		return nil, nil
+	}
+
+	kymaCrsList := &v1beta2.KymaList{}
+	if err := kcpClient.List(ctx, kymaCrsList); err != nil {
+		return nil, fmt.Errorf("failed to fetch Kyma CRs, %w", err)
+	}
+	kymaNames := getKymaNames(kymaCrsList)
+	slices.Sort(currentKymaNames)
+	return slices.DeleteFunc(slices.Compact(currentKymaNames), func(name string) bool {
+		return kymaNames[name]
+	}), nil
 }
 
 func getKymaNameFromLabels(metric *prometheusclient.Metric) string {
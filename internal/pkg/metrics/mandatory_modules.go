@@ -8,13 +8,15 @@ import (
 )
 
 const (
-	MetricMandatoryTemplateCount = "lifecycle_mgr_mandatory_modules"
-	MetricMandatoryModuleState   = "lifecycle_mgr_mandatory_module_state"
+	MetricMandatoryTemplateCount       = "lifecycle_mgr_mandatory_modules"
+	MetricMandatoryModuleState         = "lifecycle_mgr_mandatory_module_state"
+	MetricMandatoryModuleRolloutErrors = "lifecycle_mgr_mandatory_module_rollout_errors_total"
 )
 
 type MandatoryModulesMetrics struct {
 	mandatoryModuleTemplatesCounter prometheus.Gauge
 	moduleStateGauge                *prometheus.GaugeVec
+	rolloutErrorsCounter            *prometheus.CounterVec
 }
 
 func NewMandatoryModulesMetrics() *MandatoryModulesMetrics {
@@ -27,9 +29,14 @@ func NewMandatoryModulesMetrics() *MandatoryModulesMetrics {
 			Name: MetricMandatoryModuleState,
 			Help: "Indicates the Status.state for mandatory modules of Kyma",
 		}, []string{moduleNameLabel, KymaNameLabel, stateLabel}),
+		rolloutErrorsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: MetricMandatoryModuleRolloutErrors,
+			Help: "Indicates the number of mandatory modules which could not be resolved for a Kyma",
+		}, []string{moduleNameLabel, KymaNameLabel}),
 	}
 	ctrlmetrics.Registry.MustRegister(metrics.mandatoryModuleTemplatesCounter)
 	ctrlmetrics.Registry.MustRegister(metrics.moduleStateGauge)
+	ctrlmetrics.Registry.MustRegister(metrics.rolloutErrorsCounter)
 	return metrics
 }
 
@@ -37,6 +44,15 @@ func (m *MandatoryModulesMetrics) RecordMandatoryTemplatesCount(count int) {
 	m.mandatoryModuleTemplatesCounter.Set(float64(count))
 }
 
+// RecordMandatoryModuleRolloutError counts a mandatory module which could not be resolved for the Kyma,
+// e.g. because the rollout channel is not assigned in the ModuleReleaseMeta.
+func (m *MandatoryModulesMetrics) RecordMandatoryModuleRolloutError(kymaName, moduleName string) {
+	m.rolloutErrorsCounter.With(prometheus.Labels{
+		KymaNameLabel:   kymaName,
+		moduleNameLabel: moduleName,
+	}).Inc()
+}
+
 func (m *MandatoryModulesMetrics) RecordMandatoryModuleState(kymaName, moduleName string, newState shared.State) {
 	states := shared.AllStates()
 	for _, state := range states {
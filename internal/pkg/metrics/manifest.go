@@ -1,9 +1,12 @@
 package metrics
 
 import (
+	"context"
 	"time"
 
+	"github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/kyma-project/lifecycle-manager/pkg/queue"
@@ -13,6 +16,7 @@ type ManifestRequeueReason string
 
 const (
 	MetricManifestDuration                                     = "reconcile_duration_seconds"
+	MetricManifestPhaseDuration                                = "lifecycle_mgr_manifest_phase_duration_seconds"
 	ManifestNameLabel                                          = "manifest_name"
 	ManifestRetrieval                    ManifestRequeueReason = "manifest_retrieval"
 	ManifestInit                         ManifestRequeueReason = "manifest_initialize"
@@ -33,11 +37,28 @@ const (
 	ManifestReconcileFinished            ManifestRequeueReason = "manifest_reconcile_finished"
 	ManifestUnmanagedUpdate              ManifestRequeueReason = "manifest_unmanaged_update"
 	ManifestResourcesLabelRemoval        ManifestRequeueReason = "manifest_labels_removal"
+	ManifestReadoption                   ManifestRequeueReason = "manifest_readoption"
+	ManifestBlueGreenUpgrade             ManifestRequeueReason = "manifest_blue_green_upgrade"
+	ManifestUpgradeHooks                 ManifestRequeueReason = "manifest_upgrade_hooks"
+)
+
+const manifestControllerName = "manifest"
+
+// ManifestPhase is a phase of the Manifest reconciliation whose duration and errors are recorded.
+type ManifestPhase string
+
+const (
+	ManifestPhaseSpecResolve ManifestPhase = "spec_resolve"
+	ManifestPhaseRender      ManifestPhase = "render"
+	ManifestPhasePrune       ManifestPhase = "prune"
+	ManifestPhaseSSA         ManifestPhase = "ssa"
+	ManifestPhaseStateCheck  ManifestPhase = "state_check"
 )
 
 type ManifestMetrics struct {
 	*SharedMetrics
-	ManifestDurationGauge *prometheus.GaugeVec
+	ManifestDurationGauge  *prometheus.GaugeVec
+	phaseDurationHistogram *prometheus.HistogramVec
 }
 
 func NewManifestMetrics(sharedMetrics *SharedMetrics) *ManifestMetrics {
@@ -47,9 +68,15 @@ func NewManifestMetrics(sharedMetrics *SharedMetrics) *ManifestMetrics {
 			Name: MetricManifestDuration,
 			Help: "Indicates the duration for manifest reconciliation in seconds",
 		}, []string{ManifestNameLabel}),
+		phaseDurationHistogram: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    MetricManifestPhaseDuration,
+			Help:    "Indicates the duration of the phases of the Manifest reconciliation in seconds",
+			Buckets: phaseDurationBuckets,
+		}, sharedMetrics.cardinality.withKymaLabel(phaseLabel, moduleNameLabel)),
 	}
 
 	ctrlmetrics.Registry.MustRegister(metrics.ManifestDurationGauge)
+	ctrlmetrics.Registry.MustRegister(metrics.phaseDurationHistogram)
 	return metrics
 }
 
@@ -72,3 +99,55 @@ func (k *ManifestMetrics) CleanupMetrics(manifestName string) {
 		ManifestNameLabel: manifestName,
 	})
 }
+
+// ObservePhase records the duration of the phase of the reconciliation of the Manifest of the matching module in
+// 'lifecycle_mgr_manifest_phase_duration_seconds', and counts the error of the phase, if any,
+// in 'lifecycle_mgr_reconcile_errors_total'.
+func (k *ManifestMetrics) ObservePhase(kymaName, moduleName string, phase ManifestPhase, duration time.Duration,
+	err error,
+) {
+	k.phaseDurationHistogram.With(k.cardinality.addKymaLabel(prometheus.Labels{
+		phaseLabel:      string(phase),
+		moduleNameLabel: moduleName,
+	}, kymaName)).Observe(duration.Seconds())
+	if err != nil {
+		k.recordReconcileError(manifestControllerName, string(phase), kymaName, err)
+	}
+}
+
+// RemovePhaseMetrics deletes all 'lifecycle_mgr_manifest_phase_duration_seconds' metrics for the matching module.
+// In the aggregated cardinality, the metrics are kept as they are shared by the modules of all Kymas.
+func (k *ManifestMetrics) RemovePhaseMetrics(kymaName, moduleName string) {
+	if k.aggregated() {
+		return
+	}
+	k.phaseDurationHistogram.DeletePartialMatch(prometheus.Labels{
+		moduleNameLabel: moduleName,
+		KymaNameLabel:   kymaName,
+	})
+}
+
+// CleanupNonExistingKymaCrsMetrics deletes all 'lifecycle_mgr_manifest_phase_duration_seconds' metrics
+// for the Kymas which no longer exist.
+func (k *ManifestMetrics) CleanupNonExistingKymaCrsMetrics(ctx context.Context, kcpClient client.Client) error {
+	currentLifecycleManagerMetrics, err := FetchLifecycleManagerMetrics(MetricManifestPhaseDuration)
+	if err != nil {
+		return err
+	}
+	currentKymaNames := make([]string, 0, len(currentLifecycleManagerMetrics))
+	for _, m := range currentLifecycleManagerMetrics {
+		currentKymaNames = append(currentKymaNames, getKymaNameFromLabels(m))
+	}
+
+	nonExistingKymaNames, err := getNonExistingKymaNames(ctx, kcpClient, currentKymaNames)
+	if err != nil {
+		return err
+	}
+	for _, kymaName := range nonExistingKymaNames {
+		logs.FromContext(ctx).Info("Deleting a manifest metric for non-existing Kyma: " + kymaName)
+		k.phaseDurationHistogram.DeletePartialMatch(prometheus.Labels{
+			KymaNameLabel: kymaName,
+		})
+	}
+	return nil
+}
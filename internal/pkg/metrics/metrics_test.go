@@ -24,6 +24,21 @@ func Test_ConstantMetricNames(t *testing.T) {
 			constValue:    MetricModuleState,
 			expectedValue: "lifecycle_mgr_module_state",
 		},
+		{
+			constName:     "MetricModuleTimeToReady",
+			constValue:    MetricModuleTimeToReady,
+			expectedValue: "lifecycle_mgr_module_time_to_ready_seconds",
+		},
+		{
+			constName:     "MetricWorkQueueDepth",
+			constValue:    MetricWorkQueueDepth,
+			expectedValue: "lifecycle_mgr_workqueue_depth",
+		},
+		{
+			constName:     "MetricWorkQueueQueueDuration",
+			constValue:    MetricWorkQueueQueueDuration,
+			expectedValue: "lifecycle_mgr_workqueue_queue_duration_seconds",
+		},
 		{
 			constName:     "MetricPurgeTime",
 			constValue:    MetricPurgeTime,
@@ -44,6 +59,16 @@ func Test_ConstantMetricNames(t *testing.T) {
 			constValue:    SelfSignedCertNotRenewMetrics,
 			expectedValue: "lifecycle_mgr_self_signed_cert_not_renew",
 		},
+		{
+			constName:     "SelfSignedCertRotationPendingMetrics",
+			constValue:    SelfSignedCertRotationPendingMetrics,
+			expectedValue: "lifecycle_mgr_self_signed_cert_rotation_pending",
+		},
+		{
+			constName:     "GatewaySecretCARotationMetrics",
+			constValue:    GatewaySecretCARotationMetrics,
+			expectedValue: "lifecycle_mgr_gateway_secret_ca_rotation_in_progress",
+		},
 		{
 			constName:     "MetricManifestDuration",
 			constValue:    MetricManifestDuration,
@@ -59,6 +84,26 @@ func Test_ConstantMetricNames(t *testing.T) {
 			constValue:    MetricMandatoryModuleState,
 			expectedValue: "lifecycle_mgr_mandatory_module_state",
 		},
+		{
+			constName:     "MetricMandatoryModuleRolloutErrors",
+			constValue:    MetricMandatoryModuleRolloutErrors,
+			expectedValue: "lifecycle_mgr_mandatory_module_rollout_errors_total",
+		},
+		{
+			constName:     "MetricKymaPhaseDuration",
+			constValue:    MetricKymaPhaseDuration,
+			expectedValue: "lifecycle_mgr_kyma_phase_duration_seconds",
+		},
+		{
+			constName:     "MetricManifestPhaseDuration",
+			constValue:    MetricManifestPhaseDuration,
+			expectedValue: "lifecycle_mgr_manifest_phase_duration_seconds",
+		},
+		{
+			constName:     "MetricReconcileErrors",
+			constValue:    MetricReconcileErrors,
+			expectedValue: "lifecycle_mgr_reconcile_errors_total",
+		},
 	}
 	for _, testcase := range tests {
 		testName := fmt.Sprintf("const %s has correct value", testcase.constName)
@@ -15,9 +15,15 @@ const (
 	MetricPurgeTime                     = "lifecycle_mgr_purgectrl_time"
 	MetricPurgeRequests                 = "lifecycle_mgr_purgectrl_requests_total"
 	MetricPurgeError                    = "lifecycle_mgr_purgectrl_error"
+	MetricPurgedResources               = "lifecycle_mgr_purgectrl_resources_total"
 	errorReasonLabel                    = "err_reason"
+	outcomeLabel                        = "outcome"
+	OutcomeDeleted                      = "deleted"
+	OutcomeForceFinalized               = "force_finalized"
+	OutcomeRemaining                    = "remaining"
 	ErrPurgeFinalizerRemoval PurgeError = "PurgeFinalizerRemovalError"
 	ErrCleanup               PurgeError = "CleanupError"
+	ErrPurgeReport           PurgeError = "PurgeReportError"
 )
 
 type PurgeError string
@@ -26,6 +32,7 @@ type PurgeMetrics struct {
 	purgeTimeGauge       prometheus.Gauge
 	purgeRequestsCounter prometheus.Counter
 	purgeErrorGauge      *prometheus.GaugeVec
+	purgedResources      *prometheus.CounterVec
 }
 
 func NewPurgeMetrics() *PurgeMetrics {
@@ -42,10 +49,15 @@ func NewPurgeMetrics() *PurgeMetrics {
 			Name: MetricPurgeError,
 			Help: "Indicates purge errors",
 		}, []string{KymaNameLabel, shootIDLabel, instanceIDLabel, errorReasonLabel}),
+		purgedResources: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: MetricPurgedResources,
+			Help: "Indicates the number of purged resources by outcome",
+		}, []string{outcomeLabel}),
 	}
 	ctrlmetrics.Registry.MustRegister(purgeMetrics.purgeTimeGauge)
 	ctrlmetrics.Registry.MustRegister(purgeMetrics.purgeRequestsCounter)
 	ctrlmetrics.Registry.MustRegister(purgeMetrics.purgeErrorGauge)
+	ctrlmetrics.Registry.MustRegister(purgeMetrics.purgedResources)
 	return purgeMetrics
 }
 
@@ -57,6 +69,14 @@ func (p *PurgeMetrics) UpdatePurgeTime(duration time.Duration) {
 	p.purgeTimeGauge.Set(duration.Seconds())
 }
 
+// UpdatePurgedResources counts the resources of a completed purge by whether they were deleted, had their finalizers
+// removed, or remain in the cluster.
+func (p *PurgeMetrics) UpdatePurgedResources(deleted, forceFinalized, remaining int) {
+	p.purgedResources.WithLabelValues(OutcomeDeleted).Add(float64(deleted))
+	p.purgedResources.WithLabelValues(OutcomeForceFinalized).Add(float64(forceFinalized))
+	p.purgedResources.WithLabelValues(OutcomeRemaining).Add(float64(remaining))
+}
+
 func (p *PurgeMetrics) SetPurgeError(ctx context.Context, kyma *v1beta2.Kyma, purgeError PurgeError) {
 	shootID, err := ExtractShootID(kyma)
 	if err != nil {
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	MetricWorkQueueDepth         = "lifecycle_mgr_workqueue_depth"
+	MetricWorkQueueQueueDuration = "lifecycle_mgr_workqueue_queue_duration_seconds"
+)
+
+// queueDurationBuckets range from 10 milliseconds to about 1.5 hours.
+var queueDurationBuckets = prometheus.ExponentialBuckets(0.01, 4, 10) //nolint:mnd // bucket layout
+
+type QueueMetrics struct {
+	depthGauge             *prometheus.GaugeVec
+	queueDurationHistogram *prometheus.HistogramVec
+}
+
+func NewQueueMetrics() *QueueMetrics {
+	metrics := &QueueMetrics{
+		depthGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricWorkQueueDepth,
+			Help: "Indicates the number of requests per priority which are ready to be processed by a controller",
+		}, []string{controllerLabel, priorityLabel}),
+		queueDurationHistogram: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    MetricWorkQueueQueueDuration,
+			Help:    "Indicates how long requests per priority wait in the queue of a controller before being processed",
+			Buckets: queueDurationBuckets,
+		}, []string{controllerLabel, priorityLabel}),
+	}
+	ctrlmetrics.Registry.MustRegister(metrics.depthGauge, metrics.queueDurationHistogram)
+	return metrics
+}
+
+func (m *QueueMetrics) SetQueueDepth(controllerName, priority string, depth int) {
+	m.depthGauge.WithLabelValues(controllerName, priority).Set(float64(depth))
+}
+
+func (m *QueueMetrics) ObserveQueueDuration(controllerName, priority string, duration time.Duration) {
+	m.queueDurationHistogram.WithLabelValues(controllerName, priority).Observe(duration.Seconds())
+}
@@ -5,17 +5,45 @@ import (
 	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
+const MetricReconcileErrors = "lifecycle_mgr_reconcile_errors_total"
+
 type SharedMetrics struct {
-	requeueReasonCounter *prometheus.CounterVec
+	cardinality           Cardinality
+	requeueReasonCounter  *prometheus.CounterVec
+	reconcileErrorCounter *prometheus.CounterVec
 }
 
-func NewSharedMetrics() *SharedMetrics {
+func NewSharedMetrics(cardinality Cardinality) *SharedMetrics {
 	metrics := &SharedMetrics{
+		cardinality: cardinality,
 		requeueReasonCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: MetricRequeueReason,
 			Help: "Indicates the reason for requeue",
 		}, []string{requeueReasonLabel, requeueTypeLabel}),
+		reconcileErrorCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: MetricReconcileErrors,
+			Help: "Indicates the number of failed reconciliation phases by controller, phase and classified cause",
+		}, cardinality.withKymaLabel(controllerLabel, phaseLabel, causeLabel)),
 	}
-	ctrlmetrics.Registry.MustRegister(metrics.requeueReasonCounter)
+	ctrlmetrics.Registry.MustRegister(metrics.requeueReasonCounter, metrics.reconcileErrorCounter)
 	return metrics
 }
+
+func (s *SharedMetrics) recordReconcileError(controllerName, phase, kymaName string, err error) {
+	s.reconcileErrorCounter.With(s.cardinality.addKymaLabel(prometheus.Labels{
+		controllerLabel: controllerName,
+		phaseLabel:      phase,
+		causeLabel:      string(ClassifyError(err)),
+	}, kymaName)).Inc()
+}
+
+func (s *SharedMetrics) cleanupReconcileErrors(kymaName string) {
+	if s == nil || s.aggregated() {
+		return
+	}
+	s.reconcileErrorCounter.DeletePartialMatch(prometheus.Labels{KymaNameLabel: kymaName})
+}
+
+func (s *SharedMetrics) aggregated() bool {
+	return s != nil && s.cardinality == CardinalityAggregated
+}
@@ -7,11 +7,15 @@ import (
 )
 
 const (
-	SelfSignedCertNotRenewMetrics = "lifecycle_mgr_self_signed_cert_not_renew"
+	SelfSignedCertNotRenewMetrics        = "lifecycle_mgr_self_signed_cert_not_renew"
+	SelfSignedCertRotationPendingMetrics = "lifecycle_mgr_self_signed_cert_rotation_pending"
+	GatewaySecretCARotationMetrics       = "lifecycle_mgr_gateway_secret_ca_rotation_in_progress"
 )
 
 type WatcherMetrics struct {
-	certNotRenewGauge *prometheus.GaugeVec
+	certNotRenewGauge        *prometheus.GaugeVec
+	certRotationPendingGauge *prometheus.GaugeVec
+	caRotationGauge          prometheus.Gauge
 }
 
 func NewWatcherMetrics() *WatcherMetrics {
@@ -20,8 +24,18 @@ func NewWatcherMetrics() *WatcherMetrics {
 			Name: SelfSignedCertNotRenewMetrics,
 			Help: "Indicates the self-signed Certificate of related Kyma is not renewed yet",
 		}, []string{KymaNameLabel}),
+		certRotationPendingGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: SelfSignedCertRotationPendingMetrics,
+			Help: "Indicates the self-signed Certificate of related Kyma is not yet re-issued by the rotated CA",
+		}, []string{KymaNameLabel}),
+		caRotationGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: GatewaySecretCARotationMetrics,
+			Help: "Indicates the gateway secret trusts both the previous and the current CA during the grace period",
+		}),
 	}
 	ctrlmetrics.Registry.MustRegister(watcherMetrics.certNotRenewGauge)
+	ctrlmetrics.Registry.MustRegister(watcherMetrics.certRotationPendingGauge)
+	ctrlmetrics.Registry.MustRegister(watcherMetrics.caRotationGauge)
 	watchermetrics.Init(ctrlmetrics.Registry)
 	return watcherMetrics
 }
@@ -30,6 +44,9 @@ func (w *WatcherMetrics) CleanupMetrics(kymaName string) {
 	w.certNotRenewGauge.DeletePartialMatch(prometheus.Labels{
 		KymaNameLabel: kymaName,
 	})
+	w.certRotationPendingGauge.DeletePartialMatch(prometheus.Labels{
+		KymaNameLabel: kymaName,
+	})
 }
 
 func (w *WatcherMetrics) SetCertNotRenew(kymaName string) {
@@ -37,3 +54,23 @@ func (w *WatcherMetrics) SetCertNotRenew(kymaName string) {
 		KymaNameLabel: kymaName,
 	}).Set(1)
 }
+
+func (w *WatcherMetrics) SetCertRotationPending(kymaName string, pending bool) {
+	if !pending {
+		w.certRotationPendingGauge.DeletePartialMatch(prometheus.Labels{
+			KymaNameLabel: kymaName,
+		})
+		return
+	}
+	w.certRotationPendingGauge.With(prometheus.Labels{
+		KymaNameLabel: kymaName,
+	}).Set(1)
+}
+
+func (w *WatcherMetrics) SetCARotationInProgress(inProgress bool) {
+	if inProgress {
+		w.caRotationGauge.Set(1)
+		return
+	}
+	w.caRotationGauge.Set(0)
+}
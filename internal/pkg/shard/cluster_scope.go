@@ -0,0 +1,30 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// ClusterScopeRunnable is a manager.Runnable which runs the manager of the controllers of cluster-wide resources
+// alongside the manager of the shard. It does not need the shard to be claimed, as the manager of the controllers
+// of cluster-wide resources elects its own leader across all replicas.
+type ClusterScopeRunnable struct {
+	manager manager.Runnable
+}
+
+func NewClusterScopeRunnable(clusterScopeManager manager.Runnable) *ClusterScopeRunnable {
+	return &ClusterScopeRunnable{manager: clusterScopeManager}
+}
+
+func (r *ClusterScopeRunnable) Start(ctx context.Context) error {
+	if err := r.manager.Start(ctx); err != nil {
+		return fmt.Errorf("failed to run cluster scope manager: %w", err)
+	}
+	return nil
+}
+
+func (r *ClusterScopeRunnable) NeedLeaderElection() bool {
+	return false
+}
@@ -0,0 +1,52 @@
+package shard_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/shard"
+)
+
+func TestClusterScopeRunnable_StartsControllersOfClusterScopeManager(t *testing.T) {
+	shardManager := newManager(t)
+	clusterScopeManager := newManager(t)
+	started := make(chan struct{})
+	require.NoError(t, clusterScopeManager.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return nil
+	})))
+	runnable := shard.NewClusterScopeRunnable(clusterScopeManager)
+	require.NoError(t, shardManager.Add(runnable))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		assert.NoError(t, shardManager.Start(ctx))
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "controllers of the cluster scope manager were not started")
+	}
+	assert.False(t, runnable.NeedLeaderElection())
+}
+
+func newManager(t *testing.T) manager.Manager {
+	t.Helper()
+	mgr, err := manager.New(&rest.Config{Host: "https://127.0.0.1:1"}, manager.Options{
+		Scheme:                 newScheme(t),
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+	})
+	require.NoError(t, err)
+	return mgr
+}
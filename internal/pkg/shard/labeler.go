@@ -0,0 +1,127 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// Labeler is a manager.Runnable which periodically assigns the Kymas resolving to its shard, and their Manifests,
+// to the shard by setting the shard label. The Kymas are assigned across the live shards, whose Lease is held by a
+// replica, so that they are rebalanced when replicas join or leave. Since the caches of a replica only contain the
+// resources of its shard, the resources are read with an uncached reader.
+type Labeler struct {
+	reader         client.Reader
+	writer         client.Writer
+	leaseNamespace string
+	shardID        int
+	count          int
+	interval       time.Duration
+}
+
+func NewLabeler(reader client.Reader, writer client.Writer, leaseNamespace string, shardID, count int,
+	interval time.Duration,
+) *Labeler {
+	return &Labeler{
+		reader:         reader,
+		writer:         writer,
+		leaseNamespace: leaseNamespace,
+		shardID:        shardID,
+		count:          count,
+		interval:       interval,
+	}
+}
+
+func (l *Labeler) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("shard-labeler")
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := l.LabelShard(ctx); err != nil {
+			logger.Error(err, "failed to assign resources to shard", "shard", l.shardID)
+		}
+	}, l.interval)
+	return nil
+}
+
+func (l *Labeler) NeedLeaderElection() bool {
+	return true
+}
+
+// LabelShard sets the shard label on all Kymas of the shard and on the Manifests belonging to them.
+// Kymas assigned to the shard are taken over from other shards.
+func (l *Labeler) LabelShard(ctx context.Context) error {
+	shardValue := strconv.Itoa(l.shardID)
+	liveShards, err := LiveShards(ctx, l.reader, l.leaseNamespace, l.count, time.Now())
+	if err != nil {
+		return err
+	}
+	// the shard of the replica is live while it runs, even if its Lease is not renewed yet
+	if !slices.Contains(liveShards, l.shardID) {
+		liveShards = append(liveShards, l.shardID)
+	}
+
+	kymas := &apimetav1.PartialObjectMetadataList{}
+	kymas.SetGroupVersionKind(v1beta2.GroupVersion.WithKind(string(shared.KymaKind) + "List"))
+	if err := l.reader.List(ctx, kymas); err != nil {
+		return fmt.Errorf("failed to list kymas: %w", err)
+	}
+	var errs []error
+	shardKymas := make(map[string]struct{})
+	for i := range kymas.Items {
+		kyma := &kymas.Items[i]
+		if Assign(kyma.GetName(), kyma.GetLabels(), l.count, liveShards) != l.shardID {
+			continue
+		}
+		shardKymas[kyma.GetName()] = struct{}{}
+		// the items of a metadata list do not carry the kind of the listed resource
+		kyma.SetGroupVersionKind(v1beta2.GroupVersion.WithKind(string(shared.KymaKind)))
+		if err := l.ensureShardLabel(ctx, kyma, shardValue); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	manifests := &apimetav1.PartialObjectMetadataList{}
+	manifests.SetGroupVersionKind(v1beta2.GroupVersion.WithKind(string(shared.ManifestKind) + "List"))
+	if err := l.reader.List(ctx, manifests); err != nil {
+		return errors.Join(append(errs, fmt.Errorf("failed to list manifests: %w", err))...)
+	}
+	for i := range manifests.Items {
+		manifest := &manifests.Items[i]
+		if _, ok := shardKymas[manifest.GetLabels()[shared.KymaName]]; !ok {
+			continue
+		}
+		manifest.SetGroupVersionKind(v1beta2.GroupVersion.WithKind(string(shared.ManifestKind)))
+		if err := l.ensureShardLabel(ctx, manifest, shardValue); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (l *Labeler) ensureShardLabel(ctx context.Context, obj *apimetav1.PartialObjectMetadata, shardValue string) error {
+	if obj.GetLabels()[shared.ShardLabel] == shardValue {
+		return nil
+	}
+	patch := client.MergeFrom(obj.DeepCopy())
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[shared.ShardLabel] = shardValue
+	obj.SetLabels(labels)
+	if err := l.writer.Patch(ctx, obj, patch); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to assign %s %s to shard %s: %w", obj.Kind, obj.GetName(), shardValue, err)
+	}
+	return nil
+}
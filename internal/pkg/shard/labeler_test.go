@@ -0,0 +1,123 @@
+package shard_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicoordinationv1 "k8s.io/api/coordination/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/shard"
+)
+
+func TestLabeler_LabelShard_AssignsKymasAndManifestsOfShard(t *testing.T) {
+	now := time.Now()
+	liveShards := []int{0, 1}
+	ownKyma := kymaOfShard(t, 0, liveShards)
+	otherKyma := kymaOfShard(t, 1, liveShards)
+	ownManifest := manifestOf("own-manifest", ownKyma.Name)
+	otherManifest := manifestOf("other-manifest", otherKyma.Name)
+	kcpClient := fake.NewClientBuilder().WithScheme(newScheme(t)).
+		WithObjects(ownKyma, otherKyma, ownManifest, otherManifest,
+			heldLease(0, "replica-0", now), heldLease(1, "replica-1", now)).Build()
+	labeler := shard.NewLabeler(kcpClient, kcpClient, leaseNamespace, 0, 2, time.Minute)
+
+	err := labeler.LabelShard(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "0", shardLabelOf(t, kcpClient, &v1beta2.Kyma{}, ownKyma.Name))
+	assert.Equal(t, "0", shardLabelOf(t, kcpClient, &v1beta2.Manifest{}, ownManifest.Name))
+	assert.Empty(t, shardLabelOf(t, kcpClient, &v1beta2.Kyma{}, otherKyma.Name))
+	assert.Empty(t, shardLabelOf(t, kcpClient, &v1beta2.Manifest{}, otherManifest.Name))
+}
+
+func TestLabeler_LabelShard_KeepsPinnedShard(t *testing.T) {
+	now := time.Now()
+	kyma := kymaOfShard(t, 0, []int{0, 1})
+	kyma.Labels = map[string]string{shared.ShardLabel: "1", shared.PinnedShardLabel: "1"}
+	kcpClient := fake.NewClientBuilder().WithScheme(newScheme(t)).
+		WithObjects(kyma, heldLease(0, "replica-0", now), heldLease(1, "replica-1", now)).Build()
+	labeler := shard.NewLabeler(kcpClient, kcpClient, leaseNamespace, 0, 2, time.Minute)
+
+	err := labeler.LabelShard(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "1", shardLabelOf(t, kcpClient, &v1beta2.Kyma{}, kyma.Name))
+}
+
+func TestLabeler_LabelShard_TakesOverKymasOfLeftShard(t *testing.T) {
+	now := time.Now()
+	kyma := kymaOfShard(t, 2, []int{0, 1, 2})
+	kyma.Labels = map[string]string{shared.ShardLabel: "2"}
+	manifest := manifestOf("manifest", kyma.Name)
+	manifest.Labels[shared.ShardLabel] = "2"
+	newShard := shard.Assign(kyma.Name, nil, 3, []int{0, 1})
+	kcpClient := fake.NewClientBuilder().WithScheme(newScheme(t)).
+		WithObjects(kyma, manifest, heldLease(0, "replica-0", now), heldLease(1, "replica-1", now),
+			heldLease(2, "replica-2", now.Add(-time.Minute))).Build()
+	labeler := shard.NewLabeler(kcpClient, kcpClient, leaseNamespace, newShard, 3, time.Minute)
+
+	err := labeler.LabelShard(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(newShard), shardLabelOf(t, kcpClient, &v1beta2.Kyma{}, kyma.Name))
+	assert.Equal(t, strconv.Itoa(newShard), shardLabelOf(t, kcpClient, &v1beta2.Manifest{}, manifest.Name))
+}
+
+func TestLabeler_LabelShard_TakesOverKymasWhenJoining(t *testing.T) {
+	now := time.Now()
+	kyma := kymaOfShard(t, 1, []int{0, 1})
+	kyma.Labels = map[string]string{shared.ShardLabel: "0"}
+	kcpClient := fake.NewClientBuilder().WithScheme(newScheme(t)).
+		WithObjects(kyma, heldLease(0, "replica-0", now)).Build()
+	// the Lease of the joining replica is not renewed by the leader election yet
+	labeler := shard.NewLabeler(kcpClient, kcpClient, leaseNamespace, 1, 2, time.Minute)
+
+	err := labeler.LabelShard(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "1", shardLabelOf(t, kcpClient, &v1beta2.Kyma{}, kyma.Name))
+}
+
+func newScheme(t *testing.T) *machineryruntime.Scheme {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	require.NoError(t, apicoordinationv1.AddToScheme(scheme))
+	return scheme
+}
+
+func kymaOfShard(t *testing.T, shardID int, liveShards []int) *v1beta2.Kyma {
+	t.Helper()
+	for i := range 100 {
+		name := "kyma-" + string(rune('a'+i%26)) + string(rune('a'+i/26))
+		if shard.Assign(name, nil, len(liveShards), liveShards) == shardID {
+			return &v1beta2.Kyma{ObjectMeta: apimetav1.ObjectMeta{Name: name, Namespace: leaseNamespace}}
+		}
+	}
+	t.Fatalf("no kyma name found for shard %d", shardID)
+	return nil
+}
+
+func manifestOf(name, kymaName string) *v1beta2.Manifest {
+	return &v1beta2.Manifest{ObjectMeta: apimetav1.ObjectMeta{
+		Name:      name,
+		Namespace: leaseNamespace,
+		Labels:    map[string]string{shared.KymaName: kymaName},
+	}}
+}
+
+func shardLabelOf(t *testing.T, kcpClient client.Client, obj client.Object, name string) string {
+	t.Helper()
+	require.NoError(t, kcpClient.Get(context.Background(), client.ObjectKey{Name: name, Namespace: leaseNamespace}, obj))
+	return obj.GetLabels()[shared.ShardLabel]
+}
@@ -0,0 +1,152 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apicoordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// LeaseClaimer claims a shard whose Lease is not held by any replica, by taking over the Lease for the identity of
+// the replica. The Lease is created, or updated with the resourceVersion it was read with, so that of several
+// replicas claiming the same shard only one succeeds and the others move on to the next shard. The claimed shard is
+// kept afterwards through the leader election of the manager on the Lease of the shard with the same identity.
+type LeaseClaimer struct {
+	client        client.Client
+	namespace     string
+	identity      string
+	count         int
+	leaseDuration time.Duration
+	retryAfter    time.Duration
+}
+
+func NewLeaseClaimer(clnt client.Client, namespace, identity string, count int,
+	leaseDuration, retryAfter time.Duration,
+) *LeaseClaimer {
+	return &LeaseClaimer{
+		client:        clnt,
+		namespace:     namespace,
+		identity:      identity,
+		count:         count,
+		leaseDuration: leaseDuration,
+		retryAfter:    retryAfter,
+	}
+}
+
+// WaitForShard blocks until a shard is claimed and returns it.
+// As long as all shards are owned, the replica stays on standby.
+func (c *LeaseClaimer) WaitForShard(ctx context.Context) (int, error) {
+	logger := logf.FromContext(ctx)
+	for {
+		shardID, claimed, err := c.ClaimShard(ctx, time.Now())
+		if err != nil {
+			return 0, err
+		}
+		if claimed {
+			return shardID, nil
+		}
+		logger.Info("all shards are owned, waiting for a free shard", "shards", c.count)
+		select {
+		case <-ctx.Done():
+			return 0, fmt.Errorf("waiting for a free shard: %w", ctx.Err())
+		case <-time.After(c.retryAfter):
+		}
+	}
+}
+
+// ClaimShard claims the first shard whose Lease does not exist, was released or expired, and which is not claimed
+// by another replica at the same time.
+func (c *LeaseClaimer) ClaimShard(ctx context.Context, now time.Time) (int, bool, error) {
+	for shardID := range c.count {
+		claimed, err := c.claim(ctx, shardID, now)
+		if err != nil {
+			return 0, false, err
+		}
+		if claimed {
+			return shardID, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func (c *LeaseClaimer) claim(ctx context.Context, shardID int, now time.Time) (bool, error) {
+	lease := &apicoordinationv1.Lease{}
+	err := c.client.Get(ctx, client.ObjectKey{Name: LeaseName(shardID), Namespace: c.namespace}, lease)
+	if util.IsNotFound(err) {
+		lease = &apicoordinationv1.Lease{
+			ObjectMeta: apimetav1.ObjectMeta{Name: LeaseName(shardID), Namespace: c.namespace},
+		}
+		c.hold(lease, now)
+		if err := c.client.Create(ctx, lease); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to create lease of shard %d: %w", shardID, err)
+		}
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get lease of shard %d: %w", shardID, err)
+	}
+	if isHeld(lease, now) {
+		return false, nil
+	}
+	c.hold(lease, now)
+	lease.Spec.LeaseTransitions = ptr.To(ptr.Deref(lease.Spec.LeaseTransitions, 0) + 1)
+	if err := c.client.Update(ctx, lease); err != nil {
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to update lease of shard %d: %w", shardID, err)
+	}
+	return true, nil
+}
+
+func (c *LeaseClaimer) hold(lease *apicoordinationv1.Lease, now time.Time) {
+	lease.Spec.HolderIdentity = ptr.To(c.identity)
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(c.leaseDuration.Seconds()))
+	lease.Spec.AcquireTime = &apimetav1.MicroTime{Time: now}
+	lease.Spec.RenewTime = &apimetav1.MicroTime{Time: now}
+}
+
+// LiveShards returns the shards whose Lease is held by a replica.
+func LiveShards(ctx context.Context, reader client.Reader, namespace string, count int, now time.Time) ([]int,
+	error,
+) {
+	leases := &apicoordinationv1.LeaseList{}
+	if err := reader.List(ctx, leases, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list shard leases: %w", err)
+	}
+	heldLeases := make(map[string]struct{}, len(leases.Items))
+	for i := range leases.Items {
+		if isHeld(&leases.Items[i], now) {
+			heldLeases[leases.Items[i].Name] = struct{}{}
+		}
+	}
+	liveShards := make([]int, 0, count)
+	for shardID := range count {
+		if _, ok := heldLeases[LeaseName(shardID)]; ok {
+			liveShards = append(liveShards, shardID)
+		}
+	}
+	return liveShards, nil
+}
+
+func isHeld(lease *apicoordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == "" {
+		return false
+	}
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return false
+	}
+	expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	return now.Before(expiry)
+}
@@ -0,0 +1,145 @@
+package shard_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicoordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/shard"
+)
+
+const (
+	leaseNamespace = "kcp-system"
+	identity       = "replica-new"
+)
+
+func TestClaimShard_CreatesLeaseOfShardWithoutLease(t *testing.T) {
+	now := time.Now()
+	kcpClient := fake.NewClientBuilder().WithObjects(heldLease(0, "replica-0", now)).Build()
+	claimer := shard.NewLeaseClaimer(kcpClient, leaseNamespace, identity, 2, time.Minute, time.Second)
+
+	shardID, claimed, err := claimer.ClaimShard(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, 1, shardID)
+	assertHolder(t, kcpClient, 1, identity)
+}
+
+func TestClaimShard_TakesOverExpiredOrReleasedLease(t *testing.T) {
+	now := time.Now()
+	releasedLease := heldLease(1, "", now)
+	kcpClient := fake.NewClientBuilder().WithObjects(
+		heldLease(0, "replica-0", now.Add(-time.Minute)), releasedLease).Build()
+	claimer := shard.NewLeaseClaimer(kcpClient, leaseNamespace, identity, 2, time.Minute, time.Second)
+
+	shardID, claimed, err := claimer.ClaimShard(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, 0, shardID)
+	assertHolder(t, kcpClient, 0, identity)
+}
+
+func TestClaimShard_MovesToNextShard_WhenClaimedConcurrently(t *testing.T) {
+	now := time.Now()
+	kcpClient := fake.NewClientBuilder().
+		WithObjects(heldLease(0, "replica-0", now.Add(-time.Minute))).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.UpdateOption) error {
+				return apierrors.NewConflict(schema.GroupResource{Resource: "leases"}, obj.GetName(), nil)
+			},
+		}).Build()
+	claimer := shard.NewLeaseClaimer(kcpClient, leaseNamespace, identity, 2, time.Minute, time.Second)
+
+	shardID, claimed, err := claimer.ClaimShard(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, 1, shardID)
+}
+
+func TestClaimShard_ConcurrentReplicasClaimDistinctShards(t *testing.T) {
+	now := time.Now()
+	kcpClient := fake.NewClientBuilder().Build()
+	first := shard.NewLeaseClaimer(kcpClient, leaseNamespace, "replica-a", 2, time.Minute, time.Second)
+	second := shard.NewLeaseClaimer(kcpClient, leaseNamespace, "replica-b", 2, time.Minute, time.Second)
+
+	firstShard, firstClaimed, err := first.ClaimShard(context.Background(), now)
+	require.NoError(t, err)
+	secondShard, secondClaimed, err := second.ClaimShard(context.Background(), now)
+	require.NoError(t, err)
+
+	assert.True(t, firstClaimed)
+	assert.True(t, secondClaimed)
+	assert.NotEqual(t, firstShard, secondShard)
+}
+
+func TestClaimShard_ReturnsNotClaimed_WhenAllShardsAreOwned(t *testing.T) {
+	now := time.Now()
+	kcpClient := fake.NewClientBuilder().WithObjects(
+		heldLease(0, "replica-0", now), heldLease(1, "replica-1", now)).Build()
+	claimer := shard.NewLeaseClaimer(kcpClient, leaseNamespace, identity, 2, time.Minute, time.Second)
+
+	_, claimed, err := claimer.ClaimShard(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.False(t, claimed)
+}
+
+func TestWaitForShard_ReturnsError_WhenContextIsCancelled(t *testing.T) {
+	now := time.Now()
+	kcpClient := fake.NewClientBuilder().WithObjects(heldLease(0, "replica-0", now)).Build()
+	claimer := shard.NewLeaseClaimer(kcpClient, leaseNamespace, identity, 1, time.Minute, time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := claimer.WaitForShard(ctx)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestLiveShards_ReturnsShardsWithHeldLease(t *testing.T) {
+	now := time.Now()
+	kcpClient := fake.NewClientBuilder().WithObjects(
+		heldLease(0, "replica-0", now), heldLease(1, "replica-1", now.Add(-time.Minute)), heldLease(2, "", now),
+		heldLease(3, "replica-3", now)).Build()
+
+	liveShards, err := shard.LiveShards(context.Background(), kcpClient, leaseNamespace, 4, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 3}, liveShards)
+}
+
+func heldLease(shardID int, holder string, renewTime time.Time) client.Object {
+	return &apicoordinationv1.Lease{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      shard.LeaseName(shardID),
+			Namespace: leaseNamespace,
+		},
+		Spec: apicoordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To(holder),
+			LeaseDurationSeconds: ptr.To(int32(15)),
+			RenewTime:            &apimetav1.MicroTime{Time: renewTime},
+		},
+	}
+}
+
+func assertHolder(t *testing.T, clnt client.Client, shardID int, holder string) {
+	t.Helper()
+	lease := &apicoordinationv1.Lease{}
+	require.NoError(t, clnt.Get(context.Background(),
+		client.ObjectKey{Name: shard.LeaseName(shardID), Namespace: leaseNamespace}, lease))
+	assert.Equal(t, holder, ptr.Deref(lease.Spec.HolderIdentity, ""))
+}
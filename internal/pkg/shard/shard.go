@@ -0,0 +1,53 @@
+package shard
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"hash/fnv"
+	"strconv"
+
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+const (
+	leaseNamePrefix = "lifecycle-manager-shard-"
+
+	// ClusterScopeLeaseName is the name of the Lease electing the replica which runs the controllers of
+	// cluster-wide resources, independently of the shard it owns.
+	ClusterScopeLeaseName = "lifecycle-manager-cluster-scope"
+)
+
+// Assign determines the shard of the Kyma with the given name and labels. A valid pinned shard label takes
+// precedence. Otherwise, the Kyma is assigned to the live shard with the highest hash of the shard and the Kyma name,
+// so that when a shard joins or leaves, only the Kymas moving to or from that shard are reassigned. Without live
+// shards, the shard is derived from the hash of the Kyma name.
+func Assign(kymaName string, labels map[string]string, count int, liveShards []int) int {
+	if shardID, err := strconv.Atoi(labels[shared.PinnedShardLabel]); err == nil && shardID >= 0 && shardID < count {
+		return shardID
+	}
+	assigned, highestScore := -1, uint64(0)
+	for _, shardID := range liveShards {
+		hash := sha256.Sum256([]byte(strconv.Itoa(shardID) + "/" + kymaName))
+		if score := binary.BigEndian.Uint64(hash[:8]); assigned < 0 || score > highestScore {
+			assigned, highestScore = shardID, score
+		}
+	}
+	if assigned >= 0 {
+		return assigned
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(kymaName))
+	return int(hash.Sum32() % uint32(count)) //nolint:gosec // count is validated to be positive
+}
+
+// LeaseName returns the name of the Lease held by the replica owning the shard.
+func LeaseName(shardID int) string {
+	return leaseNamePrefix + strconv.Itoa(shardID)
+}
+
+// Selector selects the resources assigned to the shard.
+func Selector(shardID int) k8slabels.Selector {
+	return k8slabels.SelectorFromSet(k8slabels.Set{shared.ShardLabel: strconv.Itoa(shardID)})
+}
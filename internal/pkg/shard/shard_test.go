@@ -0,0 +1,73 @@
+package shard_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/shard"
+)
+
+func TestAssign_HashesKymaName_WithoutLiveShards(t *testing.T) {
+	shardID := shard.Assign("kyma-1", nil, 4, nil)
+
+	assert.GreaterOrEqual(t, shardID, 0)
+	assert.Less(t, shardID, 4)
+	assert.Equal(t, shardID, shard.Assign("kyma-1", map[string]string{}, 4, nil))
+}
+
+func TestAssign_PrefersValidPinnedShardLabel(t *testing.T) {
+	liveShards := []int{0, 1}
+	tests := []struct {
+		name     string
+		label    string
+		expected int
+	}{
+		{name: "valid label", label: "3", expected: 3},
+		{name: "label out of range", label: "4", expected: shard.Assign("kyma-1", nil, 4, liveShards)},
+		{name: "invalid label", label: "first", expected: shard.Assign("kyma-1", nil, 4, liveShards)},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			shardID := shard.Assign("kyma-1", map[string]string{shared.PinnedShardLabel: testCase.label}, 4,
+				liveShards)
+
+			assert.Equal(t, testCase.expected, shardID)
+		})
+	}
+}
+
+func TestAssign_SpreadsKymasAcrossLiveShards(t *testing.T) {
+	kymasPerShard := map[int]int{}
+	for i := range 1000 {
+		shardID := shard.Assign("kyma-"+strconv.Itoa(i), nil, 4, []int{0, 2, 3})
+		kymasPerShard[shardID]++
+	}
+
+	assert.Zero(t, kymasPerShard[1])
+	for _, shardID := range []int{0, 2, 3} {
+		assert.Greater(t, kymasPerShard[shardID], 250, "shard %d", shardID)
+	}
+}
+
+func TestAssign_OnlyMovesKymasOfJoiningOrLeavingShard(t *testing.T) {
+	for i := range 1000 {
+		kymaName := "kyma-" + strconv.Itoa(i)
+		before := shard.Assign(kymaName, nil, 3, []int{0, 1, 2})
+		afterLeave := shard.Assign(kymaName, nil, 3, []int{0, 2})
+
+		if before != 1 {
+			assert.Equal(t, before, afterLeave, kymaName)
+		}
+		assert.NotEqual(t, 1, afterLeave, kymaName)
+	}
+}
+
+func TestSelector_MatchesShardLabel(t *testing.T) {
+	selector := shard.Selector(2)
+
+	assert.Equal(t, shared.ShardLabel+"=2", selector.String())
+	assert.Equal(t, "lifecycle-manager-shard-2", shard.LeaseName(2))
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/kyma-project/lifecycle-manager/pkg/util"
 )
 
+const skrStorageVersionMigrationBatchSize = 100
+
 type SyncCrdsUseCase struct {
 	kcpClient         client.Client
 	skrContextFactory SkrContextProvider
@@ -79,13 +81,17 @@ func (s *SyncCrdsUseCase) Execute(ctx context.Context, kyma *v1beta2.Kyma) (bool
 	return kymaCrdUpdated || moduleTemplateCrdUpdated || moduleReleaseMetaCrdUpdated, nil
 }
 
+// PatchCRD applies the CRD to the SKR cluster. There, only the storage version is served and objects are not
+// converted, as the SKR webhook does not serve conversion.
 func PatchCRD(ctx context.Context, clnt client.Client, crd *apiextensionsv1.CustomResourceDefinition) error {
 	crdToApply := &apiextensionsv1.CustomResourceDefinition{}
 	crdToApply.SetGroupVersionKind(crd.GroupVersionKind())
 	crdToApply.SetName(crd.Name)
-	crdToApply.Spec = crd.Spec
-	crdToApply.Spec.Conversion.Strategy = apiextensionsv1.NoneConverter
-	crdToApply.Spec.Conversion.Webhook = nil
+	crdToApply.Spec = *crd.Spec.DeepCopy()
+	crdToApply.Spec.Conversion = &apiextensionsv1.CustomResourceConversion{Strategy: apiextensionsv1.NoneConverter}
+	for i := range crdToApply.Spec.Versions {
+		crdToApply.Spec.Versions[i].Served = crdToApply.Spec.Versions[i].Storage
+	}
 
 	crdToApply.SetLabels(collections.MergeMaps(crdToApply.GetLabels(), map[string]string{
 		shared.ManagedBy: shared.ManagedByLabelValue,
@@ -172,6 +178,15 @@ func (s *SyncCrdsUseCase) fetchCrdsAndUpdateKymaAnnotations(ctx context.Context,
 		updateKymaAnnotations(kyma, skrCrd, SKR)
 	}
 
+	// the SKR CRDs are synced from KCP, so all versions but the storage version are dropped once the objects are
+	// stored in it
+	if crd.RequiresMigration(skrCrd) {
+		err = crd.NewStorageVersionMigration(skrClient, skrStorageVersionMigrationBatchSize, nil).Migrate(ctx, skrCrd)
+		if err != nil {
+			return false, fmt.Errorf("failed to migrate SKR CRD to its storage version: %w", err)
+		}
+	}
+
 	return crdUpdated, nil
 }
 
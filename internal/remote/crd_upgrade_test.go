@@ -1,11 +1,16 @@
 package remote_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/remote"
@@ -94,3 +99,41 @@ func TestShouldPatchRemoteCRD(t *testing.T) {
 		})
 	}
 }
+
+func TestPatchCRD_ServesOnlyStorageVersionWithoutConversion(t *testing.T) {
+	t.Parallel()
+	kcpCrd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "kymas.operator.kyma-project.io"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1beta1", Served: true, Storage: false},
+				{Name: "v1beta2", Served: true, Storage: true},
+			},
+			Conversion: &apiextensionsv1.CustomResourceConversion{
+				Strategy: apiextensionsv1.WebhookConverter,
+				Webhook:  &apiextensionsv1.WebhookConversion{ConversionReviewVersions: []string{"v1"}},
+			},
+		},
+	}
+	var appliedCrd *apiextensionsv1.CustomResourceDefinition
+	skrClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch,
+			_ ...client.PatchOption,
+		) error {
+			appliedCrd, _ = obj.(*apiextensionsv1.CustomResourceDefinition)
+			return nil
+		},
+	}).Build()
+
+	err := remote.PatchCRD(context.Background(), skrClient, kcpCrd)
+
+	require.NoError(t, err)
+	require.NotNil(t, appliedCrd)
+	assert.Equal(t, apiextensionsv1.NoneConverter, appliedCrd.Spec.Conversion.Strategy)
+	assert.Nil(t, appliedCrd.Spec.Conversion.Webhook)
+	assert.False(t, appliedCrd.Spec.Versions[0].Served)
+	assert.True(t, appliedCrd.Spec.Versions[1].Served)
+	// the CRD of KCP is left untouched
+	assert.True(t, kcpCrd.Spec.Versions[0].Served)
+	assert.Equal(t, apiextensionsv1.WebhookConverter, kcpCrd.Spec.Conversion.Strategy)
+}
@@ -0,0 +1,149 @@
+package remote
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// provisionerSpec holds the fields of the Kyma spec controlled by the provisioner.
+type provisionerSpec struct {
+	Channel string           `json:"channel,omitempty"`
+	Modules []v1beta2.Module `json:"modules,omitempty"`
+}
+
+// MergeSpec merges the spec of the remote Kyma into the control plane Kyma. The channel and the modules controlled
+// by the provisioner keep the values of the control plane Kyma, all other attributes are taken from the remote Kyma.
+// Changes of the remote Kyma to the fields controlled by the provisioner are rejected and returned as conflicts.
+func MergeSpec(controlPlaneKyma *v1beta2.Kyma, remoteKyma *v1beta2.Kyma) []string {
+	provisioned := getProvisionerSpec(controlPlaneKyma)
+	lastSynced := getLastSyncedProvisionerSpec(remoteKyma)
+	conflicts := detectModuleConflicts(provisioned.Modules, lastSynced.Modules, remoteKyma.Spec.Modules)
+
+	if !controlPlaneKyma.IsChannelControlledByProvisioner() {
+		controlPlaneKyma.Spec.Channel = remoteKyma.Spec.Channel
+	} else if lastSynced.Channel != "" && remoteKyma.Spec.Channel != lastSynced.Channel &&
+		remoteKyma.Spec.Channel != controlPlaneKyma.Spec.Channel {
+		conflicts = append(conflicts, fmt.Sprintf(
+			"spec.channel: change to %q rejected, the channel is controlled by the provisioner",
+			remoteKyma.Spec.Channel))
+	}
+	controlPlaneKyma.Spec.Modules = mergeModules(provisioned.Modules, remoteKyma.Spec.Modules)
+
+	return conflicts
+}
+
+// ApplyProvisionerSpec overwrites the fields of the remote Kyma controlled by the provisioner with the values of the
+// control plane Kyma, and records them on the remote Kyma as last synchronized.
+func ApplyProvisionerSpec(controlPlaneKyma *v1beta2.Kyma, remoteKyma *v1beta2.Kyma) error {
+	provisioned := getProvisionerSpec(controlPlaneKyma)
+	if controlPlaneKyma.IsChannelControlledByProvisioner() {
+		remoteKyma.Spec.Channel = provisioned.Channel
+	}
+	remoteKyma.Spec.Modules = mergeModules(provisioned.Modules, remoteKyma.Spec.Modules)
+
+	if provisioned.Channel == "" && len(provisioned.Modules) == 0 {
+		delete(remoteKyma.Annotations, shared.ProvisionerSpecAnnotation)
+		return nil
+	}
+	value, err := json.Marshal(provisioned)
+	if err != nil {
+		return fmt.Errorf("failed to marshal spec controlled by the provisioner: %w", err)
+	}
+	if remoteKyma.Annotations == nil {
+		remoteKyma.Annotations = make(map[string]string)
+	}
+	remoteKyma.Annotations[shared.ProvisionerSpecAnnotation] = string(value)
+	return nil
+}
+
+func getProvisionerSpec(kyma *v1beta2.Kyma) provisionerSpec {
+	spec := provisionerSpec{}
+	if kyma.IsChannelControlledByProvisioner() {
+		spec.Channel = kyma.Spec.Channel
+	}
+	for _, module := range kyma.Spec.Modules {
+		if module.IsControlledByProvisioner() {
+			spec.Modules = append(spec.Modules, module)
+		}
+	}
+	return spec
+}
+
+// getLastSyncedProvisionerSpec returns an empty spec if the remote Kyma was never synchronized with fields
+// controlled by the provisioner, so that these are not reported as conflicts when they are added.
+func getLastSyncedProvisionerSpec(remoteKyma *v1beta2.Kyma) provisionerSpec {
+	spec := provisionerSpec{}
+	value, found := remoteKyma.Annotations[shared.ProvisionerSpecAnnotation]
+	if !found {
+		return spec
+	}
+	if err := json.Unmarshal([]byte(value), &spec); err != nil {
+		return provisionerSpec{}
+	}
+	return spec
+}
+
+// detectModuleConflicts reports the modules controlled by the provisioner which were removed or changed in the
+// remote Kyma since they were last synchronized. Differences caused by changes of the provisioner are no conflicts.
+func detectModuleConflicts(provisioned, lastSynced, remoteModules []v1beta2.Module) []string {
+	lastSyncedByName := modulesByName(lastSynced)
+	remoteByName := modulesByName(remoteModules)
+
+	var conflicts []string
+	for _, module := range provisioned {
+		lastSyncedModule, synced := lastSyncedByName[module.Name]
+		if !synced {
+			continue
+		}
+		remoteModule, found := remoteByName[module.Name]
+		switch {
+		case !found:
+			conflicts = append(conflicts, fmt.Sprintf(
+				"spec.modules[%s]: removal rejected, the module is controlled by the provisioner", module.Name))
+		case !equality.Semantic.DeepEqual(remoteModule, module) &&
+			!equality.Semantic.DeepEqual(remoteModule, lastSyncedModule):
+			conflicts = append(conflicts, fmt.Sprintf(
+				"spec.modules[%s]: change rejected, the module is controlled by the provisioner", module.Name))
+		}
+	}
+	return conflicts
+}
+
+// mergeModules replaces the modules controlled by the provisioner in the given modules, keeping their order.
+// Modules which are marked as controlled by the provisioner, but are no longer provisioned, were removed by the
+// provisioner and are dropped.
+func mergeModules(provisioned, modules []v1beta2.Module) []v1beta2.Module {
+	provisionedByName := modulesByName(provisioned)
+	merged := make([]v1beta2.Module, 0, len(modules)+len(provisioned))
+	added := make(map[string]bool, len(provisioned))
+	for _, module := range modules {
+		if provisionedModule, found := provisionedByName[module.Name]; found {
+			merged = append(merged, provisionedModule)
+			added[module.Name] = true
+			continue
+		}
+		if module.IsControlledByProvisioner() {
+			continue
+		}
+		merged = append(merged, module)
+	}
+	for _, module := range provisioned {
+		if !added[module.Name] {
+			merged = append(merged, module)
+		}
+	}
+	return merged
+}
+
+func modulesByName(modules []v1beta2.Module) map[string]v1beta2.Module {
+	byName := make(map[string]v1beta2.Module, len(modules))
+	for _, module := range modules {
+		byName[module.Name] = module
+	}
+	return byName
+}
@@ -0,0 +1,116 @@
+package remote_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/remote"
+)
+
+func TestMergeSpec_KeepsProvisionerControlledFields(t *testing.T) {
+	t.Parallel()
+	kcpKyma := newSpecKyma("regular", provisionerModule("istio", "regular"))
+	kcpKyma.Spec.ChannelControlledBy = v1beta2.SpecOwnerProvisioner
+	remoteKyma := newSpecKyma("fast", v1beta2.Module{Name: "serverless"})
+
+	conflicts := remote.MergeSpec(kcpKyma, remoteKyma)
+
+	assert.Empty(t, conflicts, "fields never synchronized to the remote Kyma are no conflicts")
+	assert.Equal(t, "regular", kcpKyma.Spec.Channel)
+	assert.Equal(t, []string{"serverless", "istio"}, moduleNames(kcpKyma.Spec.Modules))
+}
+
+func TestMergeSpec_ReportsRejectedChanges(t *testing.T) {
+	t.Parallel()
+	kcpKyma := newSpecKyma("regular", provisionerModule("istio", "regular"), provisionerModule("keda", "regular"))
+	kcpKyma.Spec.ChannelControlledBy = v1beta2.SpecOwnerProvisioner
+	remoteKyma := newSpecKyma("regular", provisionerModule("istio", "regular"), provisionerModule("keda", "regular"))
+	require.NoError(t, remote.ApplyProvisionerSpec(kcpKyma, remoteKyma))
+
+	remoteKyma.Spec.Channel = "fast"
+	remoteKyma.Spec.Modules = []v1beta2.Module{provisionerModule("istio", "fast"), {Name: "serverless"}}
+	conflicts := remote.MergeSpec(kcpKyma, remoteKyma)
+
+	assert.ElementsMatch(t, []string{
+		`spec.channel: change to "fast" rejected, the channel is controlled by the provisioner`,
+		"spec.modules[istio]: change rejected, the module is controlled by the provisioner",
+		"spec.modules[keda]: removal rejected, the module is controlled by the provisioner",
+	}, conflicts)
+	assert.Equal(t, "regular", kcpKyma.Spec.Channel)
+	assert.Equal(t, []v1beta2.Module{
+		provisionerModule("istio", "regular"), {Name: "serverless"}, provisionerModule("keda", "regular"),
+	}, kcpKyma.Spec.Modules)
+}
+
+func TestMergeSpec_ChangesOfProvisionerAreNoConflicts(t *testing.T) {
+	t.Parallel()
+	kcpKyma := newSpecKyma("regular", provisionerModule("istio", "regular"), provisionerModule("keda", "regular"))
+	remoteKyma := newSpecKyma("regular", v1beta2.Module{Name: "serverless"})
+	require.NoError(t, remote.ApplyProvisionerSpec(kcpKyma, remoteKyma))
+
+	// the provisioner upgrades istio and removes keda
+	kcpKyma.Spec.Modules = []v1beta2.Module{provisionerModule("istio", "fast")}
+	conflicts := remote.MergeSpec(kcpKyma, remoteKyma)
+
+	assert.Empty(t, conflicts)
+	assert.Equal(t, []v1beta2.Module{{Name: "serverless"}, provisionerModule("istio", "fast")},
+		kcpKyma.Spec.Modules)
+}
+
+func TestMergeSpec_CustomerControlledFieldsAreTakenFromRemote(t *testing.T) {
+	t.Parallel()
+	kcpKyma := newSpecKyma("regular", v1beta2.Module{Name: "istio"})
+	remoteKyma := newSpecKyma("fast", v1beta2.Module{Name: "serverless"})
+
+	conflicts := remote.MergeSpec(kcpKyma, remoteKyma)
+
+	assert.Empty(t, conflicts)
+	assert.Equal(t, "fast", kcpKyma.Spec.Channel)
+	assert.Equal(t, []v1beta2.Module{{Name: "serverless"}}, kcpKyma.Spec.Modules)
+}
+
+func TestApplyProvisionerSpec(t *testing.T) {
+	t.Parallel()
+	kcpKyma := newSpecKyma("regular", provisionerModule("istio", "regular"))
+	kcpKyma.Spec.ChannelControlledBy = v1beta2.SpecOwnerProvisioner
+	remoteKyma := newSpecKyma("fast", provisionerModule("istio", "fast"), v1beta2.Module{Name: "serverless"})
+
+	require.NoError(t, remote.ApplyProvisionerSpec(kcpKyma, remoteKyma))
+
+	assert.Equal(t, "regular", remoteKyma.Spec.Channel)
+	assert.Equal(t, []v1beta2.Module{provisionerModule("istio", "regular"), {Name: "serverless"}},
+		remoteKyma.Spec.Modules)
+	assert.Contains(t, remoteKyma.Annotations, shared.ProvisionerSpecAnnotation)
+
+	kcpKyma.Spec.ChannelControlledBy = v1beta2.SpecOwnerCustomer
+	kcpKyma.Spec.Modules = nil
+	require.NoError(t, remote.ApplyProvisionerSpec(kcpKyma, remoteKyma))
+
+	assert.Equal(t, "regular", remoteKyma.Spec.Channel)
+	assert.Equal(t, []v1beta2.Module{{Name: "serverless"}}, remoteKyma.Spec.Modules)
+	assert.NotContains(t, remoteKyma.Annotations, shared.ProvisionerSpecAnnotation)
+}
+
+func newSpecKyma(channel string, modules ...v1beta2.Module) *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "kyma", Namespace: "kcp-system"},
+		Spec:       v1beta2.KymaSpec{Channel: channel, Modules: modules},
+	}
+}
+
+func provisionerModule(name, channel string) v1beta2.Module {
+	return v1beta2.Module{Name: name, Channel: channel, ControlledBy: v1beta2.SpecOwnerProvisioner}
+}
+
+func moduleNames(modules []v1beta2.Module) []string {
+	names := make([]string, 0, len(modules))
+	for _, module := range modules {
+		names = append(names, module.Name)
+	}
+	return names
+}
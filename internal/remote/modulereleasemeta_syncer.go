@@ -57,7 +57,7 @@ func (mts *moduleReleaseMetaSyncer) SyncToSKR(ctx context.Context, kcpModuleRele
 	}
 
 	runtimeModuleReleases := &v1beta2.ModuleReleaseMetaList{}
-	if err := mts.skrClient.List(ctx, runtimeModuleReleases); err != nil {
+	if err := mts.skrClient.List(ctx, runtimeModuleReleases, mts.settings.listOptions()...); err != nil {
 		// it can happen that the ModuleReleaseMeta CRD is not caught during to apply if there are no objects to apply
 		// if this is the case and there is no CRD there can never be any ModuleReleaseMetas to delete
 		if meta.IsNoMatchError(err) {
@@ -74,7 +74,7 @@ func (mts *moduleReleaseMetaSyncer) SyncToSKR(ctx context.Context, kcpModuleRele
 // DeleteAllManaged deletes all ModuleReleaseMetas managed by KLM from the SKR cluster.
 func (mts *moduleReleaseMetaSyncer) DeleteAllManaged(ctx context.Context) error {
 	moduleReleaseMetasRuntime := &v1beta2.ModuleReleaseMetaList{Items: []v1beta2.ModuleReleaseMeta{}}
-	if err := mts.skrClient.List(ctx, moduleReleaseMetasRuntime); err != nil {
+	if err := mts.skrClient.List(ctx, moduleReleaseMetasRuntime, mts.settings.listOptions()...); err != nil {
 		// if there is no CRD or no ModuleReleaseMeta exists,
 		// there can never be any ModuleReleaseMeta to delete
 		if util.IsNotFound(err) {
@@ -57,7 +57,7 @@ func (mts *moduleTemplateSyncer) SyncToSKR(ctx context.Context, kcpModules []v1b
 	}
 
 	runtimeModules := &v1beta2.ModuleTemplateList{}
-	if err := mts.skrClient.List(ctx, runtimeModules); err != nil {
+	if err := mts.skrClient.List(ctx, runtimeModules, mts.settings.listOptions()...); err != nil {
 		// it can happen that the ModuleTemplate CRD is not caught during to apply if there are no modules to apply
 		// if this is the case and there is no CRD there can never be any module templates to delete
 		if meta.IsNoMatchError(err) {
@@ -74,7 +74,7 @@ func (mts *moduleTemplateSyncer) SyncToSKR(ctx context.Context, kcpModules []v1b
 // DeleteAllManaged deletes all ModuleTemplates managed by KLM from the SKR cluster.
 func (mts *moduleTemplateSyncer) DeleteAllManaged(ctx context.Context) error {
 	moduleTemplatesRuntime := &v1beta2.ModuleTemplateList{Items: []v1beta2.ModuleTemplate{}}
-	if err := mts.skrClient.List(ctx, moduleTemplatesRuntime); err != nil {
+	if err := mts.skrClient.List(ctx, moduleTemplatesRuntime, mts.settings.listOptions()...); err != nil {
 		// if there is no CRD or no module template exists,
 		// there can never be any module templates to delete
 		if util.IsNotFound(err) {
@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/tracing"
 )
 
 const moduleCatalogSyncFieldManager = "catalog-sync"
@@ -17,6 +18,27 @@ type Settings struct {
 	// this namespace flag can be used to override the namespace in which all ModuleTemplates should be applied.
 	Namespace       string
 	SSAPatchOptions *client.PatchOptions
+	// TenantScoped restricts the cleanup of the synchronized catalog to Namespace, so that the catalogs of the
+	// tenants sharing an SKR cluster do not remove each other.
+	TenantScoped bool
+}
+
+// listOptions returns the options to list the synchronized catalog in the SKR cluster.
+func (s *Settings) listOptions() []client.ListOption {
+	if s.TenantScoped {
+		return []client.ListOption{client.InNamespace(s.Namespace)}
+	}
+	return nil
+}
+
+// forSkrContext returns the settings for the catalog of the given SKR context, tenants get their catalog
+// synchronized into their tenant namespace.
+func (s Settings) forSkrContext(skrContext *SkrContext) *Settings {
+	if tenantNamespace := skrContext.TenantNamespace(); tenantNamespace != "" {
+		s.Namespace = tenantNamespace
+		s.TenantScoped = true
+	}
+	return &s
 }
 
 type RemoteCatalog struct {
@@ -104,18 +126,24 @@ func (c *RemoteCatalog) sync(
 	kcpModules []v1beta2.ModuleTemplate,
 	kcpModuleReleaseMeta []v1beta2.ModuleReleaseMeta,
 ) error {
+	ctx, span := tracing.Start(ctx, "RemoteCatalog.Sync", tracing.Kyma(kyma))
 	skrContext, err := c.skrContextFactory.Get(kyma)
 	if err != nil {
-		return fmt.Errorf("failed to get SKR context: %w", err)
+		err = fmt.Errorf("failed to get SKR context: %w", err)
+		tracing.End(span, err)
+		return err
 	}
 
-	moduleTemplates := c.moduleTemplateSyncAPIFactoryFn(c.kcpClient, skrContext.Client, &c.settings)
-	moduleReleaseMetas := c.moduleReleaseMetaSyncAPIFactoryFn(c.kcpClient, skrContext.Client, &c.settings)
+	settings := c.settings.forSkrContext(skrContext)
+	moduleTemplates := c.moduleTemplateSyncAPIFactoryFn(c.kcpClient, skrContext.Client, settings)
+	moduleReleaseMetas := c.moduleReleaseMetaSyncAPIFactoryFn(c.kcpClient, skrContext.Client, settings)
 
 	mtErr := moduleTemplates.SyncToSKR(ctx, kcpModules)
 	mrmErr := moduleReleaseMetas.SyncToSKR(ctx, kcpModuleReleaseMeta)
 
-	return errors.Join(mtErr, mrmErr)
+	err = errors.Join(mtErr, mrmErr)
+	tracing.End(span, err)
+	return err
 }
 
 func (c *RemoteCatalog) Delete(
@@ -127,7 +155,8 @@ func (c *RemoteCatalog) Delete(
 		return fmt.Errorf("failed to get SKR context: %w", err)
 	}
 
-	moduleTemplates := c.moduleTemplateSyncAPIFactoryFn(c.kcpClient, skrContext.Client, &c.settings)
+	moduleTemplates := c.moduleTemplateSyncAPIFactoryFn(c.kcpClient, skrContext.Client,
+		c.settings.forSkrContext(skrContext))
 	return moduleTemplates.DeleteAllManaged(ctx)
 }
 
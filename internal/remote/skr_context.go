@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	apicorev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -21,18 +22,23 @@ import (
 	"github.com/kyma-project/lifecycle-manager/pkg/util"
 )
 
-var ErrNotFoundAndKCPKymaUnderDeleting = errors.New("not found and kcp kyma under deleting")
+var (
+	ErrNotFoundAndKCPKymaUnderDeleting = errors.New("not found and kcp kyma under deleting")
+	ErrSpecConflict                    = errors.New("changes to fields controlled by the provisioner were rejected")
+)
 
 const (
 	crdInstallation     event.Reason = "CRDInstallation"
 	remoteInstallation  event.Reason = "RemoteInstallation"
 	remoteUpdateFailure event.Reason = "RemoteSynchronization"
 	statusUpdateFailure event.Reason = "UpdateRuntimeStatus"
+	specConflict        event.Reason = "SpecConflict"
 )
 
 type SkrContext struct {
 	Client
-	event event.Event
+	event           event.Event
+	tenantNamespace string
 }
 
 func NewSkrContext(client Client, event event.Event) *SkrContext {
@@ -42,8 +48,32 @@ func NewSkrContext(client Client, event event.Event) *SkrContext {
 	}
 }
 
+// NewTenantSkrContext creates the context of a Kyma that shares the SKR cluster with other Kymas. Its remote Kyma
+// and module catalog live in the given tenant namespace.
+func NewTenantSkrContext(client Client, event event.Event, tenantNamespace string) *SkrContext {
+	return &SkrContext{
+		Client:          client,
+		event:           event,
+		tenantNamespace: tenantNamespace,
+	}
+}
+
+// TenantNamespace returns the namespace of the Kyma in a shared SKR cluster, or an empty string if the Kyma is not
+// a tenant.
+func (s *SkrContext) TenantNamespace() string {
+	return s.tenantNamespace
+}
+
+// RemoteNamespace returns the namespace of the remote Kyma.
+func (s *SkrContext) RemoteNamespace() string {
+	if s.tenantNamespace != "" {
+		return s.tenantNamespace
+	}
+	return shared.DefaultRemoteNamespace
+}
+
 func (s *SkrContext) RemoveFinalizersFromKyma(ctx context.Context) error {
-	remoteKyma, err := s.getRemoteKyma(ctx)
+	remoteKyma, err := s.GetRemoteKyma(ctx)
 	if err != nil {
 		return err
 	}
@@ -60,7 +90,7 @@ func (s *SkrContext) RemoveFinalizersFromKyma(ctx context.Context) error {
 }
 
 func (s *SkrContext) DeleteKyma(ctx context.Context) error {
-	remoteKyma, err := s.getRemoteKyma(ctx)
+	remoteKyma, err := s.GetRemoteKyma(ctx)
 	if err != nil {
 		return err
 	}
@@ -74,7 +104,7 @@ func (s *SkrContext) DeleteKyma(ctx context.Context) error {
 func (s *SkrContext) CreateKymaNamespace(ctx context.Context) error {
 	namespace := &apicorev1.Namespace{
 		ObjectMeta: apimetav1.ObjectMeta{
-			Name: shared.DefaultRemoteNamespace,
+			Name: s.RemoteNamespace(),
 			Labels: map[string]string{
 				shared.ManagedBy:           shared.ManagedByLabelValue,
 				shared.IstioInjectionLabel: shared.EnabledValue,
@@ -134,7 +164,7 @@ func (s *SkrContext) createOrUpdateCRD(ctx context.Context, kcpClient client.Cli
 func (s *SkrContext) CreateOrFetchKyma(
 	ctx context.Context, kcpClient client.Client, kyma *v1beta2.Kyma,
 ) (*v1beta2.Kyma, error) {
-	remoteKyma, err := s.getRemoteKyma(ctx)
+	remoteKyma, err := s.GetRemoteKyma(ctx)
 	if meta.IsNoMatchError(err) || CRDNotFoundErr(err) {
 		if err := s.createOrUpdateCRD(ctx, kcpClient, shared.KymaKind.Plural()); err != nil {
 			return nil, err
@@ -147,6 +177,9 @@ func (s *SkrContext) CreateOrFetchKyma(
 			return nil, ErrNotFoundAndKCPKymaUnderDeleting
 		}
 		kyma.Spec.DeepCopyInto(&remoteKyma.Spec)
+		if err = ApplyProvisionerSpec(kyma, remoteKyma); err != nil {
+			return nil, err
+		}
 		err = s.Client.Create(ctx, remoteKyma)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create remote kyma: %w", err)
@@ -165,6 +198,9 @@ func (s *SkrContext) SynchronizeKyma(ctx context.Context, kcpKyma, remoteKyma *v
 	}
 
 	s.syncWatcherLabelsAnnotations(kcpKyma, remoteKyma)
+	if err := ApplyProvisionerSpec(kcpKyma, remoteKyma); err != nil {
+		return err
+	}
 	if err := s.Client.Update(ctx, remoteKyma); err != nil {
 		err = fmt.Errorf("failed to synchronise runtime kyma: %w", err)
 		s.event.Warning(kcpKyma, remoteUpdateFailure, err)
@@ -180,18 +216,48 @@ func (s *SkrContext) SynchronizeKyma(ctx context.Context, kcpKyma, remoteKyma *v
 	return nil
 }
 
-// ReplaceSpec replaces 'spec' attributes in control plane Kyma with values from Remote Kyma.
-func ReplaceSpec(controlPlaneKyma *v1beta2.Kyma, remoteKyma *v1beta2.Kyma) {
-	controlPlaneKyma.Spec.Modules = []v1beta2.Module{}
-	controlPlaneKyma.Spec.Modules = append(controlPlaneKyma.Spec.Modules, remoteKyma.Spec.Modules...)
-	controlPlaneKyma.Spec.Channel = remoteKyma.Spec.Channel
+// ReportSpecConflicts records the changes of the remote Kyma rejected by MergeSpec as warning events
+// on the control plane Kyma and on the remote Kyma.
+func (s *SkrContext) ReportSpecConflicts(ctx context.Context, kcpKyma, remoteKyma *v1beta2.Kyma,
+	conflicts []string,
+) error {
+	conflictErr := fmt.Errorf("%w: %s", ErrSpecConflict, strings.Join(conflicts, "; "))
+	s.event.Warning(kcpKyma, specConflict, conflictErr)
+
+	now := apimetav1.Now()
+	remoteEvent := &apicorev1.Event{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s.%x", remoteKyma.GetName(), now.UnixNano()),
+			Namespace: remoteKyma.GetNamespace(),
+		},
+		InvolvedObject: apicorev1.ObjectReference{
+			APIVersion:      v1beta2.GroupVersion.String(),
+			Kind:            string(shared.KymaKind),
+			Name:            remoteKyma.GetName(),
+			Namespace:       remoteKyma.GetNamespace(),
+			UID:             remoteKyma.GetUID(),
+			ResourceVersion: remoteKyma.GetResourceVersion(),
+		},
+		Reason:         string(specConflict),
+		Message:        conflictErr.Error(),
+		Type:           apicorev1.EventTypeWarning,
+		Source:         apicorev1.EventSource{Component: shared.OperatorName},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if err := s.Client.Create(ctx, remoteEvent); err != nil {
+		return fmt.Errorf("failed to record spec conflict on remote kyma: %w", err)
+	}
+	return nil
 }
 
-func (s *SkrContext) getRemoteKyma(ctx context.Context) (*v1beta2.Kyma, error) {
+// GetRemoteKyma fetches the Kyma from the SKR cluster, for tenants from their tenant namespace.
+func (s *SkrContext) GetRemoteKyma(ctx context.Context) (*v1beta2.Kyma, error) {
 	skrKyma := &v1beta2.Kyma{
 		ObjectMeta: apimetav1.ObjectMeta{
 			Name:      shared.DefaultRemoteKymaName,
-			Namespace: shared.DefaultRemoteNamespace,
+			Namespace: s.RemoteNamespace(),
 		},
 	}
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 
 	apicorev1 "k8s.io/api/core/v1"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
@@ -13,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/event"
 	"github.com/kyma-project/lifecycle-manager/pkg/common"
 )
@@ -27,13 +29,17 @@ type KymaSkrContextProvider struct {
 	clientCache *ClientCache
 	kcpClient   Client
 	event       event.Event
+	// tenantNamespaces holds the tenant namespace of the Kymas sharing their SKR cluster with other Kymas.
+	tenantNamespaces map[types.NamespacedName]string
+	tenantsMutex     sync.RWMutex
 }
 
 func NewKymaSkrContextProvider(kcpClient Client, clientCache *ClientCache, event event.Event) *KymaSkrContextProvider {
 	return &KymaSkrContextProvider{
-		clientCache: clientCache,
-		kcpClient:   kcpClient,
-		event:       event,
+		clientCache:      clientCache,
+		kcpClient:        kcpClient,
+		event:            event,
+		tenantNamespaces: make(map[types.NamespacedName]string),
 	}
 }
 
@@ -46,6 +52,12 @@ func (k *KymaSkrContextProvider) Init(ctx context.Context, kyma types.Namespaced
 		return nil
 	}
 
+	kcpKyma := &v1beta2.Kyma{}
+	if err := k.kcpClient.Get(ctx, kyma, kcpKyma); err != nil {
+		return fmt.Errorf("failed to get kyma: %w", err)
+	}
+	k.setTenantNamespace(kyma, kcpKyma.GetTenantNamespace())
+
 	kubeConfigSecretList := &apicorev1.SecretList{}
 	if err := k.kcpClient.List(ctx, kubeConfigSecretList, &client.ListOptions{
 		LabelSelector: k8slabels.SelectorFromSet(k8slabels.Set{shared.KymaName: kyma.Name}), Namespace: kyma.Namespace,
@@ -85,9 +97,26 @@ func (k *KymaSkrContextProvider) Get(kyma types.NamespacedName) (*SkrContext, er
 		return nil, ErrSkrClientContextNotFound
 	}
 
+	k.tenantsMutex.RLock()
+	tenantNamespace, isTenant := k.tenantNamespaces[kyma]
+	k.tenantsMutex.RUnlock()
+	if isTenant {
+		return NewTenantSkrContext(skrClient, k.event, tenantNamespace), nil
+	}
 	return NewSkrContext(skrClient, k.event), nil
 }
 
 func (k *KymaSkrContextProvider) InvalidateCache(kyma types.NamespacedName) {
 	k.clientCache.Delete(kyma)
+	k.setTenantNamespace(kyma, "")
+}
+
+func (k *KymaSkrContextProvider) setTenantNamespace(kyma types.NamespacedName, tenantNamespace string) {
+	k.tenantsMutex.Lock()
+	defer k.tenantsMutex.Unlock()
+	if tenantNamespace == "" {
+		delete(k.tenantNamespaces, kyma)
+		return
+	}
+	k.tenantNamespaces[kyma] = tenantNamespace
 }
@@ -1,14 +1,20 @@
 package remote_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/remote"
 	"github.com/kyma-project/lifecycle-manager/pkg/testutils"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
 )
 
 func TestReplaceWithVirtualKyma(t *testing.T) {
@@ -59,7 +65,8 @@ func TestReplaceWithVirtualKyma(t *testing.T) {
 			t.Parallel()
 			kcpKyma := createKyma(testCase.kcpKyma.channel, testCase.kcpKyma.modules)
 			remoteKyma := createKyma(testCase.remoteKyma.channel, testCase.remoteKyma.modules)
-			remote.ReplaceSpec(kcpKyma, remoteKyma)
+			conflicts := remote.MergeSpec(kcpKyma, remoteKyma)
+			assert.Empty(t, conflicts)
 			assert.Equal(t, testCase.expectedKyma.channel, kcpKyma.Spec.Channel)
 			var virtualModules []string
 			for _, module := range kcpKyma.Spec.Modules {
@@ -85,3 +92,23 @@ func createKyma(channel string, modules []string) *v1beta2.Kyma {
 	}
 	return kcpKyma
 }
+
+func TestSkrContext_GetRemoteKyma_FromTenantNamespace(t *testing.T) {
+	t.Parallel()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	tenantKyma := &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: shared.DefaultRemoteKymaName, Namespace: "team-a"},
+	}
+	clnt := remote.NewClientWithConfig(fake.NewClientBuilder().WithScheme(scheme).WithObjects(tenantKyma).Build(), nil)
+
+	_, err := remote.NewSkrContext(clnt, nil).GetRemoteKyma(context.Background())
+	require.True(t, util.IsNotFound(err))
+
+	tenantContext := remote.NewTenantSkrContext(clnt, nil, "team-a")
+	remoteKyma, err := tenantContext.GetRemoteKyma(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", remoteKyma.GetNamespace())
+	assert.Equal(t, "team-a", tenantContext.RemoteNamespace())
+	assert.Equal(t, shared.DefaultRemoteNamespace, remote.NewSkrContext(clnt, nil).RemoteNamespace())
+}
@@ -0,0 +1,125 @@
+package simulation
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
+)
+
+// LayoutPathExtractor reads the raw manifest layers from a local OCI image layout instead of pulling them from
+// the registry. The layers are looked up by their digest, the repository of the image spec is ignored.
+type LayoutPathExtractor struct {
+	layoutPath layout.Path
+	workDir    string
+	extractor  *img.PathExtractor
+}
+
+func NewLayoutPathExtractor(layoutPath, workDir string) (*LayoutPathExtractor, error) {
+	path, err := layout.FromPath(layoutPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI layout %s: %w", layoutPath, err)
+	}
+	return &LayoutPathExtractor{layoutPath: path, workDir: workDir, extractor: img.NewPathExtractor()}, nil
+}
+
+func (e *LayoutPathExtractor) GetPathFromRawManifest(_ context.Context, imageSpec v1beta2.ImageSpec,
+	_ authn.Keychain,
+) (string, error) {
+	return e.getPathFromLayer(imageSpec, v1beta2.RawManifestLayer)
+}
+
+func (e *LayoutPathExtractor) GetPathFromHooks(_ context.Context, imageSpec v1beta2.ImageSpec,
+	_ authn.Keychain,
+) (string, error) {
+	return e.getPathFromLayer(imageSpec, v1beta2.HooksLayer)
+}
+
+func (e *LayoutPathExtractor) getPathFromLayer(imageSpec v1beta2.ImageSpec, layerName v1beta2.LayerName,
+) (string, error) {
+	switch imageSpec.Type {
+	case v1beta2.OciRefType:
+		return e.copyBlob(imageSpec, string(layerName)+".yaml")
+	case v1beta2.OciDirType:
+		tarFile, err := e.copyBlob(imageSpec, string(layerName)+".tar")
+		if err != nil {
+			return "", err
+		}
+		extractedFile, err := e.extractor.ExtractLayer(tarFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to extract layer %s: %w", imageSpec.Ref, err)
+		}
+		return extractedFile, nil
+	default:
+		return "", img.ErrInvalidImageSpecType
+	}
+}
+
+// copyBlob copies the uncompressed blob of the layer into the work directory.
+func (e *LayoutPathExtractor) copyBlob(imageSpec v1beta2.ImageSpec, filename string) (string, error) {
+	hash, err := containerregistryv1.NewHash(imageSpec.Ref)
+	if err != nil {
+		return "", fmt.Errorf("invalid layer digest %s: %w", imageSpec.Ref, err)
+	}
+	blob, err := e.layoutPath.Blob(hash)
+	if err != nil {
+		return "", fmt.Errorf("%w %s from OCI layout: %w", img.ErrImageLayerPull, imageSpec.Ref, err)
+	}
+	defer blob.Close()
+	content, err := uncompressed(blob)
+	if err != nil {
+		return "", fmt.Errorf("failed to read layer %s: %w", imageSpec.Ref, err)
+	}
+
+	dir := filepath.Join(e.workDir, fmt.Sprintf("%s-%s", strings.ReplaceAll(imageSpec.Name, "/", "-"), hash.Hex))
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:mnd // permissions of the work directory
+		return "", fmt.Errorf("failed to create directory for layer %s: %w", imageSpec.Ref, err)
+	}
+	manifestPath := filepath.Join(dir, filename)
+	outFile, err := os.Create(manifestPath)
+	if err != nil {
+		return "", fmt.Errorf("file create failed for layer %s: %w", imageSpec.Ref, err)
+	}
+	defer outFile.Close()
+	if _, err := io.Copy(outFile, content); err != nil {
+		return "", fmt.Errorf("file copy failed for layer %s: %w", imageSpec.Ref, err)
+	}
+	return manifestPath, nil
+}
+
+func uncompressed(blob io.Reader) (io.Reader, error) {
+	reader := bufio.NewReader(blob)
+	magic, err := reader.Peek(2) //nolint:mnd // length of the gzip magic number
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read: %w", err)
+	}
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress: %w", err)
+		}
+		return gzipReader, nil
+	}
+	return reader, nil
+}
+
+// StaticKeyChainLookup returns the same keychain for all images.
+type StaticKeyChainLookup struct {
+	KeyChain authn.Keychain
+}
+
+func (l StaticKeyChainLookup) Get(_ context.Context, _ v1beta2.ImageSpec) (authn.Keychain, error) {
+	return l.KeyChain, nil
+}
@@ -0,0 +1,60 @@
+package simulation_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/simulation"
+)
+
+const rawManifest = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n"
+
+func TestLayoutPathExtractor_GetPathFromRawManifest(t *testing.T) {
+	t.Parallel()
+	layoutDir := t.TempDir()
+	layer := static.NewLayer([]byte(rawManifest), types.MediaType("application/x-yaml"))
+	image, err := mutate.AppendLayers(empty.Image, layer)
+	require.NoError(t, err)
+	layoutPath, err := layout.Write(layoutDir, empty.Index)
+	require.NoError(t, err)
+	require.NoError(t, layoutPath.AppendImage(image))
+	digest, err := layer.Digest()
+	require.NoError(t, err)
+
+	extractor, err := simulation.NewLayoutPathExtractor(layoutDir, t.TempDir())
+	require.NoError(t, err)
+	path, err := extractor.GetPathFromRawManifest(context.Background(), v1beta2.ImageSpec{
+		Name: "kyma-project.io/module/istio", Ref: digest.String(), Type: v1beta2.OciRefType,
+	}, nil)
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, rawManifest, string(bytes.TrimSpace(content))+"\n")
+}
+
+func TestLayoutPathExtractor_MissingLayer(t *testing.T) {
+	t.Parallel()
+	layoutDir := t.TempDir()
+	_, err := layout.Write(layoutDir, empty.Index)
+	require.NoError(t, err)
+
+	extractor, err := simulation.NewLayoutPathExtractor(layoutDir, t.TempDir())
+	require.NoError(t, err)
+	_, err = extractor.GetPathFromRawManifest(context.Background(), v1beta2.ImageSpec{
+		Name: "istio", Ref: "sha256:" + string(bytes.Repeat([]byte("0"), 64)), Type: v1beta2.OciRefType,
+	}, nil)
+
+	require.Error(t, err)
+}
@@ -0,0 +1,122 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+var ErrUnsupportedKind = errors.New("unsupported kind")
+
+// Objects are the control plane objects the simulation runs on.
+type Objects struct {
+	Kymas              []*v1beta2.Kyma
+	ModuleTemplates    []*v1beta2.ModuleTemplate
+	ModuleReleaseMetas []*v1beta2.ModuleReleaseMeta
+}
+
+// LoadObjects reads the Kyma, ModuleTemplate and ModuleReleaseMeta objects from the given YAML files, or from
+// the .yaml and .yml files in the given directories. Objects without a namespace are put into the given namespace.
+func LoadObjects(paths []string, namespace string) (*Objects, error) {
+	objects := &Objects{}
+	for _, path := range paths {
+		files, err := listFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if err := objects.loadFile(file, namespace); err != nil {
+				return nil, fmt.Errorf("failed to load %s: %w", file, err)
+			}
+		}
+	}
+	return objects, nil
+}
+
+func listFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && slices.Contains([]string{".yaml", ".yml"}, filepath.Ext(file)) {
+			files = append(files, file)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", path, err)
+	}
+	return files, nil
+}
+
+func (o *Objects) loadFile(file, namespace string) error {
+	reader, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer reader.Close()
+
+	decoder := k8syaml.NewYAMLOrJSONDecoder(reader, 4096) //nolint:mnd // buffer size of the decoder
+	for {
+		object := &unstructured.Unstructured{}
+		if err := decoder.Decode(&object.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to decode object: %w", err)
+		}
+		if len(object.Object) == 0 {
+			continue
+		}
+		if object.GetNamespace() == "" {
+			object.SetNamespace(namespace)
+		}
+		if err := o.add(object); err != nil {
+			return err
+		}
+	}
+}
+
+func (o *Objects) add(object *unstructured.Unstructured) error {
+	var typed any
+	switch shared.Kind(object.GetKind()) {
+	case shared.KymaKind:
+		kyma := &v1beta2.Kyma{}
+		o.Kymas = append(o.Kymas, kyma)
+		typed = kyma
+	case shared.ModuleTemplateKind:
+		template := &v1beta2.ModuleTemplate{}
+		o.ModuleTemplates = append(o.ModuleTemplates, template)
+		typed = template
+	case shared.ModuleReleaseMetaKind:
+		moduleReleaseMeta := &v1beta2.ModuleReleaseMeta{}
+		o.ModuleReleaseMetas = append(o.ModuleReleaseMetas, moduleReleaseMeta)
+		typed = moduleReleaseMeta
+	default:
+		return fmt.Errorf("%w: %s %s", ErrUnsupportedKind, object.GetKind(), object.GetName())
+	}
+	if err := machineryruntime.DefaultUnstructuredConverter.FromUnstructured(object.Object, typed); err != nil {
+		return fmt.Errorf("failed to convert %s %s: %w", object.GetKind(), object.GetName(), err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package simulation_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/simulation"
+)
+
+const objectsYAML = `apiVersion: operator.kyma-project.io/v1beta2
+kind: Kyma
+metadata:
+  name: kyma
+spec:
+  channel: regular
+  modules:
+  - name: istio
+---
+apiVersion: operator.kyma-project.io/v1beta2
+kind: ModuleReleaseMeta
+metadata:
+  name: istio
+  namespace: other
+spec:
+  moduleName: istio
+  channels:
+  - channel: regular
+    version: 1.0.0
+`
+
+const templateYAML = `apiVersion: operator.kyma-project.io/v1beta2
+kind: ModuleTemplate
+metadata:
+  name: istio-1.0.0
+spec:
+  moduleName: istio
+  version: 1.0.0
+`
+
+func TestLoadObjects(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kyma.yaml"), []byte(objectsYAML), 0o600))
+	templatesDir := filepath.Join(dir, "templates")
+	require.NoError(t, os.Mkdir(templatesDir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(templatesDir, "istio.yml"), []byte(templateYAML), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(templatesDir, "README.md"), []byte("no object"), 0o600))
+
+	objects, err := simulation.LoadObjects([]string{filepath.Join(dir, "kyma.yaml"), templatesDir}, "kcp-system")
+
+	require.NoError(t, err)
+	require.Len(t, objects.Kymas, 1)
+	assert.Equal(t, "kcp-system", objects.Kymas[0].Namespace)
+	assert.Equal(t, "istio", objects.Kymas[0].Spec.Modules[0].Name)
+	require.Len(t, objects.ModuleReleaseMetas, 1)
+	assert.Equal(t, "other", objects.ModuleReleaseMetas[0].Namespace)
+	assert.Equal(t, "1.0.0", objects.ModuleReleaseMetas[0].Spec.Channels[0].Version)
+	require.Len(t, objects.ModuleTemplates, 1)
+	assert.Equal(t, "1.0.0", objects.ModuleTemplates[0].Spec.Version)
+}
+
+func TestLoadObjects_RejectsUnsupportedKinds(t *testing.T) {
+	t.Parallel()
+	file := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n"), 0o600))
+
+	_, err := simulation.LoadObjects([]string{file}, "kcp-system")
+
+	require.ErrorIs(t, err, simulation.ErrUnsupportedKind)
+}
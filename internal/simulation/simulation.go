@@ -0,0 +1,150 @@
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	declarativev2 "github.com/kyma-project/lifecycle-manager/internal/declarative/v2"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/parser"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+)
+
+var ErrKymaNotFound = errors.New("kyma not found")
+
+type Options struct {
+	// InKCPMode marks the Manifests as remote, as done by Lifecycle Manager running in KCP.
+	InKCPMode           bool
+	RemoteSyncNamespace string
+	// SpecResolver resolves the raw manifests of the Manifests, rendering is skipped if it is nil.
+	SpecResolver *manifest.SpecResolver
+}
+
+// ModuleResult is the outcome of the simulation for a module of the Kyma.
+type ModuleResult struct {
+	Name     string
+	Template string
+	Version  string
+	Channel  string
+	// Err is set if the module could not be resolved or rendered, the other fields hold the outcome until then.
+	Err      error
+	Manifest *v1beta2.Manifest
+	// Resources are the objects created in the SKR cluster, starting with the module CR.
+	Resources []*unstructured.Unstructured
+}
+
+// Simulate resolves the ModuleTemplates for the modules of the given Kyma with the lookup of the Kyma controller,
+// generates the Manifests from them, and renders the objects the Manifest controller creates in the SKR cluster.
+// Maintenance windows are not taken into account, so upgrades are resolved as during an active maintenance window.
+func Simulate(ctx context.Context, objects *Objects, kymaName string, options Options) ([]ModuleResult, error) {
+	kyma, err := objects.getKyma(kymaName)
+	if err != nil {
+		return nil, err
+	}
+	clnt, err := objects.newClient()
+	if err != nil {
+		return nil, err
+	}
+
+	descriptorProvider := provider.NewCachedDescriptorProvider()
+	templateLookup := templatelookup.NewTemplateLookup(clnt, descriptorProvider,
+		moduletemplateinfolookup.NewDefaultModuleTemplateInfoLookupStrategies(clnt, nil, nil))
+	templates := templateLookup.GetRegularTemplates(ctx, kyma)
+	modules := parser.NewParser(clnt, descriptorProvider, options.InKCPMode, options.RemoteSyncNamespace).
+		GenerateModulesFromTemplates(kyma, templates)
+
+	renderOptions := declarativev2.DefaultOptions()
+	results := make([]ModuleResult, 0, len(modules))
+	for _, module := range modules {
+		result := ModuleResult{Name: module.ModuleName}
+		if module.Template != nil {
+			result.Channel = module.Template.DesiredChannel
+			result.Err = module.Template.Err
+			if module.Template.ModuleTemplate != nil {
+				result.Template = module.Template.GetName()
+				result.Version = module.Template.GetVersion()
+			}
+		}
+		if result.Err == nil && module.Manifest != nil {
+			module.ApplyDefaultMetaToManifest(kyma)
+			result.Manifest = module.Manifest
+			if options.SpecResolver != nil {
+				result.Resources, result.Err = render(ctx, options.SpecResolver, renderOptions, module.Manifest)
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// render renders the raw manifest of the Manifest and applies the transforms of the Manifest controller.
+func render(ctx context.Context, specResolver *manifest.SpecResolver, renderOptions *declarativev2.Options,
+	moduleManifest *v1beta2.Manifest,
+) ([]*unstructured.Unstructured, error) {
+	var resources []*unstructured.Unstructured
+	if moduleCR := modulecr.GetModuleCR(moduleManifest); moduleCR != nil {
+		resources = append(resources, moduleCR)
+	}
+	if moduleManifest.Spec.Install.Source.Raw == nil {
+		return resources, nil
+	}
+
+	spec, err := specResolver.GetSpec(ctx, moduleManifest)
+	if err != nil {
+		return resources, fmt.Errorf("failed to resolve spec: %w", err)
+	}
+	rendered, err := renderOptions.ManifestParser.Parse(spec)
+	if err != nil {
+		return resources, fmt.Errorf("failed to render raw manifest: %w", err)
+	}
+	for _, transform := range renderOptions.PostRenderTransforms {
+		if err := transform(ctx, moduleManifest, rendered.Items); err != nil {
+			return resources, fmt.Errorf("failed to transform rendered objects: %w", err)
+		}
+	}
+	return append(resources, rendered.Items...), nil
+}
+
+// getKyma returns the Kyma with the given name, or the only Kyma if no name is given.
+func (o *Objects) getKyma(name string) (*v1beta2.Kyma, error) {
+	if name == "" {
+		if len(o.Kymas) != 1 {
+			return nil, fmt.Errorf("%w: expected exactly one Kyma, found %d", ErrKymaNotFound, len(o.Kymas))
+		}
+		return o.Kymas[0], nil
+	}
+	for _, kyma := range o.Kymas {
+		if kyma.GetName() == name {
+			return kyma, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrKymaNotFound, name)
+}
+
+func (o *Objects) newClient() (client.Client, error) {
+	scheme := machineryruntime.NewScheme()
+	if err := v1beta2.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("failed to add scheme: %w", err)
+	}
+	var objects []client.Object
+	for _, kyma := range o.Kymas {
+		objects = append(objects, kyma.DeepCopy())
+	}
+	for _, template := range o.ModuleTemplates {
+		objects = append(objects, template)
+	}
+	for _, moduleReleaseMeta := range o.ModuleReleaseMetas {
+		objects = append(objects, moduleReleaseMeta)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(), nil
+}
@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+const (
+	ExporterNone = "none"
+	ExporterOTLP = "otlp"
+)
+
+var ErrUnknownExporter = errors.New("unknown tracing exporter")
+
+type Options struct {
+	// Exporter is either ExporterNone or ExporterOTLP.
+	Exporter string
+	// OTLPEndpoint is the host and port of the OTLP gRPC receiver, the OTLP environment variables are used if empty.
+	OTLPEndpoint string
+	OTLPInsecure bool
+	// SampleRatio is the ratio of the traces that are sampled, traces continued from a sampled parent are always
+	// sampled.
+	SampleRatio float64
+}
+
+// ShutdownFunc flushes the remaining spans and stops the exporter.
+type ShutdownFunc func(ctx context.Context) error
+
+// Setup sets up the global tracer provider with the exporter of the options.
+// With ExporterNone, the spans are discarded.
+func Setup(ctx context.Context, options Options) (ShutdownFunc, error) {
+	var exporter sdktrace.SpanExporter
+	switch options.Exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		var clientOptions []otlptracegrpc.Option
+		if options.OTLPEndpoint != "" {
+			clientOptions = append(clientOptions, otlptracegrpc.WithEndpoint(options.OTLPEndpoint))
+		}
+		if options.OTLPInsecure {
+			clientOptions = append(clientOptions, otlptracegrpc.WithInsecure())
+		}
+		otlpExporter, err := otlptracegrpc.New(ctx, clientOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+		}
+		exporter = otlpExporter
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownExporter, options.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(options.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(shared.OperatorName))),
+	)
+	SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// SetTracerProvider sets the global tracer provider and the W3C Trace Context propagator.
+func SetTracerProvider(provider *sdktrace.TracerProvider) {
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
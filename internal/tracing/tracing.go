@@ -0,0 +1,98 @@
+// Package tracing creates the OpenTelemetry spans of the reconciliations and propagates the trace context
+// from the Kyma to the Manifest controller.
+//
+// Spans are created with the global tracer provider, which discards them unless a provider is set up with Setup.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+const (
+	instrumentationName = "github.com/kyma-project/lifecycle-manager"
+	traceParentHeader   = "traceparent"
+)
+
+const (
+	KymaKey     attribute.Key = "kyma"
+	ManifestKey attribute.Key = "manifest"
+	ModuleKey   attribute.Key = "module"
+	ResourceKey attribute.Key = "resource"
+	ImageKey    attribute.Key = "image"
+	StateKey    attribute.Key = "state"
+)
+
+// traceContext is the W3C Trace Context format used in the trace context annotation.
+var traceContext = propagation.TraceContext{} //nolint:gochecknoglobals // stateless propagator
+
+// Start starts a span as child of the span in the context.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	// the tracer is resolved on every call, so that a tracer provider set up later is used
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End marks the span as failed if err is not nil and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func Kyma(kyma types.NamespacedName) attribute.KeyValue {
+	return KymaKey.String(kyma.String())
+}
+
+// InjectIntoAnnotations stores the trace context of the span in the context in the annotations of the object,
+// so that the reconciliation of the object can be followed in the same trace.
+func InjectIntoAnnotations(ctx context.Context, obj client.Object) {
+	carrier := propagation.MapCarrier{}
+	traceContext.Inject(ctx, carrier)
+	traceParent := carrier.Get(traceParentHeader)
+	if traceParent == "" {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[shared.TraceParentAnnotation] = traceParent
+	obj.SetAnnotations(annotations)
+}
+
+// ExtractFromAnnotations returns the trace context stored in the annotations of the object.
+// The returned context does not contain a span if the object has no valid trace context.
+func ExtractFromAnnotations(ctx context.Context, obj client.Object) context.Context {
+	traceParent, found := obj.GetAnnotations()[shared.TraceParentAnnotation]
+	if !found {
+		return ctx
+	}
+	return traceContext.Extract(ctx, propagation.MapCarrier{traceParentHeader: traceParent})
+}
+
+// StartFromAnnotations starts a span for the reconciliation of the object. If continueTrace is true, the span
+// continues the trace stored in the annotations of the object, otherwise a new trace is started linking to it.
+func StartFromAnnotations(ctx context.Context, obj client.Object, continueTrace bool, name string,
+	attributes ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	remoteCtx := ExtractFromAnnotations(ctx, obj)
+	if continueTrace {
+		return Start(remoteCtx, name, attributes...)
+	}
+	options := []trace.SpanStartOption{trace.WithAttributes(attributes...)}
+	if remote := trace.SpanContextFromContext(remoteCtx); remote.IsValid() {
+		options = append(options, trace.WithLinks(trace.Link{SpanContext: remote}))
+	}
+	return otel.Tracer(instrumentationName).Start(ctx, name, options...)
+}
@@ -0,0 +1,107 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/tracing"
+)
+
+var errPhaseFailed = errors.New("phase failed")
+
+// The tests set the global tracer provider and must not run in parallel.
+
+func TestStartAndEnd_RecordSpansWithErrors(t *testing.T) {
+	provider, exporter := newInMemoryTracerProvider()
+	tracing.SetTracerProvider(provider)
+
+	ctx, parent := tracing.Start(context.Background(), "Kyma.Reconcile", tracing.KymaKey.String("kcp-system/kyma"))
+	_, child := tracing.Start(ctx, "Kyma.SyncRemoteCrds")
+	tracing.End(child, errPhaseFailed)
+	tracing.End(parent, nil)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "Kyma.SyncRemoteCrds", spans[0].Name)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, codes.Unset, spans[1].Status.Code)
+	assert.Contains(t, spans[1].Attributes, tracing.KymaKey.String("kcp-system/kyma"))
+}
+
+func TestAnnotations_PropagateTraceContext(t *testing.T) {
+	provider, exporter := newInMemoryTracerProvider()
+	tracing.SetTracerProvider(provider)
+	manifest := &v1beta2.Manifest{ObjectMeta: apimetav1.ObjectMeta{Name: "manifest"}}
+
+	ctx, kymaSpan := tracing.Start(context.Background(), "Kyma.Reconcile")
+	tracing.InjectIntoAnnotations(ctx, manifest)
+	tracing.End(kymaSpan, nil)
+	_, manifestSpan := tracing.Start(tracing.ExtractFromAnnotations(context.Background(), manifest),
+		"Manifest.Reconcile")
+	tracing.End(manifestSpan, nil)
+
+	assert.NotEmpty(t, manifest.GetAnnotations()[shared.TraceParentAnnotation])
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID())
+	assert.Equal(t, spans[0].SpanContext.SpanID(), spans[1].Parent.SpanID())
+}
+
+func TestAnnotations_IgnoreMissingTraceContext(t *testing.T) {
+	manifest := &v1beta2.Manifest{}
+
+	tracing.InjectIntoAnnotations(context.Background(), manifest)
+	ctx := tracing.ExtractFromAnnotations(context.Background(), manifest)
+
+	assert.Empty(t, manifest.GetAnnotations())
+	assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+}
+
+func TestSetup(t *testing.T) {
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{Exporter: tracing.ExporterNone})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+
+	_, err = tracing.Setup(context.Background(), tracing.Options{Exporter: "jaeger"})
+	require.ErrorIs(t, err, tracing.ErrUnknownExporter)
+}
+
+func TestStartFromAnnotations_LinksToTraceIfNotContinued(t *testing.T) {
+	provider, exporter := newInMemoryTracerProvider()
+	tracing.SetTracerProvider(provider)
+	manifest := &v1beta2.Manifest{}
+	ctx, kymaSpan := tracing.Start(context.Background(), "Kyma.Reconcile")
+	tracing.InjectIntoAnnotations(ctx, manifest)
+	tracing.End(kymaSpan, nil)
+
+	_, continued := tracing.StartFromAnnotations(context.Background(), manifest, true, "Manifest.Reconcile")
+	tracing.End(continued, nil)
+	_, linked := tracing.StartFromAnnotations(context.Background(), manifest, false, "Manifest.Reconcile")
+	tracing.End(linked, nil)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+	kymaSpanContext := spans[0].SpanContext
+	assert.Equal(t, kymaSpanContext.TraceID(), spans[1].SpanContext.TraceID())
+	assert.NotEqual(t, kymaSpanContext.TraceID(), spans[2].SpanContext.TraceID())
+	require.Len(t, spans[2].Links, 1)
+	assert.Equal(t, kymaSpanContext.SpanID(), spans[2].Links[0].SpanContext.SpanID())
+}
+
+// newInMemoryTracerProvider returns a tracer provider recording all spans synchronously in the returned exporter.
+func newInMemoryTracerProvider() (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	return sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)), exporter
+}
@@ -0,0 +1,70 @@
+package upgradeapprovals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+type UpgradeApprovalGate struct {
+	client client.Reader
+}
+
+func NewUpgradeApprovalGate(clnt client.Reader) UpgradeApprovalGate {
+	return UpgradeApprovalGate{client: clnt}
+}
+
+// IsRequired determines if an approval is required to update the given module.
+func (UpgradeApprovalGate) IsRequired(moduleTemplate *v1beta2.ModuleTemplate, kyma *v1beta2.Kyma) bool {
+	if !kyma.HasGatedUpgrades() {
+		return false
+	}
+
+	// module not installed yet => no need for an approval
+	moduleStatus := kyma.Status.GetModuleStatus(moduleTemplate.GetModuleName())
+	if moduleStatus == nil || moduleStatus.Version == "" {
+		return false
+	}
+
+	// module already installed in this version => no need for an approval
+	return moduleStatus.Version != moduleTemplate.GetVersion()
+}
+
+// IsApproved determines if the update of the given module to the version of the ModuleTemplate is approved.
+func (g UpgradeApprovalGate) IsApproved(ctx context.Context, moduleTemplate *v1beta2.ModuleTemplate,
+	kyma *v1beta2.Kyma,
+) (bool, error) {
+	approval, err := Find(ctx, g.client, kyma, moduleTemplate.GetModuleName(), moduleTemplate.GetVersion(),
+		time.Now())
+	if err != nil {
+		return false, err
+	}
+	return approval != nil, nil
+}
+
+// Find returns the UpgradeApproval which approves the upgrade of the module of the Kyma to the version at the given
+// time, or nil if there is none. Of several approvals, the one expiring last is returned.
+func Find(ctx context.Context, clnt client.Reader, kyma *v1beta2.Kyma, module, version string,
+	now time.Time,
+) (*v1beta2.UpgradeApproval, error) {
+	approvals := &v1beta2.UpgradeApprovalList{}
+	if err := clnt.List(ctx, approvals, client.InNamespace(kyma.GetNamespace())); err != nil {
+		return nil, fmt.Errorf("failed to list upgrade approvals: %w", err)
+	}
+	var found *v1beta2.UpgradeApproval
+	for index := range approvals.Items {
+		approval := &approvals.Items[index]
+		if approval.Spec.KymaName != kyma.GetName() || approval.Spec.Module != module ||
+			approval.Spec.Version != version || approval.IsExpired(now) {
+			continue
+		}
+		if found == nil || approval.Spec.ExpiresAt.After(found.Spec.ExpiresAt.Time) {
+			found = approval
+		}
+	}
+	return found, nil
+}
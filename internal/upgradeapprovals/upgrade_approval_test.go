@@ -0,0 +1,120 @@
+package upgradeapprovals_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/upgradeapprovals"
+)
+
+func TestIsRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		gated            bool
+		installedVersion string
+		want             bool
+	}{
+		{name: "Kyma without gated upgrades", gated: false, installedVersion: "1.0.0", want: false},
+		{name: "module not installed", gated: true, installedVersion: "", want: false},
+		{name: "module installed in the version", gated: true, installedVersion: "1.1.0", want: false},
+		{name: "module installed in another version", gated: true, installedVersion: "1.0.0", want: true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			kyma := newKyma(testCase.gated, testCase.installedVersion)
+			gate := upgradeapprovals.NewUpgradeApprovalGate(newClient(t))
+
+			assert.Equal(t, testCase.want, gate.IsRequired(newModuleTemplate("1.1.0"), kyma))
+		})
+	}
+}
+
+func TestIsApproved(t *testing.T) {
+	tests := []struct {
+		name     string
+		approval *v1beta2.UpgradeApproval
+		want     bool
+	}{
+		{name: "no approval", approval: nil, want: false},
+		{name: "approval of the version", approval: newApproval("kyma", "1.1.0", time.Hour), want: true},
+		{name: "approval of another version", approval: newApproval("kyma", "1.2.0", time.Hour), want: false},
+		{name: "approval of another Kyma", approval: newApproval("other", "1.1.0", time.Hour), want: false},
+		{name: "expired approval", approval: newApproval("kyma", "1.1.0", -time.Minute), want: false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var objects []client.Object
+			if testCase.approval != nil {
+				objects = append(objects, testCase.approval)
+			}
+			gate := upgradeapprovals.NewUpgradeApprovalGate(newClient(t, objects...))
+
+			approved, err := gate.IsApproved(context.Background(), newModuleTemplate("1.1.0"), newKyma(true, "1.0.0"))
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.want, approved)
+		})
+	}
+}
+
+func TestFind_ReturnsApprovalExpiringLast(t *testing.T) {
+	first := newApproval("kyma", "1.1.0", time.Hour)
+	last := newApproval("kyma", "1.1.0", 2*time.Hour)
+	last.SetName("approval-2")
+
+	approval, err := upgradeapprovals.Find(context.Background(), newClient(t, first, last), newKyma(true, "1.0.0"),
+		"template-operator", "1.1.0", time.Now())
+
+	require.NoError(t, err)
+	require.NotNil(t, approval)
+	assert.Equal(t, "approval-2", approval.GetName())
+}
+
+func newClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func newKyma(gated bool, installedVersion string) *v1beta2.Kyma {
+	kyma := &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "kyma", Namespace: "kcp-system"},
+	}
+	if gated {
+		kyma.SetLabels(map[string]string{shared.UpgradeApprovalLabel: shared.EnableLabelValue})
+	}
+	if installedVersion != "" {
+		kyma.Status.Modules = []v1beta2.ModuleStatus{{Name: "template-operator", Version: installedVersion}}
+	}
+	return kyma
+}
+
+func newModuleTemplate(version string) *v1beta2.ModuleTemplate {
+	return &v1beta2.ModuleTemplate{
+		Spec: v1beta2.ModuleTemplateSpec{ModuleName: "template-operator", Version: version},
+	}
+}
+
+func newApproval(kymaName, version string, expiresIn time.Duration) *v1beta2.UpgradeApproval {
+	return &v1beta2.UpgradeApproval{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "approval", Namespace: "kcp-system"},
+		Spec: v1beta2.UpgradeApprovalSpec{
+			KymaName:   kymaName,
+			Module:     "template-operator",
+			Version:    version,
+			ExpiresAt:  apimetav1.NewTime(time.Now().Add(expiresIn)),
+			ApprovedBy: "operator@example.com",
+		},
+	}
+}
@@ -3,6 +3,7 @@ package watch
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +44,37 @@ func (h *MandatoryTemplateChangeHandler) Watch() handler.MapFunc {
 	}
 }
 
+// WatchModuleReleaseMeta maps a ModuleReleaseMeta of a mandatory module to all Kymas, as its mandatory rollout
+// determines the Kymas the module is installed on, and in which version.
+func (h *MandatoryTemplateChangeHandler) WatchModuleReleaseMeta() handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		emptyRequest := make([]reconcile.Request, 0)
+		moduleReleaseMeta, ok := o.(*v1beta2.ModuleReleaseMeta)
+		if !ok {
+			return emptyRequest
+		}
+
+		templates := &v1beta2.ModuleTemplateList{}
+		if err := h.List(ctx, templates, client.InNamespace(moduleReleaseMeta.GetNamespace()),
+			client.MatchingLabels{shared.IsMandatoryModule: shared.EnableLabelValue}); err != nil {
+			return emptyRequest
+		}
+		if !slices.ContainsFunc(templates.Items, func(template v1beta2.ModuleTemplate) bool {
+			return template.Spec.ModuleName == moduleReleaseMeta.Spec.ModuleName ||
+				template.Labels[shared.ModuleName] == moduleReleaseMeta.Spec.ModuleName
+		}) {
+			return nil
+		}
+
+		kymas, err := getKymaList(ctx, h)
+		if err != nil {
+			return emptyRequest
+		}
+
+		return getRequestItems(kymas.Items)
+	}
+}
+
 func getKymaList(ctx context.Context, clnt client.Reader) (*v1beta2.KymaList, error) {
 	kymas := &v1beta2.KymaList{}
 	listOptions := &client.ListOptions{
@@ -0,0 +1,50 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// ForwardSKREvent forwards an SKR event received for a Kyma of another shard to the replica owning the shard,
+// by setting the SKREventAnnotation of the Kyma. Events of deleted Kymas are dropped.
+func ForwardSKREvent(ctx context.Context, clnt client.Client, kymaKey client.ObjectKey, now time.Time) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{shared.SKREventAnnotation: now.UTC().Format(time.RFC3339Nano)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal skr event patch: %w", err)
+	}
+	kyma := &v1beta2.Kyma{ObjectMeta: apimetav1.ObjectMeta{Name: kymaKey.Name, Namespace: kymaKey.Namespace}}
+	if err := clnt.Patch(ctx, kyma, client.RawPatch(types.MergePatchType, patch)); err != nil &&
+		!util.IsNotFound(err) {
+		return fmt.Errorf("failed to forward skr event to kyma %s: %w", kymaKey, err)
+	}
+	return nil
+}
+
+// SKREventForwardedPredicate lets Kyma updates pass which carry an SKR event forwarded by another replica.
+func SKREventForwardedPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+			return e.ObjectOld.GetAnnotations()[shared.SKREventAnnotation] !=
+				e.ObjectNew.GetAnnotations()[shared.SKREventAnnotation]
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package watch_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/watch"
+)
+
+func TestForwardSKREvent_SetsAnnotation(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	kyma := &v1beta2.Kyma{ObjectMeta: apimetav1.ObjectMeta{Name: "kyma", Namespace: "kcp-system"}}
+	kcpClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kyma).Build()
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	err := watch.ForwardSKREvent(context.Background(), kcpClient, client.ObjectKeyFromObject(kyma), now)
+
+	require.NoError(t, err)
+	forwarded := &v1beta2.Kyma{}
+	require.NoError(t, kcpClient.Get(context.Background(), client.ObjectKeyFromObject(kyma), forwarded))
+	assert.Equal(t, "2025-01-02T03:04:05Z", forwarded.GetAnnotations()[shared.SKREventAnnotation])
+}
+
+func TestForwardSKREvent_IgnoresDeletedKyma(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	kcpClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	err := watch.ForwardSKREvent(context.Background(), kcpClient,
+		client.ObjectKey{Name: "kyma", Namespace: "kcp-system"}, time.Now())
+
+	require.NoError(t, err)
+}
+
+func TestSKREventForwardedPredicate(t *testing.T) {
+	forwardedKyma := func(value string) *v1beta2.Kyma {
+		return &v1beta2.Kyma{ObjectMeta: apimetav1.ObjectMeta{
+			Annotations: map[string]string{shared.SKREventAnnotation: value},
+		}}
+	}
+	eventPredicate := watch.SKREventForwardedPredicate()
+
+	assert.True(t, eventPredicate.Update(event.UpdateEvent{
+		ObjectOld: forwardedKyma("2025-01-01T00:00:00Z"), ObjectNew: forwardedKyma("2025-01-01T00:00:01Z"),
+	}))
+	assert.True(t, eventPredicate.Update(event.UpdateEvent{
+		ObjectOld: &v1beta2.Kyma{}, ObjectNew: forwardedKyma("2025-01-01T00:00:00Z"),
+	}))
+	assert.False(t, eventPredicate.Update(event.UpdateEvent{
+		ObjectOld: forwardedKyma("2025-01-01T00:00:00Z"), ObjectNew: forwardedKyma("2025-01-01T00:00:00Z"),
+	}))
+}
@@ -0,0 +1,23 @@
+package watch
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// UpgradeApprovalToKyma maps an UpgradeApproval to the Kyma whose upgrade it approves, so that the approved upgrade
+// does not wait for the next periodic reconciliation of the Kyma.
+func UpgradeApprovalToKyma(_ context.Context, obj client.Object) []reconcile.Request {
+	approval, ok := obj.(*v1beta2.UpgradeApproval)
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Name: approval.Spec.KymaName, Namespace: approval.GetNamespace()},
+	}}
+}
@@ -0,0 +1,28 @@
+package watch_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/watch"
+)
+
+func TestUpgradeApprovalToKyma(t *testing.T) {
+	approval := &v1beta2.UpgradeApproval{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "approval", Namespace: "kcp-system"},
+		Spec:       v1beta2.UpgradeApprovalSpec{KymaName: "kyma"},
+	}
+
+	requests := watch.UpgradeApprovalToKyma(context.Background(), approval)
+
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: "kyma", Namespace: "kcp-system"}},
+	}, requests)
+	assert.Empty(t, watch.UpgradeApprovalToKyma(context.Background(), &v1beta2.Kyma{}))
+}
@@ -0,0 +1,35 @@
+package v1beta2
+
+import (
+	"errors"
+	"fmt"
+
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+const ConversionPath = "/convert"
+
+var ErrNotConvertible = errors.New("not convertible between the API versions")
+
+// SetupConversionWebhookWithManager registers the conversion webhook between the v1beta1 and v1beta2 versions of
+// the Kyma, Manifest, ModuleTemplate and Watcher CRDs in the manager. It is set up before the validating webhooks,
+// whose builders only register the conversion webhook if its path is not handled yet.
+func SetupConversionWebhookWithManager(mgr ctrl.Manager) error {
+	for _, obj := range []machineryruntime.Object{
+		&v1beta2.Kyma{}, &v1beta2.Manifest{}, &v1beta2.ModuleTemplate{}, &v1beta2.Watcher{},
+	} {
+		convertible, err := conversion.IsConvertible(mgr.GetScheme(), obj)
+		if err != nil {
+			return fmt.Errorf("failed to setup conversion webhook: %w", err)
+		}
+		if !convertible {
+			return fmt.Errorf("failed to setup conversion webhook: %T is %w", obj, ErrNotConvertible)
+		}
+	}
+	mgr.GetWebhookServer().Register(ConversionPath, conversion.NewWebhookHandler(mgr.GetScheme()))
+	return nil
+}
@@ -0,0 +1,167 @@
+package v1beta2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+var ErrUnexpectedObject = errors.New("unexpected object type")
+
+// SetupKymaWebhookWithManager registers the validating webhook for Kyma in the manager.
+func SetupKymaWebhookWithManager(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1beta2.Kyma{}).
+		WithValidator(NewKymaValidator(mgr.GetClient())).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to setup Kyma webhook: %w", err)
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-operator-kyma-project-io-v1beta2-kyma,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kyma-project.io,resources=kymas,verbs=create;update,versions=v1beta2,name=vkyma-v1beta2.operator.kyma-project.io,admissionReviewVersions=v1
+
+// KymaValidator rejects Kymas with duplicate modules or with modules in a channel which is not offered
+// by the ModuleReleaseMeta of the module.
+type KymaValidator struct {
+	client client.Reader
+}
+
+func NewKymaValidator(client client.Reader) *KymaValidator {
+	return &KymaValidator{client: client}
+}
+
+func (v *KymaValidator) ValidateCreate(ctx context.Context, obj machineryruntime.Object) (admission.Warnings, error) {
+	kyma, ok := obj.(*v1beta2.Kyma)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a Kyma but got %T", ErrUnexpectedObject, obj)
+	}
+	return v.validate(ctx, nil, kyma)
+}
+
+func (v *KymaValidator) ValidateUpdate(ctx context.Context,
+	oldObj, newObj machineryruntime.Object,
+) (admission.Warnings, error) {
+	oldKyma, ok := oldObj.(*v1beta2.Kyma)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a Kyma but got %T", ErrUnexpectedObject, oldObj)
+	}
+	kyma, ok := newObj.(*v1beta2.Kyma)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a Kyma but got %T", ErrUnexpectedObject, newObj)
+	}
+	// finalizers have to be removable independent of the spec
+	if !kyma.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	return v.validate(ctx, oldKyma, kyma)
+}
+
+func (v *KymaValidator) ValidateDelete(_ context.Context, _ machineryruntime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate only checks the channels of modules which are new or whose channel changed compared to the old Kyma,
+// so that updates of the Kyma are not rejected because a channel was removed from a ModuleReleaseMeta afterwards.
+func (v *KymaValidator) validate(ctx context.Context,
+	oldKyma, kyma *v1beta2.Kyma,
+) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	var allErrs field.ErrorList
+	modulesPath := field.NewPath("spec", "modules")
+
+	oldChannels := map[string]string{}
+	if oldKyma != nil {
+		for _, module := range oldKyma.Spec.Modules {
+			oldChannels[module.Name] = effectiveChannel(module, oldKyma)
+		}
+	}
+
+	seen := make(map[string]bool, len(kyma.Spec.Modules))
+	for i, module := range kyma.Spec.Modules {
+		modulePath := modulesPath.Index(i)
+		if seen[module.Name] {
+			allErrs = append(allErrs, field.Duplicate(modulePath.Child("name"), module.Name))
+			continue
+		}
+		seen[module.Name] = true
+
+		channel := effectiveChannel(module, kyma)
+		if oldChannel, found := oldChannels[module.Name]; found && oldChannel == channel {
+			continue
+		}
+		warning, fieldErr, err := v.validateChannel(ctx, module.Name, channel, kyma.Namespace, modulePath)
+		if err != nil {
+			return nil, err
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+		if fieldErr != nil {
+			allErrs = append(allErrs, fieldErr)
+		}
+	}
+
+	// the remote Kyma and the module installations of a tenant can not be moved to another namespace
+	if oldKyma != nil && oldKyma.GetTenantNamespace() != kyma.GetTenantNamespace() {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("metadata", "labels").Key(shared.TenantNamespaceLabel),
+			"the tenant namespace of a Kyma can not be changed"))
+	}
+
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+	return warnings, apierrors.NewInvalid(v1beta2.GroupVersion.WithKind(string(shared.KymaKind)).GroupKind(),
+		kyma.Name, allErrs)
+}
+
+func (v *KymaValidator) validateChannel(ctx context.Context,
+	moduleName, channel, namespace string, modulePath *field.Path,
+) (string, *field.Error, error) {
+	if channel == "" {
+		return "", nil, nil
+	}
+	moduleReleaseMeta, err := templatelookup.GetModuleReleaseMeta(ctx, v.client, moduleName, namespace)
+	if util.IsNotFound(err) {
+		return fmt.Sprintf("%s: no ModuleReleaseMeta found for module %s, its channel can not be validated",
+			modulePath.String(), moduleName), nil, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	if _, err = templatelookup.GetChannelVersionForModule(moduleReleaseMeta, channel); err != nil {
+		if errors.Is(err, templatelookup.ErrChannelNotFound) || errors.Is(err, templatelookup.ErrNoChannelsFound) {
+			return "", field.NotSupported(modulePath.Child("channel"), channel,
+				offeredChannels(moduleReleaseMeta)), nil
+		}
+		return "", nil, err
+	}
+	return "", nil, nil
+}
+
+func effectiveChannel(module v1beta2.Module, kyma *v1beta2.Kyma) string {
+	if module.Channel != "" {
+		return module.Channel
+	}
+	return kyma.Spec.Channel
+}
+
+func offeredChannels(moduleReleaseMeta *v1beta2.ModuleReleaseMeta) []string {
+	channels := make([]string, 0, len(moduleReleaseMeta.Spec.Channels))
+	for _, assignment := range moduleReleaseMeta.Spec.Channels {
+		channels = append(channels, assignment.Channel)
+	}
+	return channels
+}
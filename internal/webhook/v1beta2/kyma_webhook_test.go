@@ -0,0 +1,125 @@
+package v1beta2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	webhookv1beta2 "github.com/kyma-project/lifecycle-manager/internal/webhook/v1beta2"
+)
+
+const namespace = "kcp-system"
+
+func TestKymaValidator_ValidateCreate(t *testing.T) {
+	t.Parallel()
+	validator := webhookv1beta2.NewKymaValidator(newFakeClient(t,
+		newModuleReleaseMeta("istio", v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "1.0.0"})))
+
+	tests := []struct {
+		name          string
+		modules       []v1beta2.Module
+		expectInvalid bool
+		expectWarning bool
+	}{
+		{
+			name:    "module in offered channel",
+			modules: []v1beta2.Module{{Name: "istio"}},
+		},
+		{
+			name:          "module in channel not offered by the ModuleReleaseMeta",
+			modules:       []v1beta2.Module{{Name: "istio", Channel: "fast"}},
+			expectInvalid: true,
+		},
+		{
+			name:          "duplicate modules",
+			modules:       []v1beta2.Module{{Name: "istio"}, {Name: "istio"}},
+			expectInvalid: true,
+		},
+		{
+			name:          "module without ModuleReleaseMeta",
+			modules:       []v1beta2.Module{{Name: "keda", Channel: "fast"}},
+			expectWarning: true,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			warnings, err := validator.ValidateCreate(context.Background(), newKyma("regular", testCase.modules...))
+
+			assert.Equal(t, testCase.expectInvalid, apierrors.IsInvalid(err))
+			if !testCase.expectInvalid {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.expectWarning, len(warnings) > 0)
+		})
+	}
+}
+
+func TestKymaValidator_ValidateUpdate_OnlyValidatesChangedChannels(t *testing.T) {
+	t.Parallel()
+	validator := webhookv1beta2.NewKymaValidator(newFakeClient(t,
+		newModuleReleaseMeta("istio", v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "1.0.0"})))
+	// the channel fast was removed from the ModuleReleaseMeta after the module was added
+	oldKyma := newKyma("regular", v1beta2.Module{Name: "istio", Channel: "fast"})
+
+	kyma := newKyma("regular", v1beta2.Module{Name: "istio", Channel: "fast"}, v1beta2.Module{Name: "keda"})
+	_, err := validator.ValidateUpdate(context.Background(), oldKyma, kyma)
+	require.NoError(t, err)
+
+	kyma = newKyma("fast", v1beta2.Module{Name: "istio"})
+	_, err = validator.ValidateUpdate(context.Background(), newKyma("regular", v1beta2.Module{Name: "istio"}), kyma)
+	assert.True(t, apierrors.IsInvalid(err))
+}
+
+func TestKymaValidator_ValidateUpdate_RejectsTenantNamespaceChange(t *testing.T) {
+	t.Parallel()
+	validator := webhookv1beta2.NewKymaValidator(newFakeClient(t))
+	oldKyma := newKyma("regular")
+	oldKyma.Labels = map[string]string{shared.TenantNamespaceLabel: "team-a"}
+
+	kyma := oldKyma.DeepCopy()
+	kyma.Labels[shared.RegionLabel] = "europe"
+	_, err := validator.ValidateUpdate(context.Background(), oldKyma, kyma)
+	require.NoError(t, err)
+
+	kyma.Labels[shared.TenantNamespaceLabel] = "team-b"
+	_, err = validator.ValidateUpdate(context.Background(), oldKyma, kyma)
+	assert.True(t, apierrors.IsInvalid(err))
+}
+
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func newKyma(channel string, modules ...v1beta2.Module) *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "kyma", Namespace: namespace},
+		Spec:       v1beta2.KymaSpec{Channel: channel, Modules: modules},
+	}
+}
+
+func newModuleReleaseMeta(moduleName string, channels ...v1beta2.ChannelVersionAssignment) *v1beta2.ModuleReleaseMeta {
+	return &v1beta2.ModuleReleaseMeta{
+		ObjectMeta: apimetav1.ObjectMeta{Name: moduleName, Namespace: namespace},
+		Spec:       v1beta2.ModuleReleaseMetaSpec{ModuleName: moduleName, Channels: channels},
+	}
+}
+
+func newModuleTemplate(moduleName, version string, mandatory bool) *v1beta2.ModuleTemplate {
+	return &v1beta2.ModuleTemplate{
+		ObjectMeta: apimetav1.ObjectMeta{Name: moduleName + "-" + version, Namespace: namespace},
+		Spec:       v1beta2.ModuleTemplateSpec{ModuleName: moduleName, Version: version, Mandatory: mandatory},
+	}
+}
@@ -0,0 +1,117 @@
+package v1beta2
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// SetupModuleReleaseMetaWebhookWithManager registers the validating webhook for ModuleReleaseMeta in the manager.
+func SetupModuleReleaseMetaWebhookWithManager(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1beta2.ModuleReleaseMeta{}).
+		WithValidator(NewModuleReleaseMetaValidator(mgr.GetClient())).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to setup ModuleReleaseMeta webhook: %w", err)
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-operator-kyma-project-io-v1beta2-modulereleasemeta,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kyma-project.io,resources=modulereleasemetas,verbs=create;update,versions=v1beta2,name=vmodulereleasemeta-v1beta2.operator.kyma-project.io,admissionReviewVersions=v1
+
+// ModuleReleaseMetaValidator rejects ModuleReleaseMetas which assign versions to channels for which there is
+// no ModuleTemplate, or only a mandatory ModuleTemplate.
+type ModuleReleaseMetaValidator struct {
+	client client.Reader
+}
+
+func NewModuleReleaseMetaValidator(client client.Reader) *ModuleReleaseMetaValidator {
+	return &ModuleReleaseMetaValidator{client: client}
+}
+
+func (v *ModuleReleaseMetaValidator) ValidateCreate(ctx context.Context,
+	obj machineryruntime.Object,
+) (admission.Warnings, error) {
+	moduleReleaseMeta, ok := obj.(*v1beta2.ModuleReleaseMeta)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a ModuleReleaseMeta but got %T", ErrUnexpectedObject, obj)
+	}
+	return nil, v.validate(ctx, nil, moduleReleaseMeta)
+}
+
+func (v *ModuleReleaseMetaValidator) ValidateUpdate(ctx context.Context,
+	oldObj, newObj machineryruntime.Object,
+) (admission.Warnings, error) {
+	oldModuleReleaseMeta, ok := oldObj.(*v1beta2.ModuleReleaseMeta)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a ModuleReleaseMeta but got %T", ErrUnexpectedObject, oldObj)
+	}
+	moduleReleaseMeta, ok := newObj.(*v1beta2.ModuleReleaseMeta)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a ModuleReleaseMeta but got %T", ErrUnexpectedObject, newObj)
+	}
+	// finalizers have to be removable independent of the spec
+	if !moduleReleaseMeta.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, oldModuleReleaseMeta, moduleReleaseMeta)
+}
+
+func (v *ModuleReleaseMetaValidator) ValidateDelete(_ context.Context,
+	_ machineryruntime.Object,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate only checks the channel assignments which are new or changed compared to the old ModuleReleaseMeta.
+func (v *ModuleReleaseMetaValidator) validate(ctx context.Context,
+	oldModuleReleaseMeta, moduleReleaseMeta *v1beta2.ModuleReleaseMeta,
+) error {
+	oldVersions := map[string]string{}
+	if oldModuleReleaseMeta != nil {
+		for _, assignment := range oldModuleReleaseMeta.Spec.Channels {
+			oldVersions[assignment.Channel] = assignment.Version
+		}
+	}
+
+	var allErrs field.ErrorList
+	channelsPath := field.NewPath("spec", "channels")
+	for i, assignment := range moduleReleaseMeta.Spec.Channels {
+		if oldVersion, found := oldVersions[assignment.Channel]; found && oldVersion == assignment.Version {
+			continue
+		}
+		versionPath := channelsPath.Index(i).Child("version")
+		template, err := moduletemplateinfolookup.GetTemplateByVersion(ctx, v.client,
+			moduleReleaseMeta.Spec.ModuleName, assignment.Version, moduleReleaseMeta.Namespace)
+		if util.IsNotFound(err) {
+			allErrs = append(allErrs, field.Invalid(versionPath, assignment.Version,
+				fmt.Sprintf("no ModuleTemplate %s-%s found", moduleReleaseMeta.Spec.ModuleName, assignment.Version)))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// mandatory versions are only assigned to channels for the mandatory rollout
+		if template.IsMandatory() && moduleReleaseMeta.Spec.Mandatory == nil {
+			allErrs = append(allErrs, field.Invalid(versionPath, assignment.Version,
+				fmt.Sprintf("ModuleTemplate %s is mandatory and must only be assigned to a channel "+
+					"if spec.mandatory is set", template.Name)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(v1beta2.GroupVersion.WithKind(string(shared.ModuleReleaseMetaKind)).GroupKind(),
+		moduleReleaseMeta.Name, allErrs)
+}
@@ -0,0 +1,57 @@
+package v1beta2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	webhookv1beta2 "github.com/kyma-project/lifecycle-manager/internal/webhook/v1beta2"
+)
+
+func TestModuleReleaseMetaValidator_ValidateCreate(t *testing.T) {
+	t.Parallel()
+	validator := webhookv1beta2.NewModuleReleaseMetaValidator(newFakeClient(t,
+		newModuleTemplate("istio", "1.0.0", false), newModuleTemplate("istio", "2.0.0", true)))
+
+	_, err := validator.ValidateCreate(context.Background(),
+		newModuleReleaseMeta("istio", v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "1.0.0"}))
+	require.NoError(t, err)
+
+	_, err = validator.ValidateCreate(context.Background(),
+		newModuleReleaseMeta("istio", v1beta2.ChannelVersionAssignment{Channel: "fast", Version: "1.1.0"}))
+	assert.True(t, apierrors.IsInvalid(err), "version without ModuleTemplate")
+
+	_, err = validator.ValidateCreate(context.Background(),
+		newModuleReleaseMeta("istio", v1beta2.ChannelVersionAssignment{Channel: "fast", Version: "2.0.0"}))
+	assert.True(t, apierrors.IsInvalid(err), "version of mandatory ModuleTemplate")
+}
+
+func TestModuleReleaseMetaValidator_ValidateCreate_AllowsMandatoryVersionsForMandatoryRollout(t *testing.T) {
+	t.Parallel()
+	validator := webhookv1beta2.NewModuleReleaseMetaValidator(newFakeClient(t,
+		newModuleTemplate("istio", "2.0.0", true)))
+	moduleReleaseMeta := newModuleReleaseMeta("istio",
+		v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "2.0.0"})
+	moduleReleaseMeta.Spec.Mandatory = &v1beta2.MandatoryModuleRollout{Channel: "regular"}
+
+	_, err := validator.ValidateCreate(context.Background(), moduleReleaseMeta)
+
+	require.NoError(t, err)
+}
+
+func TestModuleReleaseMetaValidator_ValidateUpdate_OnlyValidatesChangedAssignments(t *testing.T) {
+	t.Parallel()
+	validator := webhookv1beta2.NewModuleReleaseMetaValidator(newFakeClient(t,
+		newModuleTemplate("istio", "1.0.0", false)))
+	oldModuleReleaseMeta := newModuleReleaseMeta("istio",
+		v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "0.9.0"})
+
+	_, err := validator.ValidateUpdate(context.Background(), oldModuleReleaseMeta, newModuleReleaseMeta("istio",
+		v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "0.9.0"},
+		v1beta2.ChannelVersionAssignment{Channel: "fast", Version: "1.0.0"}))
+	require.NoError(t, err)
+}
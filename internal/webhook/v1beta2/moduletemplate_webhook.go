@@ -0,0 +1,108 @@
+package v1beta2
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+// SetupModuleTemplateWebhookWithManager registers the validating webhook for ModuleTemplate in the manager.
+func SetupModuleTemplateWebhookWithManager(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&v1beta2.ModuleTemplate{}).
+		WithValidator(NewModuleTemplateValidator(mgr.GetClient())).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to setup ModuleTemplate webhook: %w", err)
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-operator-kyma-project-io-v1beta2-moduletemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kyma-project.io,resources=moduletemplates,verbs=create;update,versions=v1beta2,name=vmoduletemplate-v1beta2.operator.kyma-project.io,admissionReviewVersions=v1
+
+// ModuleTemplateValidator rejects mandatory ModuleTemplates which are referenced by a ModuleReleaseMeta,
+// and warns about the deprecated spec.channel.
+type ModuleTemplateValidator struct {
+	client client.Reader
+}
+
+func NewModuleTemplateValidator(client client.Reader) *ModuleTemplateValidator {
+	return &ModuleTemplateValidator{client: client}
+}
+
+func (v *ModuleTemplateValidator) ValidateCreate(ctx context.Context,
+	obj machineryruntime.Object,
+) (admission.Warnings, error) {
+	template, ok := obj.(*v1beta2.ModuleTemplate)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a ModuleTemplate but got %T", ErrUnexpectedObject, obj)
+	}
+	return v.validate(ctx, template)
+}
+
+func (v *ModuleTemplateValidator) ValidateUpdate(ctx context.Context,
+	_, newObj machineryruntime.Object,
+) (admission.Warnings, error) {
+	template, ok := newObj.(*v1beta2.ModuleTemplate)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a ModuleTemplate but got %T", ErrUnexpectedObject, newObj)
+	}
+	// finalizers have to be removable independent of the spec
+	if !template.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	return v.validate(ctx, template)
+}
+
+func (v *ModuleTemplateValidator) ValidateDelete(_ context.Context,
+	_ machineryruntime.Object,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *ModuleTemplateValidator) validate(ctx context.Context,
+	template *v1beta2.ModuleTemplate,
+) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	if template.Spec.Channel != "" {
+		warnings = append(warnings,
+			"spec.channel is deprecated, assign the version to a channel in the ModuleReleaseMeta of the module instead")
+	}
+	if !template.IsMandatory() {
+		return warnings, nil
+	}
+
+	moduleName := template.GetModuleName()
+	moduleReleaseMeta, err := templatelookup.GetModuleReleaseMeta(ctx, v.client, moduleName, template.Namespace)
+	if util.IsNotFound(err) {
+		return warnings, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the channels of a ModuleReleaseMeta with a mandatory rollout assign the mandatory versions
+	if moduleReleaseMeta.Spec.Mandatory != nil {
+		return warnings, nil
+	}
+	for _, assignment := range moduleReleaseMeta.Spec.Channels {
+		if assignment.Version != template.GetVersion() {
+			continue
+		}
+		return warnings, apierrors.NewInvalid(
+			v1beta2.GroupVersion.WithKind(string(shared.ModuleTemplateKind)).GroupKind(), template.Name,
+			field.ErrorList{field.Forbidden(field.NewPath("spec", "mandatory"),
+				fmt.Sprintf("version %s of module %s is assigned to channel %s in ModuleReleaseMeta %s, "+
+					"mandatory modules must only be referenced by a ModuleReleaseMeta with spec.mandatory set",
+					assignment.Version, moduleName, assignment.Channel, moduleReleaseMeta.Name))})
+	}
+	return warnings, nil
+}
@@ -0,0 +1,52 @@
+package v1beta2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	webhookv1beta2 "github.com/kyma-project/lifecycle-manager/internal/webhook/v1beta2"
+)
+
+func TestModuleTemplateValidator_RejectsMandatoryTemplateReferencedByModuleReleaseMeta(t *testing.T) {
+	t.Parallel()
+	validator := webhookv1beta2.NewModuleTemplateValidator(newFakeClient(t,
+		newModuleReleaseMeta("istio", v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "1.0.0"})))
+
+	_, err := validator.ValidateCreate(context.Background(), newModuleTemplate("istio", "1.0.0", true))
+	assert.True(t, apierrors.IsInvalid(err))
+
+	_, err = validator.ValidateCreate(context.Background(), newModuleTemplate("istio", "1.1.0", true))
+	require.NoError(t, err)
+
+	_, err = validator.ValidateCreate(context.Background(), newModuleTemplate("istio", "1.0.0", false))
+	require.NoError(t, err)
+}
+
+func TestModuleTemplateValidator_AllowsMandatoryTemplateReferencedByMandatoryRollout(t *testing.T) {
+	t.Parallel()
+	moduleReleaseMeta := newModuleReleaseMeta("istio",
+		v1beta2.ChannelVersionAssignment{Channel: "regular", Version: "1.0.0"})
+	moduleReleaseMeta.Spec.Mandatory = &v1beta2.MandatoryModuleRollout{}
+	validator := webhookv1beta2.NewModuleTemplateValidator(newFakeClient(t, moduleReleaseMeta))
+
+	_, err := validator.ValidateCreate(context.Background(), newModuleTemplate("istio", "1.0.0", true))
+
+	require.NoError(t, err)
+}
+
+func TestModuleTemplateValidator_WarnsAboutDeprecatedChannel(t *testing.T) {
+	t.Parallel()
+	validator := webhookv1beta2.NewModuleTemplateValidator(newFakeClient(t))
+	template := newModuleTemplate("istio", "1.0.0", false)
+	template.Spec.Channel = "regular"
+
+	warnings, err := validator.ValidateCreate(context.Background(), template)
+
+	require.NoError(t, err)
+	assert.Len(t, warnings, 1)
+}
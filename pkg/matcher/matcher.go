@@ -12,6 +12,7 @@ type CRDMatcherFunc func(crd apiextensionsv1.CustomResourceDefinition) bool
 // Every CRD is defined using  the syntax: `<names.plural>.<group>` or `<names.singular>.<group>`,
 // e.g:. "kymas.operator.kyma-project.io" or "kyma.operator.kyma-project.io"
 // Instead of a name, an asterisk `*` may be used. It matches any name for the given group.
+// A single asterisk `*` instead of a CRD matches all CRDs.
 func CreateCRDMatcherFrom(input string) CRDMatcherFunc {
 	trimmed := strings.TrimSpace(input)
 	defs := strings.Split(trimmed, ",")
@@ -47,6 +48,11 @@ func crdMatcherForItems(defs []string) CRDMatcherFunc {
 // Instead of a CRD name an asterisk `*` may be used, it matches any name for the given group.
 // See the: k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1/CustomResourceDefinition type for details.
 func crdMatcherForItem(givenCRDReference string) CRDMatcherFunc {
+	if strings.TrimSpace(givenCRDReference) == "*" {
+		return func(crd apiextensionsv1.CustomResourceDefinition) bool {
+			return true
+		}
+	}
 	nameSegments := strings.Split(givenCRDReference, ".")
 	const minSegments = 2
 	if len(nameSegments) < minSegments {
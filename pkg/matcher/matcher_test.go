@@ -23,3 +23,14 @@ func TestCreateCRDMatcherFrom(t *testing.T) {
 	require.True(t, matcherFunc(manifestCrd))
 	require.False(t, matcherFunc(watcherCrd))
 }
+
+func TestCreateCRDMatcherFrom_MatchesAllCRDsWithAsterisk(t *testing.T) {
+	t.Parallel()
+	matcherFunc := matcher.CreateCRDMatcherFrom("*")
+
+	crdBuilder := builder.NewCRDBuilder()
+
+	require.True(t, matcherFunc(crdBuilder.WithName("kyma").Build()))
+	require.True(t, matcherFunc(crdBuilder.WithName("watcher").Build()))
+	require.False(t, matcher.CreateCRDMatcherFrom("")(crdBuilder.WithName("kyma").Build()))
+}
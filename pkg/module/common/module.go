@@ -22,6 +22,7 @@ type (
 		*v1beta2.Manifest
 		Enabled     bool
 		IsUnmanaged bool
+		Criticality v1beta2.ModuleCriticality
 	}
 )
 
@@ -50,6 +51,12 @@ func (m *Module) ApplyDefaultMetaToManifest(kyma *v1beta2.Kyma) {
 	if m.Template.Spec.Mandatory {
 		lbls[shared.IsMandatoryModule] = shared.EnableLabelValue
 	}
+	if shardLabel, ok := kyma.GetLabels()[shared.ShardLabel]; ok {
+		lbls[shared.ShardLabel] = shardLabel
+	}
+	if tenantNamespace := kyma.GetTenantNamespace(); tenantNamespace != "" && m.Template.IsNamespacedModule() {
+		lbls[shared.TenantNamespaceLabel] = tenantNamespace
+	}
 	m.SetLabels(lbls)
 
 	anns := m.GetAnnotations()
@@ -75,6 +75,20 @@ func TestApplyDefaultMetaToManifest_WhenCalled_SetsManagedByLabel(t *testing.T)
 	assert.Equal(t, "lifecycle-manager", resultLabels["operator.kyma-project.io/managed-by"])
 }
 
+func TestApplyDefaultMetaToManifest_WhenCalledWithShardedKyma_SetsShardLabel(t *testing.T) {
+	module := createModule()
+	kyma := &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Labels: map[string]string{"operator.kyma-project.io/shard": "2"},
+		},
+	}
+
+	module.ApplyDefaultMetaToManifest(kyma)
+
+	resultLabels := module.GetLabels()
+	assert.Equal(t, "2", resultLabels["operator.kyma-project.io/shard"])
+}
+
 func TestApplyDefaultMetaToManifest_WhenCalled_SetsFQDNAnnotation(t *testing.T) {
 	module := createModule()
 	module.FQDN = "some-fqdn"
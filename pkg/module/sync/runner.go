@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
@@ -16,7 +17,10 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
+	"github.com/kyma-project/lifecycle-manager/internal/tracing"
+	"github.com/kyma-project/lifecycle-manager/internal/upgradeapprovals"
 	commonerrs "github.com/kyma-project/lifecycle-manager/pkg/common" //nolint:importas // a one-time reference for the package
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	"github.com/kyma-project/lifecycle-manager/pkg/module/common"
@@ -27,23 +31,27 @@ import (
 
 var ErrServerSideApplyFailed = errors.New("ServerSideApply failed")
 
-func New(clnt client.Client) *Runner {
+// New creates a Runner. The lifecycle decisions are recorded with the given audit recorder, which may be nil.
+func New(clnt client.Client, auditRecorder *audit.Recorder) *Runner {
 	return &Runner{
-		Client:    clnt,
-		versioner: schema.GroupVersions(clnt.Scheme().PreferredVersionAllGroups()),
-		converter: clnt.Scheme(),
+		Client:        clnt,
+		versioner:     schema.GroupVersions(clnt.Scheme().PreferredVersionAllGroups()),
+		converter:     clnt.Scheme(),
+		auditRecorder: auditRecorder,
 	}
 }
 
 type (
-	RemoveMetricsFunc func(kymaName, moduleName string)
-	GetModuleFunc     func(ctx context.Context, module client.Object) error
+	RemoveMetricsFunc          func(kymaName, moduleName string)
+	GetModuleFunc              func(ctx context.Context, module client.Object) error
+	ObserveTimeToReadyMetricFn func(moduleName, version string, timeToReady time.Duration)
 )
 
 type Runner struct {
 	client.Client
-	versioner machineryruntime.GroupVersioner
-	converter machineryruntime.ObjectConvertor
+	versioner     machineryruntime.GroupVersioner
+	converter     machineryruntime.ObjectConvertor
+	auditRecorder *audit.Recorder
 }
 
 func (r *Runner) ReconcileManifests(ctx context.Context, kyma *v1beta2.Kyma,
@@ -62,15 +70,19 @@ func (r *Runner) ReconcileManifests(ctx context.Context, kyma *v1beta2.Kyma,
 			}
 			// Due to module template visibility change, some module previously deployed should be removed.
 			if errors.Is(module.Template.Err, templatelookup.ErrTemplateNotAllowed) {
-				results <- r.deleteManifest(ctx, module)
+				results <- r.deleteManifest(ctx, kyma, module)
 				return
 			}
 			// ModuleInStatus template in other error status should be ignored.
 			if module.Template.Err != nil {
+				r.auditTemplateError(ctx, kyma, module)
 				results <- nil
 				return
 			}
-			if err := r.updateManifest(ctx, kyma, module); err != nil {
+			moduleCtx, span := tracing.Start(ctx, "Manifest.Update", tracing.ModuleKey.String(module.ModuleName))
+			err := r.updateManifest(moduleCtx, kyma, module)
+			tracing.End(span, err)
+			if err != nil {
 				results <- fmt.Errorf("could not update module %s: %w", module.GetName(), err)
 				return
 			}
@@ -122,7 +134,11 @@ func (r *Runner) updateManifest(ctx context.Context, kyma *v1beta2.Kyma,
 		return err
 	}
 
-	if err := r.doUpdateWithStrategy(ctx, kyma.Labels[shared.ManagedBy], module,
+	if requiresReadoption(module, manifestInCluster, moduleStatus) {
+		markForReadoption(newManifest)
+	}
+
+	if err := r.doUpdateWithStrategy(ctx, kyma, module,
 		manifestInCluster, newManifest, moduleStatus); err != nil {
 		return err
 	}
@@ -131,6 +147,24 @@ func (r *Runner) updateManifest(ctx context.Context, kyma *v1beta2.Kyma,
 	return nil
 }
 
+// requiresReadoption returns true if an unmanaged module becomes managed again. Its resources are left in the SKR
+// cluster and may have been changed in the meantime, so they have to be re-adopted.
+func requiresReadoption(module *common.Module, manifestInCluster *v1beta2.Manifest,
+	moduleStatus *v1beta2.ModuleStatus,
+) bool {
+	return manifestInCluster == nil && !module.IsUnmanaged &&
+		moduleStatus != nil && moduleStatus.State == shared.StateUnmanaged
+}
+
+func markForReadoption(manifest *v1beta2.Manifest) {
+	annotations := manifest.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[shared.ReadoptionAnnotation] = shared.ReadoptionRequired
+	manifest.SetAnnotations(annotations)
+}
+
 func getManifestStatus(manifest, manifestInCluster *v1beta2.Manifest) shared.Status {
 	// In case manifest in cluster exists, collect status from it.
 	if manifestInCluster != nil {
@@ -140,14 +174,20 @@ func getManifestStatus(manifest, manifestInCluster *v1beta2.Manifest) shared.Sta
 	return manifest.Status
 }
 
-func (r *Runner) doUpdateWithStrategy(ctx context.Context, owner string, module *common.Module,
+func (r *Runner) doUpdateWithStrategy(ctx context.Context, kyma *v1beta2.Kyma, module *common.Module,
 	manifestInCluster, newManifest *v1beta2.Manifest, kymaModuleStatus *v1beta2.ModuleStatus,
 ) error {
 	if !NeedToUpdate(manifestInCluster, newManifest, kymaModuleStatus, module) {
 		return nil
 	}
 	if module.Enabled {
-		return r.patchManifest(ctx, owner, newManifest)
+		// the Manifest controller continues the trace of this change
+		tracing.InjectIntoAnnotations(ctx, newManifest)
+		if err := r.patchManifest(ctx, kyma.Labels[shared.ManagedBy], newManifest); err != nil {
+			return err
+		}
+		r.auditUpdate(ctx, kyma, module, manifestInCluster, newManifest)
+		return nil
 	}
 	// For disabled module, the manifest CR is under deleting, in this case, we only update the spec when it's still not deleted.
 	if err := r.updateAvailableManifestSpec(ctx, manifestInCluster, newManifest); err != nil && !util.IsNotFound(err) {
@@ -213,7 +253,9 @@ func NeedToUpdate(manifestInCluster, newManifest *v1beta2.Manifest, moduleInStat
 	}
 
 	diffInSpec := newManifest.Spec.Version != manifestInCluster.Spec.Version ||
-		!newManifest.IsSameChannel(manifestInCluster)
+		!newManifest.IsSameChannel(manifestInCluster) ||
+		!equality.Semantic.DeepEqual(newManifest.Spec.ResourceStatusMapping,
+			manifestInCluster.Spec.ResourceStatusMapping)
 	if manifestInCluster.IsMandatoryModule() || moduleInStatus == nil {
 		return diffInSpec
 	}
@@ -222,12 +264,115 @@ func NeedToUpdate(manifestInCluster, newManifest *v1beta2.Manifest, moduleInStat
 	return diffInTemplate || diffInSpec
 }
 
-func (r *Runner) deleteManifest(ctx context.Context, module *common.Module) error {
+func (r *Runner) deleteManifest(ctx context.Context, kyma *v1beta2.Kyma, module *common.Module) error {
 	err := r.Delete(ctx, module.Manifest)
 	if util.IsNotFound(err) {
 		return nil
 	}
-	return fmt.Errorf("failed to delete manifest: %w", err)
+	if err != nil {
+		return fmt.Errorf("failed to delete manifest: %w", err)
+	}
+	// The Manifest is deleted in every reconciliation until its finalizers are removed,
+	// the decision is only recorded once, while the module is not yet deleting.
+	if moduleStatus, ok := kyma.GetModuleStatusMap()[module.ModuleName]; ok &&
+		moduleStatus.State != shared.StateDeleting {
+		r.auditRecorder.Record(ctx, audit.Record{
+			Operation:     audit.OperationDelete,
+			KymaNamespace: kyma.GetNamespace(),
+			KymaName:      kyma.GetName(),
+			Module:        module.ModuleName,
+			FromVersion:   moduleStatus.Version,
+			Reason:        module.Template.Err.Error(),
+		})
+	}
+	return nil
+}
+
+func (r *Runner) auditUpdate(ctx context.Context, kyma *v1beta2.Kyma, module *common.Module,
+	manifestInCluster, newManifest *v1beta2.Manifest,
+) {
+	auditRecord := audit.Record{
+		KymaNamespace: kyma.GetNamespace(),
+		KymaName:      kyma.GetName(),
+		Module:        module.ModuleName,
+		ToVersion:     newManifest.Spec.Version,
+		Trigger:       fmt.Sprintf("%s %s", shared.ModuleTemplateKind, module.Template.GetName()),
+	}
+	switch {
+	case module.IsUnmanaged:
+		auditRecord.Operation = audit.OperationUnmanage
+		auditRecord.FromVersion = manifestInCluster.Spec.Version
+		auditRecord.ToVersion = ""
+		auditRecord.Reason = "module is set to unmanaged"
+	case manifestInCluster == nil:
+		auditRecord.Operation = audit.OperationInstall
+		auditRecord.Reason = "module is enabled"
+		if module.Template.Spec.Mandatory {
+			auditRecord.Reason = "module is mandatory"
+		}
+		if _, readoption := newManifest.GetAnnotations()[shared.ReadoptionAnnotation]; readoption {
+			auditRecord.Reason = "module is managed again"
+		}
+	case manifestInCluster.Spec.Version != newManifest.Spec.Version:
+		auditRecord.Operation = audit.OperationUpgrade
+		auditRecord.FromVersion = manifestInCluster.Spec.Version
+		auditRecord.Reason = fmt.Sprintf("version %s is resolved for channel %s",
+			newManifest.Spec.Version, module.Template.DesiredChannel)
+		if kyma.HasGatedUpgrades() {
+			r.auditApproval(ctx, kyma, module, &auditRecord)
+		}
+	default:
+		return
+	}
+	r.auditRecorder.Record(ctx, auditRecord)
+}
+
+// auditApproval names the UpgradeApproval of an upgrade of a Kyma with gated upgrades as its trigger.
+func (r *Runner) auditApproval(ctx context.Context, kyma *v1beta2.Kyma, module *common.Module,
+	auditRecord *audit.Record,
+) {
+	approval, err := upgradeapprovals.Find(ctx, r.Client, kyma, module.ModuleName, auditRecord.ToVersion, time.Now())
+	if err != nil || approval == nil {
+		return
+	}
+	auditRecord.Trigger = fmt.Sprintf("%s %s", shared.UpgradeApprovalKind, approval.GetName())
+	auditRecord.Reason = fmt.Sprintf("version %s is approved by %s until %s", auditRecord.ToVersion,
+		approval.Spec.ApprovedBy, approval.Spec.ExpiresAt.UTC().Format(time.RFC3339))
+	if approval.Spec.Reason != "" {
+		auditRecord.Reason += ": " + approval.Spec.Reason
+	}
+}
+
+// auditTemplateError records upgrades that are held back by the template lookup. As the lookup is repeated in every
+// reconciliation, the decision is only recorded when the message in the module status changes.
+func (r *Runner) auditTemplateError(ctx context.Context, kyma *v1beta2.Kyma, module *common.Module) {
+	var operation audit.Operation
+	switch {
+	case errors.Is(module.Template.Err, moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow),
+		errors.Is(module.Template.Err, moduletemplateinfolookup.ErrWaitingForUpgradeApproval):
+		operation = audit.OperationUpgradeSkipped
+	case errors.Is(module.Template.Err, templatelookup.ErrTemplateUpdateNotAllowed):
+		operation = audit.OperationUpgradeBlocked
+	default:
+		return
+	}
+	moduleStatus, ok := kyma.GetModuleStatusMap()[module.ModuleName]
+	if !ok || moduleStatus.Message == module.Template.Err.Error() {
+		return
+	}
+	auditRecord := audit.Record{
+		Operation:     operation,
+		KymaNamespace: kyma.GetNamespace(),
+		KymaName:      kyma.GetName(),
+		Module:        module.ModuleName,
+		FromVersion:   moduleStatus.Version,
+		Reason:        module.Template.Err.Error(),
+	}
+	if module.Template.ModuleTemplate != nil {
+		auditRecord.ToVersion = module.Template.GetVersion()
+		auditRecord.Trigger = fmt.Sprintf("%s %s", shared.ModuleTemplateKind, module.Template.GetName())
+	}
+	r.auditRecorder.Record(ctx, auditRecord)
 }
 
 func (r *Runner) setupModule(module *common.Module, kyma *v1beta2.Kyma) error {
@@ -247,20 +392,27 @@ func (r *Runner) setupModule(module *common.Module, kyma *v1beta2.Kyma) error {
 func (r *Runner) SyncModuleStatus(ctx context.Context, kyma *v1beta2.Kyma, modules common.Modules,
 	kymaMetrics *metrics.KymaMetrics,
 ) {
-	updateModuleStatusFromExistingModules(kyma, modules)
+	updateModuleStatusFromExistingModules(kyma, modules, kymaMetrics.ObserveModuleTimeToReady)
+	UpdatePendingUpgrades(kyma, modules, time.Now())
 	DeleteNoLongerExistingModuleStatus(ctx, kyma, r.getModule, kymaMetrics.RemoveModuleStateMetrics)
 }
 
 func updateModuleStatusFromExistingModules(
 	kyma *v1beta2.Kyma,
 	modules common.Modules,
+	observeTimeToReady ObserveTimeToReadyMetricFn,
 ) {
 	moduleStatusMap := kyma.GetModuleStatusMap()
+	now := time.Now()
 
 	for idx := range modules {
 		module := modules[idx]
 		moduleStatus, exists := moduleStatusMap[module.ModuleName]
 		latestModuleStatus := generateModuleStatus(module, moduleStatus)
+		latestModuleStatus.Criticality = module.Criticality
+		if timeToReady, becameReady := latestModuleStatus.UpdateLifecycle(moduleStatus, now); becameReady {
+			observeTimeToReady(latestModuleStatus.Name, latestModuleStatus.Version, timeToReady)
+		}
 		if exists {
 			*moduleStatus = latestModuleStatus
 		} else {
@@ -269,6 +421,38 @@ func updateModuleStatusFromExistingModules(
 	}
 }
 
+// UpdatePendingUpgrades records the module upgrades which wait for an UpgradeApproval in the status of the Kyma. An
+// upgrade keeps the time it became pending as long as it waits for the same version.
+func UpdatePendingUpgrades(kyma *v1beta2.Kyma, modules common.Modules, now time.Time) {
+	previous := make(map[string]v1beta2.PendingUpgrade, len(kyma.Status.PendingUpgrades))
+	for _, pendingUpgrade := range kyma.Status.PendingUpgrades {
+		previous[pendingUpgrade.Module] = pendingUpgrade
+	}
+	var pendingUpgrades []v1beta2.PendingUpgrade
+	for _, module := range modules {
+		if module.Template == nil || module.Template.ModuleTemplate == nil ||
+			!errors.Is(module.Template.Err, moduletemplateinfolookup.ErrWaitingForUpgradeApproval) {
+			continue
+		}
+		pendingUpgrade := v1beta2.PendingUpgrade{
+			Module:    module.ModuleName,
+			ToVersion: module.Template.GetVersion(),
+			Since:     apimetav1.NewTime(now),
+		}
+		if moduleStatus := kyma.Status.GetModuleStatus(module.ModuleName); moduleStatus != nil {
+			pendingUpgrade.FromVersion = moduleStatus.Version
+		}
+		if info := module.Template.Spec.Info; info != nil {
+			pendingUpgrade.ReleaseNotes = info.ReleaseNotes
+		}
+		if existing, ok := previous[module.ModuleName]; ok && existing.ToVersion == pendingUpgrade.ToVersion {
+			pendingUpgrade.Since = existing.Since
+		}
+		pendingUpgrades = append(pendingUpgrades, pendingUpgrade)
+	}
+	kyma.Status.PendingUpgrades = pendingUpgrades
+}
+
 func generateModuleStatus(module *common.Module, existStatus *v1beta2.ModuleStatus) v1beta2.ModuleStatus {
 	if module.Template.Err != nil {
 		return generateModuleStatusFromError(module, existStatus)
@@ -319,17 +503,41 @@ func generateModuleStatus(module *common.Module, existStatus *v1beta2.ModuleStat
 		},
 		Resource: moduleResource,
 	}
+	applyModuleCRStatus(&moduleStatus, manifestObject.Status.ModuleCR)
+	if moduleStatus.State == shared.StateReadoptionPending {
+		moduleStatus.Message = manifestObject.Status.LastOperation.Operation
+	}
 
 	if module.IsUnmanaged {
 		moduleStatus.State = shared.StateUnmanaged
+		moduleStatus.Message = ""
 		moduleStatus.Manifest = nil
 		moduleStatus.Template = nil
 		moduleStatus.Resource = nil
+		moduleStatus.ResourceStatus = nil
 	}
 
 	return moduleStatus
 }
 
+// applyModuleCRStatus copies the mapped status of the module CR into the module status. As long as the resources of
+// the module are ready, a module CR reporting a different state determines the state of the module.
+func applyModuleCRStatus(moduleStatus *v1beta2.ModuleStatus, moduleCRStatus *shared.ModuleCRStatus) {
+	if moduleCRStatus == nil {
+		return
+	}
+	moduleStatus.ResourceStatus = moduleCRStatus.DeepCopy()
+	if moduleStatus.State != shared.StateReady || moduleCRStatus.State == "" ||
+		moduleCRStatus.State == shared.StateReady {
+		return
+	}
+	moduleStatus.State = moduleCRStatus.State
+	moduleStatus.Message = moduleCRStatus.Message
+	if moduleStatus.Message == "" {
+		moduleStatus.Message = fmt.Sprintf("module CR is in %s state", moduleCRStatus.State)
+	}
+}
+
 func generateModuleStatusFromError(module *common.Module, existStatus *v1beta2.ModuleStatus) v1beta2.ModuleStatus {
 	switch {
 	case errors.Is(module.Template.Err, templatelookup.ErrTemplateUpdateNotAllowed):
@@ -345,11 +553,13 @@ func generateModuleStatusFromError(module *common.Module, existStatus *v1beta2.M
 			State:   shared.StateWarning,
 			Message: module.Template.Err.Error(),
 		}
-	case errors.Is(module.Template.Err, moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow):
+	case errors.Is(module.Template.Err, moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow),
+		errors.Is(module.Template.Err, moduletemplateinfolookup.ErrWaitingForUpgradeApproval):
 		newModuleStatus := existStatus.DeepCopy()
 		newModuleStatus.Message = module.Template.Err.Error()
 		return *newModuleStatus
-	case errors.Is(module.Template.Err, moduletemplateinfolookup.ErrFailedToDetermineIfMaintenanceWindowIsActive):
+	case errors.Is(module.Template.Err, moduletemplateinfolookup.ErrFailedToDetermineIfMaintenanceWindowIsActive),
+		errors.Is(module.Template.Err, moduletemplateinfolookup.ErrFailedToDetermineIfUpgradeIsApproved):
 		newModuleStatus := existStatus.DeepCopy()
 		newModuleStatus.Message = module.Template.Err.Error()
 		newModuleStatus.State = shared.StateError
@@ -4,19 +4,26 @@ import (
 	"context"
 	"sort"
 	"strings"
+	gosync "sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/audit"
 	"github.com/kyma-project/lifecycle-manager/pkg/module/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/module/sync"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
 	"github.com/kyma-project/lifecycle-manager/pkg/testutils"
 )
 
@@ -493,6 +500,43 @@ func TestNeedToUpdate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"When only the module CR status mapping changed, expect need to update",
+			args{
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{Version: "0.1"},
+				},
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{
+						Version:               "0.1",
+						ResourceStatusMapping: &v1beta2.ModuleCRStatusMapping{StatePath: "status.phase"},
+					},
+				},
+				&v1beta2.ModuleStatus{State: "Ready", Version: "0.1"},
+				&common.Module{},
+			},
+			true,
+		},
+		{
+			"When the module CR status mapping is unchanged, expect no update",
+			args{
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{
+						Version:               "0.1",
+						ResourceStatusMapping: &v1beta2.ModuleCRStatusMapping{StatePath: "status.phase"},
+					},
+				},
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{
+						Version:               "0.1",
+						ResourceStatusMapping: &v1beta2.ModuleCRStatusMapping{StatePath: "status.phase"},
+					},
+				},
+				&v1beta2.ModuleStatus{State: "Ready", Version: "0.1"},
+				&common.Module{},
+			},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -503,3 +547,113 @@ func TestNeedToUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileManifests_AuditsHeldBackUpgradesOnce(t *testing.T) {
+	t.Parallel()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	removedModule := newModuleWithTemplateError("removed", templatelookup.ErrTemplateNotAllowed)
+	sink := &auditSinkStub{}
+	runner := sync.New(fake.NewClientBuilder().WithScheme(scheme).WithObjects(removedModule.Manifest.DeepCopy()).Build(),
+		audit.NewTrail(sink).For(audit.ActorKymaController))
+	kyma := testutils.NewTestKyma("kyma")
+	kyma.Status.Modules = []v1beta2.ModuleStatus{
+		{Name: "skipped", Version: "1.0.0"},
+		{Name: "unapproved", Version: "1.0.0"},
+		{Name: "blocked", Version: "1.0.0", Message: templatelookup.ErrTemplateUpdateNotAllowed.Error()},
+		{Name: "removed", Version: "1.0.0", State: shared.StateReady},
+	}
+	modules := common.Modules{
+		newModuleWithTemplateError("skipped", moduletemplateinfolookup.ErrWaitingForNextMaintenanceWindow),
+		newModuleWithTemplateError("unapproved", moduletemplateinfolookup.ErrWaitingForUpgradeApproval),
+		newModuleWithTemplateError("blocked", templatelookup.ErrTemplateUpdateNotAllowed),
+		removedModule,
+	}
+
+	require.NoError(t, runner.ReconcileManifests(context.Background(), kyma, modules))
+
+	operations := map[string]audit.Operation{}
+	for _, auditRecord := range sink.records {
+		operations[auditRecord.Module] = auditRecord.Operation
+		assert.Equal(t, audit.ActorKymaController, auditRecord.Actor)
+		assert.Equal(t, "1.0.0", auditRecord.FromVersion)
+	}
+	assert.Equal(t, map[string]audit.Operation{
+		"skipped":    audit.OperationUpgradeSkipped,
+		"unapproved": audit.OperationUpgradeSkipped,
+		"removed":    audit.OperationDelete,
+	}, operations)
+}
+
+func TestUpdatePendingUpgrades(t *testing.T) {
+	t.Parallel()
+	since := apimetav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	now := time.Now()
+	kyma := testutils.NewTestKyma("kyma")
+	kyma.Status.Modules = []v1beta2.ModuleStatus{
+		{Name: "pending", Version: "1.0.0"},
+		{Name: "newer", Version: "1.0.0"},
+		{Name: "approved", Version: "1.0.0"},
+	}
+	kyma.Status.PendingUpgrades = []v1beta2.PendingUpgrade{
+		{Module: "pending", FromVersion: "1.0.0", ToVersion: "1.1.0", Since: since},
+		{Module: "newer", FromVersion: "1.0.0", ToVersion: "1.1.0", Since: since},
+		{Module: "approved", FromVersion: "1.0.0", ToVersion: "1.1.0", Since: since},
+	}
+	modules := common.Modules{
+		newModuleWaitingForApproval("pending", "1.1.0"),
+		newModuleWaitingForApproval("newer", "1.2.0"),
+		{ModuleName: "approved", Template: &templatelookup.ModuleTemplateInfo{ModuleTemplate: &v1beta2.ModuleTemplate{}}},
+	}
+
+	sync.UpdatePendingUpgrades(kyma, modules, now)
+
+	assert.Equal(t, []v1beta2.PendingUpgrade{
+		{
+			Module: "pending", FromVersion: "1.0.0", ToVersion: "1.1.0",
+			ReleaseNotes: "https://example.com/pending/1.1.0", Since: since,
+		},
+		{
+			Module: "newer", FromVersion: "1.0.0", ToVersion: "1.2.0",
+			ReleaseNotes: "https://example.com/newer/1.2.0", Since: apimetav1.NewTime(now),
+		},
+	}, kyma.Status.PendingUpgrades)
+}
+
+func newModuleWaitingForApproval(name, version string) *common.Module {
+	return &common.Module{
+		ModuleName: name,
+		Template: &templatelookup.ModuleTemplateInfo{
+			ModuleTemplate: &v1beta2.ModuleTemplate{
+				Spec: v1beta2.ModuleTemplateSpec{
+					ModuleName: name,
+					Version:    version,
+					Info:       &v1beta2.ModuleInfo{ReleaseNotes: "https://example.com/" + name + "/" + version},
+				},
+			},
+			Err: moduletemplateinfolookup.ErrWaitingForUpgradeApproval,
+		},
+	}
+}
+
+func newModuleWithTemplateError(name string, err error) *common.Module {
+	return &common.Module{
+		ModuleName: name,
+		Template:   &templatelookup.ModuleTemplateInfo{Err: err},
+		Manifest: &v1beta2.Manifest{
+			ObjectMeta: apimetav1.ObjectMeta{Name: name, Namespace: apimetav1.NamespaceDefault},
+		},
+	}
+}
+
+type auditSinkStub struct {
+	mutex   gosync.Mutex
+	records []audit.Record
+}
+
+func (s *auditSinkStub) Write(_ context.Context, auditRecord audit.Record) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.records = append(s.records, auditRecord)
+	return nil
+}
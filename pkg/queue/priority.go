@@ -0,0 +1,68 @@
+package queue
+
+import (
+	"strconv"
+
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+// Priorities of the requests in a PriorityQueue, requests with a higher priority are processed first.
+const (
+	// PriorityUserChange is used for spec changes, deletions and events sent by the SKR watcher.
+	PriorityUserChange = 100
+	// PriorityNew is used for objects that were never reconciled.
+	PriorityNew = 50
+	// PriorityDefault is used for all other triggers, e.g. changes of related resources.
+	PriorityDefault = 0
+	// PriorityRetry is used for failed reconciliations and requeues of objects in Error or Warning state.
+	PriorityRetry = -50
+	// PriorityPeriodic is used for requeues of reconciled objects and for unchanged objects
+	// of the initial list or a resync of the cache.
+	PriorityPeriodic = handler.LowPriority
+)
+
+func PriorityName(priority int) string {
+	switch priority {
+	case PriorityUserChange:
+		return "user_change"
+	case PriorityNew:
+		return "new"
+	case PriorityDefault:
+		return "default"
+	case PriorityRetry:
+		return "retry"
+	case PriorityPeriodic:
+		return "periodic"
+	default:
+		return strconv.Itoa(priority)
+	}
+}
+
+// RequeuePriority determines the priority of the requeue of an object reconciled into the given state.
+func RequeuePriority(state shared.State) int {
+	switch state {
+	case shared.StateError, shared.StateWarning, shared.StateReadoptionPending:
+		return PriorityRetry
+	case shared.StateProcessing, shared.StateDeleting:
+		return PriorityDefault
+	case shared.StateReady, shared.StateUnmanaged:
+		fallthrough
+	default:
+		return PriorityPeriodic
+	}
+}
+
+// AddWithPriority adds the request with the given priority if the queue supports priorities,
+// otherwise the request is added as is.
+func AddWithPriority(queue workqueue.TypedRateLimitingInterface[ctrl.Request], request ctrl.Request, priority int) {
+	if priorityQueue, ok := queue.(priorityqueue.PriorityQueue[ctrl.Request]); ok {
+		priorityQueue.AddWithOpts(priorityqueue.AddOpts{Priority: priority}, request)
+		return
+	}
+	queue.Add(request)
+}
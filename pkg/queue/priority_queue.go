@@ -0,0 +1,239 @@
+package queue
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+const depthUpdateInterval = time.Second
+
+// ObjectState is the state of a queued object as seen by the cache of its controller.
+type ObjectState struct {
+	Exists     bool
+	Deleting   bool
+	Generation int64
+	State      shared.State
+}
+
+type ObjectStateFunc func(request ctrl.Request) ObjectState
+
+type PriorityQueueMetrics interface {
+	SetQueueDepth(controllerName, priority string, depth int)
+	ObserveQueueDuration(controllerName, priority string, duration time.Duration)
+}
+
+// NewPriorityQueueFunc returns a constructor for the work queue of a controller, to be used as NewQueue
+// in the controller options.
+func NewPriorityQueueFunc(objectState ObjectStateFunc, metrics PriorityQueueMetrics,
+) func(string, workqueue.TypedRateLimiter[ctrl.Request]) workqueue.TypedRateLimitingInterface[ctrl.Request] {
+	return func(controllerName string,
+		rateLimiter workqueue.TypedRateLimiter[ctrl.Request],
+	) workqueue.TypedRateLimitingInterface[ctrl.Request] {
+		return NewPriorityQueue(controllerName, rateLimiter, objectState, metrics)
+	}
+}
+
+// NewPriorityQueue creates a work queue that derives the priority of the requests from the way they are added:
+//   - events of changed objects get PriorityUserChange if the object was deleted or its generation changed
+//     since it was last processed, PriorityNew if it was never reconciled, and PriorityDefault otherwise,
+//   - failed reconciliations are retried with PriorityRetry and the backoff of the rate limiter,
+//   - requeues after a successful reconciliation get the RequeuePriority of the state of the object,
+//   - unchanged objects of the initial list or a resync of the cache get PriorityPeriodic.
+//
+// The metrics are optional.
+func NewPriorityQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[ctrl.Request],
+	objectState ObjectStateFunc, metrics PriorityQueueMetrics,
+) priorityqueue.PriorityQueue[ctrl.Request] {
+	queue := &priorityQueue{
+		PriorityQueue: priorityqueue.New(controllerName, func(opts *priorityqueue.Opts[ctrl.Request]) {
+			opts.Log = ctrl.Log.WithName("queue").WithValues("controller", controllerName)
+			opts.RateLimiter = rateLimiter
+		}),
+		name:        controllerName,
+		rateLimiter: rateLimiter,
+		objectState: objectState,
+		metrics:     metrics,
+		now:         time.Now,
+		queued:      map[ctrl.Request]queuedRequest{},
+		generations: map[ctrl.Request]int64{},
+		done:        make(chan struct{}),
+	}
+	if metrics != nil {
+		go queue.updateDepthLoop()
+	}
+	return queue
+}
+
+type priorityQueue struct {
+	priorityqueue.PriorityQueue[ctrl.Request]
+
+	name        string
+	rateLimiter workqueue.TypedRateLimiter[ctrl.Request]
+	objectState ObjectStateFunc
+	metrics     PriorityQueueMetrics
+	now         func() time.Time
+
+	// lock has to be acquired for any access to queued and generations
+	lock sync.Mutex
+	// queued tracks the priority of the queued requests and when they became ready to be processed
+	queued map[ctrl.Request]queuedRequest
+	// generations holds the generation of the objects when they were last handed out for processing
+	generations map[ctrl.Request]int64
+
+	shutdownOnce sync.Once
+	done         chan struct{}
+}
+
+type queuedRequest struct {
+	priority int
+	readyAt  time.Time
+}
+
+func (q *priorityQueue) Add(request ctrl.Request) {
+	q.AddWithOpts(priorityqueue.AddOpts{}, request)
+}
+
+func (q *priorityQueue) AddAfter(request ctrl.Request, duration time.Duration) {
+	q.add(q.requeuePriority(request), duration, request)
+}
+
+func (q *priorityQueue) AddRateLimited(request ctrl.Request) {
+	q.add(PriorityRetry, q.rateLimiter.When(request), request)
+}
+
+func (q *priorityQueue) AddWithOpts(opts priorityqueue.AddOpts, requests ...ctrl.Request) {
+	for _, request := range requests {
+		after := opts.After
+		if opts.RateLimited {
+			after = max(after, q.rateLimiter.When(request))
+		}
+		q.add(q.eventPriority(opts.Priority, request), after, request)
+	}
+}
+
+func (q *priorityQueue) Get() (ctrl.Request, bool) {
+	request, _, shutdown := q.GetWithPriority()
+	return request, shutdown
+}
+
+func (q *priorityQueue) GetWithPriority() (ctrl.Request, int, bool) {
+	request, priority, shutdown := q.PriorityQueue.GetWithPriority()
+	if shutdown {
+		return request, priority, shutdown
+	}
+
+	state := q.objectState(request)
+	q.lock.Lock()
+	queued, tracked := q.queued[request]
+	delete(q.queued, request)
+	if state.Exists {
+		q.generations[request] = state.Generation
+	} else {
+		delete(q.generations, request)
+	}
+	q.lock.Unlock()
+
+	if tracked && q.metrics != nil {
+		q.metrics.ObserveQueueDuration(q.name, PriorityName(priority), max(q.now().Sub(queued.readyAt), 0))
+	}
+	return request, priority, shutdown
+}
+
+func (q *priorityQueue) ShutDown() {
+	q.shutdownOnce.Do(func() { close(q.done) })
+	q.PriorityQueue.ShutDown()
+}
+
+func (q *priorityQueue) ShutDownWithDrain() {
+	q.shutdownOnce.Do(func() { close(q.done) })
+	q.PriorityQueue.ShutDownWithDrain()
+}
+
+func (q *priorityQueue) add(priority int, after time.Duration, request ctrl.Request) {
+	readyAt := q.now().Add(after)
+	q.lock.Lock()
+	// the queue de-duplicates requests, keeping the highest priority and the earliest time to be ready
+	if queued, ok := q.queued[request]; ok {
+		priority = max(priority, queued.priority)
+		if queued.readyAt.Before(readyAt) {
+			readyAt = queued.readyAt
+		}
+	}
+	q.queued[request] = queuedRequest{priority: priority, readyAt: readyAt}
+	q.lock.Unlock()
+
+	q.PriorityQueue.AddWithOpts(priorityqueue.AddOpts{After: after, Priority: priority}, request)
+}
+
+func (q *priorityQueue) eventPriority(priority int, request ctrl.Request) int {
+	// explicit priorities, e.g. of SKR watcher events or of unchanged objects, are kept as is
+	if priority != PriorityDefault {
+		return priority
+	}
+
+	state := q.objectState(request)
+	q.lock.Lock()
+	generation, processed := q.generations[request]
+	q.lock.Unlock()
+
+	switch {
+	case !state.Exists, state.Deleting:
+		return PriorityUserChange
+	case processed && generation != state.Generation:
+		return PriorityUserChange
+	case state.State == "":
+		return PriorityNew
+	default:
+		return PriorityDefault
+	}
+}
+
+func (q *priorityQueue) requeuePriority(request ctrl.Request) int {
+	state := q.objectState(request)
+	if !state.Exists {
+		return PriorityDefault
+	}
+	return RequeuePriority(state.State)
+}
+
+func (q *priorityQueue) updateDepthLoop() {
+	ticker := time.NewTicker(depthUpdateInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-q.done:
+			return
+		case <-ticker.C:
+			q.updateDepth()
+		}
+	}
+}
+
+// updateDepth reports the number of requests per priority which are ready to be processed.
+func (q *priorityQueue) updateDepth() {
+	depth := map[int]int{
+		PriorityUserChange: 0,
+		PriorityNew:        0,
+		PriorityDefault:    0,
+		PriorityRetry:      0,
+		PriorityPeriodic:   0,
+	}
+	now := q.now()
+	q.lock.Lock()
+	for _, queued := range q.queued {
+		if !queued.readyAt.After(now) {
+			depth[queued.priority]++
+		}
+	}
+	q.lock.Unlock()
+
+	for priority, count := range depth {
+		q.metrics.SetQueueDepth(q.name, PriorityName(priority), count)
+	}
+}
@@ -0,0 +1,169 @@
+package queue_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/pkg/queue"
+)
+
+func Test_RequeuePriority(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		state    shared.State
+		expected int
+	}{
+		{state: shared.StateError, expected: queue.PriorityRetry},
+		{state: shared.StateWarning, expected: queue.PriorityRetry},
+		{state: shared.StateReadoptionPending, expected: queue.PriorityRetry},
+		{state: shared.StateProcessing, expected: queue.PriorityDefault},
+		{state: shared.StateDeleting, expected: queue.PriorityDefault},
+		{state: shared.StateReady, expected: queue.PriorityPeriodic},
+		{state: shared.StateUnmanaged, expected: queue.PriorityPeriodic},
+		{state: "", expected: queue.PriorityPeriodic},
+	}
+	for _, testCase := range tests {
+		t.Run(string(testCase.state), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testCase.expected, queue.RequeuePriority(testCase.state))
+		})
+	}
+}
+
+func Test_PriorityQueue_ProcessesRequestsByPriority(t *testing.T) {
+	t.Parallel()
+	states := objectStates{
+		"ready":   {Exists: true, Generation: 1, State: shared.StateReady},
+		"failed":  {Exists: true, Generation: 1, State: shared.StateError},
+		"new":     {Exists: true, Generation: 1},
+		"changed": {Exists: true, Generation: 1, State: shared.StateReady},
+		"watched": {Exists: true, Generation: 1, State: shared.StateReady},
+	}
+	metrics := &queueMetricsStub{}
+	priorityQueue := queue.NewPriorityQueue("test", noDelayRateLimiter(), states.get, metrics)
+	defer priorityQueue.ShutDown()
+
+	// the generation of a processed object changes afterwards
+	priorityQueue.Add(request("changed"))
+	processed, _ := priorityQueue.Get()
+	priorityQueue.Done(processed)
+	states.set("changed", queue.ObjectState{Exists: true, Generation: 2, State: shared.StateReady})
+
+	priorityQueue.AddAfter(request("ready"), 0)
+	priorityQueue.AddRateLimited(request("failed"))
+	priorityQueue.Add(request("new"))
+	priorityQueue.Add(request("changed"))
+	priorityQueue.AddWithOpts(priorityqueue.AddOpts{Priority: queue.PriorityUserChange}, request("watched"))
+
+	expected := []struct {
+		name     string
+		priority int
+	}{
+		{name: "changed", priority: queue.PriorityUserChange},
+		{name: "watched", priority: queue.PriorityUserChange},
+		{name: "new", priority: queue.PriorityNew},
+		{name: "failed", priority: queue.PriorityRetry},
+		{name: "ready", priority: queue.PriorityPeriodic},
+	}
+	for _, next := range expected {
+		item, priority, shutdown := priorityQueue.GetWithPriority()
+		require.False(t, shutdown)
+		assert.Equal(t, request(next.name), item)
+		assert.Equal(t, next.priority, priority)
+		priorityQueue.Done(item)
+	}
+	assert.ElementsMatch(t, []string{"default", "user_change", "user_change", "new", "retry", "periodic"},
+		metrics.observedPriorities())
+}
+
+func Test_PriorityQueue_KeepsHighestPriorityOfDuplicates(t *testing.T) {
+	t.Parallel()
+	states := objectStates{"kyma": {Exists: true, Generation: 1, State: shared.StateReady}}
+	priorityQueue := queue.NewPriorityQueue("test", noDelayRateLimiter(), states.get, nil)
+	defer priorityQueue.ShutDown()
+
+	priorityQueue.AddWithOpts(priorityqueue.AddOpts{Priority: queue.PriorityUserChange}, request("kyma"))
+	priorityQueue.AddAfter(request("kyma"), 0)
+
+	assert.Equal(t, 1, priorityQueue.Len())
+	_, priority, _ := priorityQueue.GetWithPriority()
+	assert.Equal(t, queue.PriorityUserChange, priority)
+}
+
+func Test_PriorityQueue_DeletedObjectIsUserChange(t *testing.T) {
+	t.Parallel()
+	states := objectStates{"deleting": {Exists: true, Deleting: true, Generation: 1}}
+	priorityQueue := queue.NewPriorityQueue("test", noDelayRateLimiter(), states.get, nil)
+	defer priorityQueue.ShutDown()
+
+	priorityQueue.Add(request("deleting"))
+	priorityQueue.Add(request("gone"))
+
+	for range 2 {
+		_, priority, _ := priorityQueue.GetWithPriority()
+		assert.Equal(t, queue.PriorityUserChange, priority)
+	}
+}
+
+func Test_AddWithPriority_FallsBackToAddWithoutPriorityQueue(t *testing.T) {
+	t.Parallel()
+	rateLimitingQueue := workqueue.NewTypedRateLimitingQueue(noDelayRateLimiter())
+	defer rateLimitingQueue.ShutDown()
+
+	queue.AddWithPriority(rateLimitingQueue, request("kyma"), queue.PriorityUserChange)
+
+	item, _ := rateLimitingQueue.Get()
+	assert.Equal(t, request("kyma"), item)
+}
+
+func request(name string) ctrl.Request {
+	return ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "kcp-system", Name: name}}
+}
+
+func noDelayRateLimiter() workqueue.TypedRateLimiter[ctrl.Request] {
+	return workqueue.NewTypedItemExponentialFailureRateLimiter[ctrl.Request](0, 0)
+}
+
+type objectStates map[string]queue.ObjectState
+
+var objectStatesLock sync.Mutex //nolint:gochecknoglobals // guards the objectStates of the tests
+
+func (s objectStates) get(request ctrl.Request) queue.ObjectState {
+	objectStatesLock.Lock()
+	defer objectStatesLock.Unlock()
+	return s[request.Name]
+}
+
+func (s objectStates) set(name string, state queue.ObjectState) {
+	objectStatesLock.Lock()
+	defer objectStatesLock.Unlock()
+	s[name] = state
+}
+
+type queueMetricsStub struct {
+	lock       sync.Mutex
+	priorities []string
+}
+
+func (m *queueMetricsStub) SetQueueDepth(_, _ string, _ int) {}
+
+func (m *queueMetricsStub) ObserveQueueDuration(_, priority string, _ time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.priorities = append(m.priorities, priority)
+}
+
+func (m *queueMetricsStub) observedPriorities() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.priorities
+}
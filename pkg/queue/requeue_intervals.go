@@ -24,7 +24,7 @@ func DetermineRequeueInterval(state shared.State, intervals RequeueIntervals) ti
 		interval = intervals.Busy
 	case shared.StateProcessing:
 		interval = intervals.Busy
-	case shared.StateWarning:
+	case shared.StateWarning, shared.StateReadoptionPending:
 		interval = intervals.Warning
 	case shared.StateReady:
 		fallthrough
@@ -2,9 +2,11 @@ package templatelookup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Masterminds/semver/v3"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -12,9 +14,57 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 )
 
+var (
+	ErrMandatoryModuleExcluded  = errors.New("mandatory module is excluded for the Kyma")
+	ErrMandatoryVersionNotFound = errors.New("no mandatory ModuleTemplate found for version")
+)
+
 // GetMandatory returns ModuleTemplates TOs (Transfer Objects) which are marked are mandatory modules.
 func GetMandatory(ctx context.Context, kymaClient client.Reader) (ModuleTemplatesByModuleName,
 	error,
+) {
+	templatesByModule, err := listMandatoryTemplates(ctx, kymaClient)
+	if err != nil {
+		return nil, err
+	}
+
+	mandatoryModules := make(ModuleTemplatesByModuleName, len(templatesByModule))
+	for moduleName, templates := range templatesByModule {
+		mandatoryModules[moduleName] = getMandatoryWithHighestVersion(templates)
+	}
+	return mandatoryModules, nil
+}
+
+// GetMandatoryForKyma returns the ModuleTemplates of the mandatory modules to install for the Kyma.
+// A mandatory module whose ModuleReleaseMeta configures the mandatory rollout is marked with
+// ErrMandatoryModuleExcluded if the Kyma is excluded, and is otherwise installed in the version of the target
+// matching the Kyma, or of the channel. All other mandatory modules are installed in their highest version.
+func GetMandatoryForKyma(ctx context.Context, kymaClient client.Reader, kyma *v1beta2.Kyma,
+) (ModuleTemplatesByModuleName, error) {
+	templatesByModule, err := listMandatoryTemplates(ctx, kymaClient)
+	if err != nil {
+		return nil, err
+	}
+
+	mandatoryModules := make(ModuleTemplatesByModuleName, len(templatesByModule))
+	for moduleName, templates := range templatesByModule {
+		moduleReleaseMeta, err := GetModuleReleaseMeta(ctx, kymaClient, moduleName, kyma.Namespace)
+		if client.IgnoreNotFound(err) != nil {
+			mandatoryModules[moduleName] = &ModuleTemplateInfo{Err: err}
+			continue
+		}
+		if moduleReleaseMeta == nil || moduleReleaseMeta.Spec.Mandatory == nil {
+			mandatoryModules[moduleName] = getMandatoryWithHighestVersion(templates)
+			continue
+		}
+		mandatoryModules[moduleName] = getMandatoryForRollout(moduleReleaseMeta, kyma, templates)
+	}
+	return mandatoryModules, nil
+}
+
+// listMandatoryTemplates returns the mandatory ModuleTemplates which are not being deleted, by module name.
+func listMandatoryTemplates(ctx context.Context, kymaClient client.Reader) (map[string][]*v1beta2.ModuleTemplate,
+	error,
 ) {
 	mandatoryModuleTemplateList := &v1beta2.ModuleTemplateList{}
 	labelSelector := k8slabels.SelectorFromSet(k8slabels.Set{shared.IsMandatoryModule: shared.EnableLabelValue})
@@ -23,31 +73,105 @@ func GetMandatory(ctx context.Context, kymaClient client.Reader) (ModuleTemplate
 		return nil, fmt.Errorf("could not list mandatory ModuleTemplates: %w", err)
 	}
 
-	// maps module name to the module template of the highest version encountered
-	mandatoryModules := make(map[string]*ModuleTemplateInfo)
-	for _, moduleTemplate := range mandatoryModuleTemplateList.Items {
+	templatesByModule := make(map[string][]*v1beta2.ModuleTemplate)
+	for index := range mandatoryModuleTemplateList.Items {
+		moduleTemplate := &mandatoryModuleTemplateList.Items[index]
 		if moduleTemplate.DeletionTimestamp.IsZero() {
-			currentModuleTemplate := &moduleTemplate
-			moduleName := GetModuleName(currentModuleTemplate)
-			if mandatoryModules[moduleName] != nil {
-				var err error
-				currentModuleTemplate, err = GetModuleTemplateWithHigherVersion(currentModuleTemplate,
-					mandatoryModules[moduleName].ModuleTemplate)
-				if err != nil {
-					mandatoryModules[moduleName] = &ModuleTemplateInfo{
-						ModuleTemplate: nil,
-						Err:            err,
-					}
-					continue
-				}
-			}
-			mandatoryModules[moduleName] = &ModuleTemplateInfo{
-				ModuleTemplate: currentModuleTemplate,
-				Err:            nil,
+			moduleName := GetModuleName(moduleTemplate)
+			templatesByModule[moduleName] = append(templatesByModule[moduleName], moduleTemplate)
+		}
+	}
+	return templatesByModule, nil
+}
+
+func getMandatoryWithHighestVersion(templates []*v1beta2.ModuleTemplate) *ModuleTemplateInfo {
+	highest := templates[0]
+	for _, moduleTemplate := range templates[1:] {
+		var err error
+		highest, err = GetModuleTemplateWithHigherVersion(moduleTemplate, highest)
+		if err != nil {
+			return &ModuleTemplateInfo{
+				ModuleTemplate: nil,
+				Err:            err,
 			}
 		}
 	}
-	return mandatoryModules, nil
+	return &ModuleTemplateInfo{
+		ModuleTemplate: highest,
+		Err:            nil,
+	}
+}
+
+// getMandatoryForRollout resolves the mandatory ModuleTemplate for the Kyma following the mandatory rollout of the
+// ModuleReleaseMeta. Without a matching target, the version of the rollout channel is installed, or of the channel
+// of the Kyma if the rollout has none.
+func getMandatoryForRollout(moduleReleaseMeta *v1beta2.ModuleReleaseMeta, kyma *v1beta2.Kyma,
+	templates []*v1beta2.ModuleTemplate,
+) *ModuleTemplateInfo {
+	rollout := moduleReleaseMeta.Spec.Mandatory
+	for _, exclusion := range rollout.Exclusions {
+		excluded, err := selectsKyma(exclusion, kyma)
+		if err != nil {
+			return &ModuleTemplateInfo{Err: err}
+		}
+		if excluded {
+			return &ModuleTemplateInfo{Err: fmt.Errorf("%w: module %s", ErrMandatoryModuleExcluded,
+				moduleReleaseMeta.Spec.ModuleName)}
+		}
+	}
+
+	channel, version := rollout.Channel, ""
+	for _, target := range rollout.Targets {
+		targeted, err := selectsKyma(target.Selector, kyma)
+		if err != nil {
+			return &ModuleTemplateInfo{Err: err}
+		}
+		if targeted {
+			channel, version = target.Channel, target.Version
+			break
+		}
+	}
+
+	templateInfo := &ModuleTemplateInfo{}
+	if version == "" {
+		if channel == "" {
+			channel = kyma.Spec.Channel
+		}
+		templateInfo.DesiredChannel = channel
+		var err error
+		if version, err = GetChannelVersionForModule(moduleReleaseMeta, channel); err != nil {
+			templateInfo.Err = err
+			return templateInfo
+		}
+	}
+
+	templateInfo.ModuleTemplate, templateInfo.Err = getMandatoryWithVersion(templates, version)
+	return templateInfo
+}
+
+func getMandatoryWithVersion(templates []*v1beta2.ModuleTemplate, version string) (*v1beta2.ModuleTemplate, error) {
+	desiredVersion, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse version as a semver: %s: %w", version, err)
+	}
+	for _, moduleTemplate := range templates {
+		templateVersion, err := GetModuleSemverVersion(moduleTemplate)
+		if err != nil {
+			return nil, err
+		}
+		if templateVersion.Equal(desiredVersion) {
+			return moduleTemplate, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrMandatoryVersionNotFound, version)
+}
+
+func selectsKyma(labelSelector apimetav1.LabelSelector, kyma *v1beta2.Kyma) (bool, error) {
+	selector, err := apimetav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid label selector in mandatory rollout: %w", err)
+	}
+	return selector.Matches(k8slabels.Set(kyma.GetLabels())), nil
 }
 
 func GetModuleName(moduleTemplate *v1beta2.ModuleTemplate) string {
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
@@ -245,3 +247,162 @@ func TestGetMandatory_WithErrorNotSemVer(t *testing.T) {
 	require.Contains(t, result, "warden")
 	require.ErrorContains(t, result["warden"].Err, "could not parse version as a semver")
 }
+
+func TestGetMandatoryForKyma_WithoutRollout_ReturnsHighestVersion(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	err := v1beta2.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	moduleReleaseMeta := builder.NewModuleReleaseMetaBuilder().
+		WithModuleName("warden").
+		WithSingleModuleChannelAndVersions("regular", "1.0.0").
+		Build()
+	kyma := builder.NewKymaBuilder().WithChannel("regular").Build()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(mandatoryWardenTemplates("1.0.0", "1.0.1"), moduleReleaseMeta)...).
+		Build()
+
+	result, err := templatelookup.GetMandatoryForKyma(context.TODO(), fakeClient, kyma)
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.NoError(t, result["warden"].Err)
+	require.Equal(t, "1.0.1", result["warden"].ModuleTemplate.Spec.Version)
+}
+
+func TestGetMandatoryForKyma_WithRollout_ReturnsVersionOfKymaChannel(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	err := v1beta2.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	moduleReleaseMeta := builder.NewModuleReleaseMetaBuilder().
+		WithModuleName("warden").
+		WithSingleModuleChannelAndVersions("regular", "1.0.0").
+		WithSingleModuleChannelAndVersions("fast", "1.0.1").
+		WithMandatory(&v1beta2.MandatoryModuleRollout{}).
+		Build()
+	kyma := builder.NewKymaBuilder().WithChannel("regular").Build()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(mandatoryWardenTemplates("1.0.0", "1.0.1"), moduleReleaseMeta)...).
+		Build()
+
+	result, err := templatelookup.GetMandatoryForKyma(context.TODO(), fakeClient, kyma)
+
+	require.NoError(t, err)
+	require.NoError(t, result["warden"].Err)
+	require.Equal(t, "1.0.0", result["warden"].ModuleTemplate.Spec.Version)
+	require.Equal(t, "regular", result["warden"].DesiredChannel)
+}
+
+func TestGetMandatoryForKyma_WithRollout_ReturnsVersionOfMatchingTarget(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	err := v1beta2.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	moduleReleaseMeta := builder.NewModuleReleaseMetaBuilder().
+		WithModuleName("warden").
+		WithSingleModuleChannelAndVersions("regular", "1.0.2").
+		WithSingleModuleChannelAndVersions("fast", "1.0.1").
+		WithMandatory(&v1beta2.MandatoryModuleRollout{
+			Targets: []v1beta2.MandatoryModuleTarget{
+				{
+					Selector: apimetav1.LabelSelector{MatchLabels: map[string]string{"kyma-project.io/region": "regulated"}},
+					Version:  "1.0.0",
+				},
+				{
+					Selector: apimetav1.LabelSelector{},
+					Channel:  "fast",
+				},
+			},
+		}).
+		Build()
+	regulatedKyma := builder.NewKymaBuilder().WithChannel("regular").
+		WithLabel("kyma-project.io/region", "regulated").Build()
+	kyma := builder.NewKymaBuilder().WithChannel("regular").Build()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(mandatoryWardenTemplates("1.0.0", "1.0.1", "1.0.2"), moduleReleaseMeta)...).
+		Build()
+
+	result, err := templatelookup.GetMandatoryForKyma(context.TODO(), fakeClient, regulatedKyma)
+	require.NoError(t, err)
+	require.NoError(t, result["warden"].Err)
+	require.Equal(t, "1.0.0", result["warden"].ModuleTemplate.Spec.Version)
+
+	result, err = templatelookup.GetMandatoryForKyma(context.TODO(), fakeClient, kyma)
+	require.NoError(t, err)
+	require.NoError(t, result["warden"].Err)
+	require.Equal(t, "1.0.1", result["warden"].ModuleTemplate.Spec.Version)
+	require.Equal(t, "fast", result["warden"].DesiredChannel)
+}
+
+func TestGetMandatoryForKyma_WithRollout_ReturnsErrorForExcludedKyma(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	err := v1beta2.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	moduleReleaseMeta := builder.NewModuleReleaseMetaBuilder().
+		WithModuleName("warden").
+		WithSingleModuleChannelAndVersions("regular", "1.0.0").
+		WithMandatory(&v1beta2.MandatoryModuleRollout{
+			Exclusions: []apimetav1.LabelSelector{
+				{MatchLabels: map[string]string{"kyma-project.io/broker-plan-name": "trial"}},
+			},
+		}).
+		Build()
+	kyma := builder.NewKymaBuilder().WithChannel("regular").
+		WithLabel("kyma-project.io/broker-plan-name", "trial").Build()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(mandatoryWardenTemplates("1.0.0"), moduleReleaseMeta)...).
+		Build()
+
+	result, err := templatelookup.GetMandatoryForKyma(context.TODO(), fakeClient, kyma)
+
+	require.NoError(t, err)
+	require.ErrorIs(t, result["warden"].Err, templatelookup.ErrMandatoryModuleExcluded)
+	require.Nil(t, result["warden"].ModuleTemplate)
+}
+
+func TestGetMandatoryForKyma_WithRollout_ReturnsErrorForMissingVersion(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	err := v1beta2.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	moduleReleaseMeta := builder.NewModuleReleaseMetaBuilder().
+		WithModuleName("warden").
+		WithSingleModuleChannelAndVersions("regular", "2.0.0").
+		WithMandatory(&v1beta2.MandatoryModuleRollout{}).
+		Build()
+	kyma := builder.NewKymaBuilder().WithChannel("regular").Build()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(mandatoryWardenTemplates("1.0.0"), moduleReleaseMeta)...).
+		Build()
+
+	result, err := templatelookup.GetMandatoryForKyma(context.TODO(), fakeClient, kyma)
+
+	require.NoError(t, err)
+	require.ErrorIs(t, result["warden"].Err, templatelookup.ErrMandatoryVersionNotFound)
+}
+
+func mandatoryWardenTemplates(versions ...string) []client.Object {
+	templates := make([]client.Object, 0, len(versions))
+	for _, version := range versions {
+		templates = append(templates, builder.NewModuleTemplateBuilder().
+			WithName("warden-"+version).
+			WithModuleName("warden").
+			WithMandatory(true).
+			WithLabel("operator.kyma-project.io/mandatory-module", "true").
+			WithVersion(version).
+			Build())
+	}
+	return templates
+}
@@ -37,7 +37,7 @@ func (s ByModuleReleaseMetaStrategy) Lookup(ctx context.Context,
 		return moduleTemplateInfo
 	}
 
-	template, err := getTemplateByVersion(ctx,
+	template, err := GetTemplateByVersion(ctx,
 		s.client,
 		moduleInfo.Name,
 		desiredModuleVersion,
@@ -41,7 +41,8 @@ func newMoreThanOneTemplateCandidateErr(moduleName string,
 		ErrTemplateNotIdentified, moduleName, candidates)
 }
 
-func getTemplateByVersion(ctx context.Context,
+// GetTemplateByVersion fetches the ModuleTemplate of the given module version, which is named <module>-<version>.
+func GetTemplateByVersion(ctx context.Context,
 	clnt client.Reader,
 	moduleName, moduleVersion, namespace string,
 ) (*v1beta2.ModuleTemplate, error) {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
 )
@@ -33,6 +35,28 @@ func NewModuleTemplateInfoLookupStrategies(strategies []ModuleTemplateInfoLookup
 	return ModuleTemplateInfoLookupStrategies{strategies: strategies}
 }
 
+// NewDefaultModuleTemplateInfoLookupStrategies returns the strategies used to look up the ModuleTemplates for the
+// modules of a Kyma. Upgrades requiring an approval are only looked up once they are approved, unless upgradeApproval
+// is nil. Upgrades requiring a maintenance window are only looked up during an active maintenance window, unless
+// maintenanceWindow is nil.
+func NewDefaultModuleTemplateInfoLookupStrategies(clnt client.Reader,
+	maintenanceWindow MaintenanceWindow,
+	upgradeApproval UpgradeApproval,
+) ModuleTemplateInfoLookupStrategies {
+	var byModuleReleaseMeta ModuleTemplateInfoLookupStrategy = NewByModuleReleaseMetaStrategy(clnt)
+	if upgradeApproval != nil {
+		byModuleReleaseMeta = NewWithUpgradeApprovalDecorator(upgradeApproval, byModuleReleaseMeta)
+	}
+	if maintenanceWindow != nil {
+		byModuleReleaseMeta = NewWithMaintenanceWindowDecorator(maintenanceWindow, byModuleReleaseMeta)
+	}
+	return NewModuleTemplateInfoLookupStrategies([]ModuleTemplateInfoLookupStrategy{
+		NewByVersionStrategy(clnt),
+		NewByChannelStrategy(clnt),
+		byModuleReleaseMeta,
+	})
+}
+
 func (s ModuleTemplateInfoLookupStrategies) Lookup(ctx context.Context,
 	moduleInfo *templatelookup.ModuleInfo,
 	kyma *v1beta2.Kyma,
@@ -0,0 +1,73 @@
+package moduletemplateinfolookup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+)
+
+var (
+	ErrWaitingForUpgradeApproval            = errors.New("waiting for upgrade approval")
+	ErrFailedToDetermineIfUpgradeIsApproved = errors.New("failed to determine if upgrade is approved")
+)
+
+type UpgradeApproval interface {
+	IsRequired(moduleTemplate *v1beta2.ModuleTemplate, kyma *v1beta2.Kyma) bool
+	IsApproved(ctx context.Context, moduleTemplate *v1beta2.ModuleTemplate, kyma *v1beta2.Kyma) (bool, error)
+}
+
+// WithUpgradeApprovalDecorator holds back the upgrades of the modules of Kymas with gated upgrades until they are
+// approved. Unlike a pending maintenance window, a pending approval keeps the ModuleTemplate of the new version in the
+// result, so that the pending upgrade can be reported.
+type WithUpgradeApprovalDecorator struct {
+	upgradeApproval UpgradeApproval
+	decorated       ModuleTemplateInfoLookupStrategy
+}
+
+func NewWithUpgradeApprovalDecorator(upgradeApproval UpgradeApproval, decorated ModuleTemplateInfoLookupStrategy) WithUpgradeApprovalDecorator {
+	return WithUpgradeApprovalDecorator{
+		upgradeApproval: upgradeApproval,
+		decorated:       decorated,
+	}
+}
+
+func (p WithUpgradeApprovalDecorator) IsResponsible(moduleInfo *templatelookup.ModuleInfo, moduleReleaseMeta *v1beta2.ModuleReleaseMeta) bool {
+	return p.decorated.IsResponsible(moduleInfo, moduleReleaseMeta)
+}
+
+func (p WithUpgradeApprovalDecorator) Lookup(ctx context.Context,
+	moduleInfo *templatelookup.ModuleInfo,
+	kyma *v1beta2.Kyma,
+	moduleReleaseMeta *v1beta2.ModuleReleaseMeta,
+) templatelookup.ModuleTemplateInfo {
+	moduleTemplateInfo := p.decorated.Lookup(ctx,
+		moduleInfo,
+		kyma,
+		moduleReleaseMeta)
+
+	// decorated returns an error case => return immediately
+	if moduleTemplateInfo.ModuleTemplate == nil || moduleTemplateInfo.Err != nil {
+		return moduleTemplateInfo
+	}
+
+	if !p.upgradeApproval.IsRequired(moduleTemplateInfo.ModuleTemplate, kyma) {
+		return moduleTemplateInfo
+	}
+
+	approved, err := p.upgradeApproval.IsApproved(ctx, moduleTemplateInfo.ModuleTemplate, kyma)
+	if err != nil {
+		moduleTemplateInfo.Err = fmt.Errorf("%w: %w", ErrFailedToDetermineIfUpgradeIsApproved, err)
+		moduleTemplateInfo.ModuleTemplate = nil
+		return moduleTemplateInfo
+	}
+
+	if !approved {
+		moduleTemplateInfo.Err = fmt.Errorf("%w to update module to version %s", ErrWaitingForUpgradeApproval, moduleTemplateInfo.GetVersion())
+		return moduleTemplateInfo
+	}
+
+	return moduleTemplateInfo
+}
@@ -0,0 +1,165 @@
+package moduletemplateinfolookup_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+)
+
+func Test_WithUpgradeApprovalDecorator_IsReponsible_CallsDecoratedIsResponsible(t *testing.T) {
+	decorated := &lookupStrategyStub{
+		responsible: true,
+	}
+	withUpgradeApprovalDecorator := moduletemplateinfolookup.NewWithUpgradeApprovalDecorator(nil, decorated)
+
+	responsible := withUpgradeApprovalDecorator.IsResponsible(nil, nil)
+
+	assert.True(t, decorated.called)
+	assert.True(t, responsible)
+}
+
+func Test_WithUpgradeApprovalDecorator_Lookup_ReturnsModuleTemplateInfo_WhenDecoratedLookupReturnsModuleTemplateInfoWithError(t *testing.T) {
+	upgradeApproval := &upgradeApprovalStub{}
+	expectedModuleTemplateInfo := templatelookup.ModuleTemplateInfo{
+		Err: errors.New("test error"),
+	}
+	decorated := &lookupStrategyStub{
+		moduleTemplateInfo: expectedModuleTemplateInfo,
+	}
+	withUpgradeApprovalDecorator := moduletemplateinfolookup.NewWithUpgradeApprovalDecorator(upgradeApproval, decorated)
+
+	moduleTemplateInfo := withUpgradeApprovalDecorator.Lookup(context.Background(),
+		nil,
+		nil,
+		nil)
+
+	assert.False(t, upgradeApproval.requiredCalled)
+	assert.False(t, upgradeApproval.approvedCalled)
+	assert.Equal(t, expectedModuleTemplateInfo, moduleTemplateInfo)
+}
+
+func Test_WithUpgradeApprovalDecorator_Lookup_ReturnsModuleTemplateInfo_WhenNoApprovalRequired(t *testing.T) {
+	upgradeApproval := &upgradeApprovalStub{
+		required: false,
+	}
+	expectedModuleTemplateInfo := newModuleTemplateInfo()
+	decorated := &lookupStrategyStub{
+		moduleTemplateInfo: expectedModuleTemplateInfo,
+	}
+	withUpgradeApprovalDecorator := moduletemplateinfolookup.NewWithUpgradeApprovalDecorator(upgradeApproval, decorated)
+
+	moduleTemplateInfo := withUpgradeApprovalDecorator.Lookup(context.Background(),
+		nil,
+		nil,
+		nil)
+
+	assert.True(t, upgradeApproval.requiredCalled)
+	assert.False(t, upgradeApproval.approvedCalled)
+	assert.Equal(t, expectedModuleTemplateInfo, moduleTemplateInfo)
+}
+
+func Test_WithUpgradeApprovalDecorator_Lookup_ReturnsError_WhenIsApprovedReturnsError(t *testing.T) {
+	err := errors.New("test error")
+	upgradeApproval := &upgradeApprovalStub{
+		required: true,
+		err:      err,
+	}
+	decorated := &lookupStrategyStub{
+		moduleTemplateInfo: newModuleTemplateInfo(),
+	}
+	withUpgradeApprovalDecorator := moduletemplateinfolookup.NewWithUpgradeApprovalDecorator(upgradeApproval, decorated)
+
+	moduleTemplateInfo := withUpgradeApprovalDecorator.Lookup(context.Background(),
+		nil,
+		nil,
+		nil)
+
+	assert.True(t, upgradeApproval.requiredCalled)
+	assert.True(t, upgradeApproval.approvedCalled)
+	require.ErrorIs(t, moduleTemplateInfo.Err, moduletemplateinfolookup.ErrFailedToDetermineIfUpgradeIsApproved)
+	require.ErrorIs(t, moduleTemplateInfo.Err, err)
+	assert.Nil(t, moduleTemplateInfo.ModuleTemplate)
+}
+
+func Test_WithUpgradeApprovalDecorator_Lookup_ReturnsErrorAndTemplate_WhenApprovalIsRequiredAndMissing(t *testing.T) {
+	upgradeApproval := &upgradeApprovalStub{
+		required: true,
+		approved: false,
+	}
+	decorated := &lookupStrategyStub{
+		moduleTemplateInfo: newModuleTemplateInfo(),
+	}
+	withUpgradeApprovalDecorator := moduletemplateinfolookup.NewWithUpgradeApprovalDecorator(upgradeApproval, decorated)
+
+	moduleTemplateInfo := withUpgradeApprovalDecorator.Lookup(context.Background(),
+		nil,
+		nil,
+		nil)
+
+	assert.True(t, upgradeApproval.requiredCalled)
+	assert.True(t, upgradeApproval.approvedCalled)
+	require.ErrorIs(t, moduleTemplateInfo.Err, moduletemplateinfolookup.ErrWaitingForUpgradeApproval)
+	require.ErrorContains(t, moduleTemplateInfo.Err, "1.1.0")
+	assert.NotNil(t, moduleTemplateInfo.ModuleTemplate)
+}
+
+func Test_WithUpgradeApprovalDecorator_Lookup_ReturnsModuleTemplateInfo_WhenApprovalIsRequiredAndPresent(t *testing.T) {
+	upgradeApproval := &upgradeApprovalStub{
+		required: true,
+		approved: true,
+	}
+	expectedModuleTemplateInfo := newModuleTemplateInfo()
+	decorated := &lookupStrategyStub{
+		moduleTemplateInfo: expectedModuleTemplateInfo,
+	}
+	withUpgradeApprovalDecorator := moduletemplateinfolookup.NewWithUpgradeApprovalDecorator(upgradeApproval, decorated)
+
+	moduleTemplateInfo := withUpgradeApprovalDecorator.Lookup(context.Background(),
+		nil,
+		nil,
+		nil)
+
+	assert.True(t, upgradeApproval.requiredCalled)
+	assert.True(t, upgradeApproval.approvedCalled)
+	assert.Equal(t, expectedModuleTemplateInfo, moduleTemplateInfo)
+}
+
+func newModuleTemplateInfo() templatelookup.ModuleTemplateInfo {
+	return templatelookup.ModuleTemplateInfo{
+		DesiredChannel: "test",
+		ModuleTemplate: &v1beta2.ModuleTemplate{
+			Spec: v1beta2.ModuleTemplateSpec{
+				Channel: "test",
+				Version: "1.1.0",
+			},
+		},
+	}
+}
+
+type upgradeApprovalStub struct {
+	requiredCalled bool
+	required       bool
+	approvedCalled bool
+	approved       bool
+	err            error
+}
+
+func (s *upgradeApprovalStub) IsRequired(_ *v1beta2.ModuleTemplate, _ *v1beta2.Kyma) bool {
+	s.requiredCalled = true
+	return s.required
+}
+
+func (s *upgradeApprovalStub) IsApproved(_ context.Context, _ *v1beta2.ModuleTemplate, _ *v1beta2.Kyma) (bool, error) {
+	s.approvedCalled = true
+	if s.err != nil {
+		return false, s.err
+	}
+	return s.approved, nil
+}
@@ -99,6 +99,7 @@ func (t *TemplateLookup) GetRegularTemplates(ctx context.Context, kyma *v1beta2.
 		}
 		templates[moduleInfo.Name] = &templateInfo
 	}
+	MarkTenantConflicts(ctx, t, kyma, templates)
 	return templates
 }
 
@@ -0,0 +1,78 @@
+package templatelookup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+var ErrClusterScopedModuleConflict = errors.New("cluster-scoped module is already enabled by another tenant")
+
+// MarkTenantConflicts marks the cluster-scoped modules of a tenant Kyma that are enabled by another tenant of the same
+// SKR cluster, as only one tenant can install them. The module is owned by the tenant that has it installed, or by
+// the first tenant by name if none has it installed yet. Modules labeled as namespaced never conflict.
+func MarkTenantConflicts(ctx context.Context, reader client.Reader, kyma *v1beta2.Kyma,
+	templates ModuleTemplatesByModuleName,
+) {
+	if kyma.GetTenantNamespace() == "" || kyma.GetRuntimeID() == "" {
+		return
+	}
+
+	clusterScoped := make(ModuleTemplatesByModuleName)
+	for name, template := range templates {
+		if template.Err == nil && template.ModuleTemplate != nil && !template.IsNamespacedModule() {
+			clusterScoped[name] = template
+		}
+	}
+	if len(clusterScoped) == 0 {
+		return
+	}
+
+	tenants := &v1beta2.KymaList{}
+	if err := reader.List(ctx, tenants, client.InNamespace(kyma.GetNamespace()),
+		client.MatchingLabels{shared.RuntimeIDLabel: kyma.GetRuntimeID()}); err != nil {
+		for _, template := range clusterScoped {
+			template.Err = fmt.Errorf("failed to list the tenants of the SKR cluster: %w", err)
+		}
+		return
+	}
+	slices.SortFunc(tenants.Items, func(a, b v1beta2.Kyma) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	for name, template := range clusterScoped {
+		owner := clusterScopedModuleOwner(tenants.Items, kyma, name, template.IsMandatory())
+		if owner != "" && owner != kyma.GetName() {
+			template.Err = fmt.Errorf("%w: module %s is owned by Kyma %s", ErrClusterScopedModuleConflict,
+				name, owner)
+		}
+	}
+}
+
+// clusterScopedModuleOwner returns the name of the tenant owning the module, preferring a tenant that has the module
+// installed over the first tenant enabling it.
+func clusterScopedModuleOwner(tenants []v1beta2.Kyma, kyma *v1beta2.Kyma, moduleName string, mandatory bool) string {
+	owner := ""
+	for _, tenant := range tenants {
+		if tenant.Status.GetModuleStatus(moduleName) != nil {
+			return tenant.GetName()
+		}
+		if owner == "" && (mandatory || tenant.GetName() == kyma.GetName() || enablesModule(&tenant, moduleName)) {
+			owner = tenant.GetName()
+		}
+	}
+	return owner
+}
+
+func enablesModule(kyma *v1beta2.Kyma, moduleName string) bool {
+	return slices.ContainsFunc(kyma.Spec.Modules, func(module v1beta2.Module) bool {
+		return module.Name == moduleName
+	})
+}
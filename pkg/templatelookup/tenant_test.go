@@ -0,0 +1,83 @@
+package templatelookup_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+)
+
+func TestMarkTenantConflicts(t *testing.T) {
+	t.Parallel()
+	tenantA := newTenantKyma("tenant-a", "istio", "keda")
+	tenantB := newTenantKyma("tenant-b", "istio", "serverless", "keda")
+	tenantB.Status.Modules = []v1beta2.ModuleStatus{{Name: "keda"}}
+	otherRuntime := newTenantKyma("other", "serverless")
+	otherRuntime.Labels[shared.RuntimeIDLabel] = "other-runtime"
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	clnt := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(tenantA, tenantB, otherRuntime).Build()
+
+	templates := templatelookup.ModuleTemplatesByModuleName{
+		"istio":      newTenantTemplateInfo("istio", false),
+		"serverless": newTenantTemplateInfo("serverless", false),
+		"keda":       newTenantTemplateInfo("keda", false),
+		"api":        newTenantTemplateInfo("api", true),
+	}
+	templatelookup.MarkTenantConflicts(context.Background(), clnt, tenantB, templates)
+
+	require.ErrorIs(t, templates["istio"].Err, templatelookup.ErrClusterScopedModuleConflict)
+	assert.Contains(t, templates["istio"].Err.Error(), "tenant-a")
+	require.NoError(t, templates["serverless"].Err, "the other runtime does not share the SKR cluster")
+	require.NoError(t, templates["keda"].Err, "the module is already installed by the tenant")
+	require.NoError(t, templates["api"].Err, "namespaced modules do not conflict")
+}
+
+func TestMarkTenantConflicts_IgnoresKymasWithoutTenant(t *testing.T) {
+	t.Parallel()
+	kyma := newTenantKyma("kyma", "istio")
+	delete(kyma.Labels, shared.TenantNamespaceLabel)
+	templates := templatelookup.ModuleTemplatesByModuleName{"istio": newTenantTemplateInfo("istio", false)}
+
+	// the reader is not used for Kymas that are not tenants
+	templatelookup.MarkTenantConflicts(context.Background(), nil, kyma, templates)
+
+	require.NoError(t, templates["istio"].Err)
+}
+
+func newTenantKyma(name string, modules ...string) *v1beta2.Kyma {
+	kyma := &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      name,
+			Namespace: "kcp-system",
+			Labels: map[string]string{
+				shared.RuntimeIDLabel:       "runtime",
+				shared.TenantNamespaceLabel: name,
+			},
+		},
+	}
+	for _, module := range modules {
+		kyma.Spec.Modules = append(kyma.Spec.Modules, v1beta2.Module{Name: module})
+	}
+	return kyma
+}
+
+func newTenantTemplateInfo(moduleName string, namespaced bool) *templatelookup.ModuleTemplateInfo {
+	template := &v1beta2.ModuleTemplate{
+		ObjectMeta: apimetav1.ObjectMeta{Name: moduleName + "-1.0.0", Namespace: "kcp-system"},
+		Spec:       v1beta2.ModuleTemplateSpec{ModuleName: moduleName, Version: "1.0.0"},
+	}
+	if namespaced {
+		template.Labels = map[string]string{shared.NamespacedModuleLabel: shared.EnableLabelValue}
+	}
+	return &templatelookup.ModuleTemplateInfo{ModuleTemplate: template}
+}
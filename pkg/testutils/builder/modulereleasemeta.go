@@ -66,6 +66,11 @@ func (m ModuleReleaseMetaBuilder) WithInternal(internal bool) ModuleReleaseMetaB
 	return m
 }
 
+func (m ModuleReleaseMetaBuilder) WithMandatory(rollout *v1beta2.MandatoryModuleRollout) ModuleReleaseMetaBuilder {
+	m.moduleReleaseMeta.Spec.Mandatory = rollout
+	return m
+}
+
 func (m ModuleReleaseMetaBuilder) Build() *v1beta2.ModuleReleaseMeta {
 	return m.moduleReleaseMeta
 }
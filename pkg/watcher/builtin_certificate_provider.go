@@ -0,0 +1,265 @@
+package watcher
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	apicorev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/log"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+const serialNumberBitSize = 128
+
+var (
+	ErrInvalidCASecret     = errors.New("invalid CA secret")
+	ErrInvalidCertificate  = errors.New("invalid certificate")
+	ErrUnsupportedCAKeyAlg = errors.New("unsupported CA private key")
+)
+
+// BuiltinCertificateProvider issues the certificates without cert-manager by signing them with the CA
+// stored in the root CA secret of the Istio Namespace. The certificate secrets are renewed by the
+// CertificateRenewer.
+type BuiltinCertificateProvider struct {
+	kcpClient client.Client
+	config    CertificateConfig
+	labelSet  k8slabels.Set
+}
+
+func NewBuiltinCertificateProvider(kcpClient client.Client, config CertificateConfig) *BuiltinCertificateProvider {
+	return &BuiltinCertificateProvider{
+		kcpClient: kcpClient,
+		config:    config,
+		labelSet: k8slabels.Set{
+			shared.PurposeLabel: shared.BuiltinSigner,
+			shared.ManagedBy:    shared.OperatorName,
+		},
+	}
+}
+
+func (p *BuiltinCertificateProvider) IssueCertificate(ctx context.Context,
+	kyma *v1beta2.Kyma,
+) (*CertificateInfo, error) {
+	subjectAltNames, err := resolveSubjectAltNames(kyma, p.config)
+	if err != nil {
+		return nil, fmt.Errorf("error get Subject Alternative Name from KymaCR: %w", err)
+	}
+
+	secret, err := getCertificateSecret(ctx, p.kcpClient, p.secretKey(kyma.Name))
+	if err != nil && !util.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		certificate, err := parseCertificate(secret.Data[tlsCertKey])
+		if err == nil && !p.requiresRenewal(certificate) && slices.Equal(certificate.DNSNames, subjectAltNames.DNSNames) {
+			if err := p.ensureLabels(ctx, secret); err != nil {
+				return nil, err
+			}
+			return p.certificateInfo(certificate), nil
+		}
+		return p.signCertificateSecret(ctx, secret, subjectAltNames.DNSNames)
+	}
+
+	return p.signCertificateSecret(ctx, &apicorev1.Secret{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      ResolveTLSCertName(kyma.Name),
+			Namespace: p.config.IstioNamespace,
+		},
+	}, subjectAltNames.DNSNames)
+}
+
+func (p *BuiltinCertificateProvider) RemoveCertificate(ctx context.Context, kymaName string) error {
+	return removeCertificateSecret(ctx, p.kcpClient, p.secretKey(kymaName))
+}
+
+func (p *BuiltinCertificateProvider) RemoveSecretAfterCARotated(ctx context.Context,
+	gatewaySecret *apicorev1.Secret, kymaObjKey client.ObjectKey,
+) (bool, error) {
+	return removeSecretAfterCARotated(ctx, p.kcpClient, p.secretKey(kymaObjKey.Name), gatewaySecret, kymaObjKey)
+}
+
+// RenewCertificates re-signs all certificate secrets which are due for renewal, keeping their Subject Alternative Names.
+func (p *BuiltinCertificateProvider) RenewCertificates(ctx context.Context) error {
+	logger := logf.FromContext(ctx)
+	secretList := &apicorev1.SecretList{}
+	if err := p.kcpClient.List(ctx, secretList, &client.ListOptions{
+		LabelSelector: k8slabels.SelectorFromSet(p.labelSet),
+		Namespace:     p.config.IstioNamespace,
+	}); err != nil {
+		return fmt.Errorf("failed to list certificate secrets: %w", err)
+	}
+
+	var errs []error
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		certificate, err := parseCertificate(secret.Data[tlsCertKey])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse certificate of secret %s: %w", secret.Name, err))
+			continue
+		}
+		if !p.requiresRenewal(certificate) {
+			continue
+		}
+		if _, err = p.signCertificateSecret(ctx, secret, certificate.DNSNames); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		logger.V(log.DebugLevel).Info("Renewed certificate", "secret", secret.Name)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ensureLabels relabels a valid certificate secret issued before its labels changed, so that it is renewed.
+func (p *BuiltinCertificateProvider) ensureLabels(ctx context.Context, secret *apicorev1.Secret) error {
+	if k8slabels.SelectorFromSet(p.labelSet).Matches(k8slabels.Set(secret.GetLabels())) {
+		return nil
+	}
+	labels := secret.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string, len(p.labelSet))
+	}
+	for key, value := range p.labelSet {
+		labels[key] = value
+	}
+	secret.SetLabels(labels)
+	if err := p.kcpClient.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update labels of certificate secret %s: %w", secret.Name, err)
+	}
+	return nil
+}
+
+func (p *BuiltinCertificateProvider) secretKey(kymaName string) client.ObjectKey {
+	return client.ObjectKey{Name: ResolveTLSCertName(kymaName), Namespace: p.config.IstioNamespace}
+}
+
+func (p *BuiltinCertificateProvider) requiresRenewal(certificate *x509.Certificate) bool {
+	return !time.Now().Before(certificate.NotAfter.Add(-p.config.RenewBefore))
+}
+
+func (p *BuiltinCertificateProvider) certificateInfo(certificate *x509.Certificate) *CertificateInfo {
+	renewalTime := certificate.NotAfter.Add(-p.config.RenewBefore)
+	return &CertificateInfo{RenewalTime: &renewalTime}
+}
+
+// signCertificateSecret signs a new certificate and private key and stores them in the given secret,
+// which is created if it does not have a resource version yet.
+func (p *BuiltinCertificateProvider) signCertificateSecret(ctx context.Context, secret *apicorev1.Secret,
+	dnsNames []string,
+) (*CertificateInfo, error) {
+	caCert, caKey, err := p.getCA(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, p.config.KeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBitSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		NotBefore:    now,
+		NotAfter:     now.Add(p.config.Duration),
+		DNSNames:     dnsNames,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, &privateKey.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign certificate: %w", err)
+	}
+
+	secret.Type = apicorev1.SecretTypeTLS
+	secret.SetLabels(p.labelSet)
+	secret.Data = map[string][]byte{
+		caCertKey:        pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw}),
+		tlsCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		tlsPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}),
+	}
+	if secret.ResourceVersion == "" {
+		err = p.kcpClient.Create(ctx, secret)
+	} else {
+		err = p.kcpClient.Update(ctx, secret)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to write certificate secret %s: %w", secret.Name, err)
+	}
+
+	return p.certificateInfo(template), nil
+}
+
+func (p *BuiltinCertificateProvider) getCA(ctx context.Context) (*x509.Certificate, crypto.Signer, error) {
+	caSecret := &apicorev1.Secret{}
+	if err := p.kcpClient.Get(ctx, client.ObjectKey{
+		Name:      shared.RootCASecretName,
+		Namespace: p.config.IstioNamespace,
+	}, caSecret); err != nil {
+		return nil, nil, fmt.Errorf("failed to get CA secret %s: %w", shared.RootCASecretName, err)
+	}
+
+	caCert, err := parseCertificate(caSecret.Data[tlsCertKey])
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w %s: %w", ErrInvalidCASecret, shared.RootCASecretName, err)
+	}
+	caKey, err := parsePrivateKey(caSecret.Data[tlsPrivateKeyKey])
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w %s: %w", ErrInvalidCASecret, shared.RootCASecretName, err)
+	}
+
+	return caCert, caKey, nil
+}
+
+func parseCertificate(pemData []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found: %w", ErrInvalidCertificate)
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+	return certificate, nil
+}
+
+func parsePrivateKey(pemData []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found: %w", ErrUnsupportedCAKeyAlg)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, ErrUnsupportedCAKeyAlg
+	}
+	return signer, nil
+}
@@ -0,0 +1,206 @@
+package watcher_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+	"github.com/kyma-project/lifecycle-manager/pkg/watcher"
+)
+
+const (
+	testIstioNamespace = "istio-system"
+	testKymaName       = "test-kyma"
+	testKeySize        = 2048
+)
+
+func TestBuiltinCertificateProvider_IssueCertificate_CreatesSecretSignedByCA(t *testing.T) {
+	caCert, caSecret := newCASecret(t)
+	kcpClient := fake.NewClientBuilder().WithObjects(caSecret).Build()
+	provider := watcher.NewBuiltinCertificateProvider(kcpClient, newCertificateConfig(time.Hour, 30*time.Minute))
+
+	info, err := provider.IssueCertificate(context.Background(), newKyma())
+
+	require.NoError(t, err)
+	require.NotNil(t, info.RenewalTime)
+	assert.WithinDuration(t, time.Now().Add(30*time.Minute), *info.RenewalTime, time.Minute)
+	secret := getCertificateSecret(t, kcpClient)
+	assert.Equal(t, apicorev1.SecretTypeTLS, secret.Type)
+	assert.Equal(t, shared.BuiltinSigner, secret.Labels[shared.PurposeLabel])
+	assert.Equal(t, pemCertificate(caCert), secret.Data["ca.crt"])
+	assert.NotEmpty(t, secret.Data["tls.key"])
+	certificate := parseCertificate(t, secret.Data["tls.crt"])
+	require.NoError(t, certificate.CheckSignatureFrom(caCert))
+	assert.Contains(t, certificate.DNSNames, "example.domain.com")
+	assert.Contains(t, certificate.DNSNames, "skr-webhook.kyma-system.svc")
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		certificate.ExtKeyUsage)
+}
+
+func TestBuiltinCertificateProvider_IssueCertificate_KeepsValidCertificate(t *testing.T) {
+	_, caSecret := newCASecret(t)
+	kcpClient := fake.NewClientBuilder().WithObjects(caSecret).Build()
+	provider := watcher.NewBuiltinCertificateProvider(kcpClient, newCertificateConfig(time.Hour, 30*time.Minute))
+	_, err := provider.IssueCertificate(context.Background(), newKyma())
+	require.NoError(t, err)
+	issuedSecret := getCertificateSecret(t, kcpClient)
+
+	_, err = provider.IssueCertificate(context.Background(), newKyma())
+
+	require.NoError(t, err)
+	assert.Equal(t, issuedSecret.Data, getCertificateSecret(t, kcpClient).Data)
+}
+
+func TestBuiltinCertificateProvider_IssueCertificate_RelabelsValidCertificate(t *testing.T) {
+	_, caSecret := newCASecret(t)
+	kcpClient := fake.NewClientBuilder().WithObjects(caSecret).Build()
+	provider := watcher.NewBuiltinCertificateProvider(kcpClient, newCertificateConfig(time.Hour, 30*time.Minute))
+	_, err := provider.IssueCertificate(context.Background(), newKyma())
+	require.NoError(t, err)
+	issuedSecret := getCertificateSecret(t, kcpClient)
+	issuedSecret.Labels[shared.PurposeLabel] = shared.CertManager
+	require.NoError(t, kcpClient.Update(context.Background(), issuedSecret))
+
+	_, err = provider.IssueCertificate(context.Background(), newKyma())
+
+	require.NoError(t, err)
+	relabeledSecret := getCertificateSecret(t, kcpClient)
+	assert.Equal(t, shared.BuiltinSigner, relabeledSecret.Labels[shared.PurposeLabel])
+	assert.Equal(t, issuedSecret.Data, relabeledSecret.Data)
+}
+
+func TestBuiltinCertificateProvider_IssueCertificate_ReturnsError_WhenCASecretIsMissing(t *testing.T) {
+	kcpClient := fake.NewClientBuilder().Build()
+	provider := watcher.NewBuiltinCertificateProvider(kcpClient, newCertificateConfig(time.Hour, 30*time.Minute))
+
+	_, err := provider.IssueCertificate(context.Background(), newKyma())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get CA secret")
+}
+
+func TestBuiltinCertificateProvider_RenewCertificates_ReissuesCertificateDueForRenewal(t *testing.T) {
+	caCert, caSecret := newCASecret(t)
+	kcpClient := fake.NewClientBuilder().WithObjects(caSecret).Build()
+	_, err := watcher.NewBuiltinCertificateProvider(kcpClient, newCertificateConfig(time.Hour, 30*time.Minute)).
+		IssueCertificate(context.Background(), newKyma())
+	require.NoError(t, err)
+	issuedCertificate := parseCertificate(t, getCertificateSecret(t, kcpClient).Data["tls.crt"])
+
+	// renewing 2 hours before the expiry makes the certificate issued for 1 hour due for renewal
+	err = watcher.NewBuiltinCertificateProvider(kcpClient, newCertificateConfig(time.Hour, 2*time.Hour)).
+		RenewCertificates(context.Background())
+
+	require.NoError(t, err)
+	renewedCertificate := parseCertificate(t, getCertificateSecret(t, kcpClient).Data["tls.crt"])
+	assert.NotEqual(t, issuedCertificate.SerialNumber, renewedCertificate.SerialNumber)
+	assert.Equal(t, issuedCertificate.DNSNames, renewedCertificate.DNSNames)
+	require.NoError(t, renewedCertificate.CheckSignatureFrom(caCert))
+}
+
+func TestBuiltinCertificateProvider_RemoveCertificate_DeletesSecret(t *testing.T) {
+	_, caSecret := newCASecret(t)
+	kcpClient := fake.NewClientBuilder().WithObjects(caSecret).Build()
+	provider := watcher.NewBuiltinCertificateProvider(kcpClient, newCertificateConfig(time.Hour, 30*time.Minute))
+	_, err := provider.IssueCertificate(context.Background(), newKyma())
+	require.NoError(t, err)
+
+	err = provider.RemoveCertificate(context.Background(), testKymaName)
+
+	require.NoError(t, err)
+	err = kcpClient.Get(context.Background(), certificateSecretKey(), &apicorev1.Secret{})
+	assert.True(t, util.IsNotFound(err))
+}
+
+func newCertificateConfig(duration, renewBefore time.Duration) watcher.CertificateConfig {
+	return watcher.CertificateConfig{
+		IstioNamespace:      testIstioNamespace,
+		RemoteSyncNamespace: "kyma-system",
+		Duration:            duration,
+		RenewBefore:         renewBefore,
+		KeySize:             testKeySize,
+	}
+}
+
+func newKyma() *v1beta2.Kyma {
+	return &v1beta2.Kyma{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      testKymaName,
+			Namespace: "kcp-system",
+			Annotations: map[string]string{
+				shared.SKRDomainAnnotation: "example.domain.com",
+			},
+		},
+	}
+}
+
+func newCASecret(t *testing.T) (*x509.Certificate, *apicorev1.Secret) {
+	t.Helper()
+	caKey, err := rsa.GenerateKey(rand.Reader, testKeySize)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "klm-watcher-selfsigned-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	return caCert, &apicorev1.Secret{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name:      shared.RootCASecretName,
+			Namespace: testIstioNamespace,
+		},
+		Data: map[string][]byte{
+			"ca.crt":  pemCertificate(caCert),
+			"tls.crt": pemCertificate(caCert),
+			"tls.key": pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(caKey)}),
+		},
+	}
+}
+
+func pemCertificate(certificate *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw})
+}
+
+func parseCertificate(t *testing.T, pemData []byte) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode(pemData)
+	require.NotNil(t, block)
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return certificate
+}
+
+func certificateSecretKey() client.ObjectKey {
+	return client.ObjectKey{Name: watcher.ResolveTLSCertName(testKymaName), Namespace: testIstioNamespace}
+}
+
+func getCertificateSecret(t *testing.T, kcpClient client.Client) *apicorev1.Secret {
+	t.Helper()
+	secret := &apicorev1.Secret{}
+	require.NoError(t, kcpClient.Get(context.Background(), certificateSecretKey(), secret))
+	return secret
+}
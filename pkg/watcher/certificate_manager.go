@@ -129,17 +129,22 @@ func (c *CertificateManager) removeCertificate(ctx context.Context) error {
 }
 
 func (c *CertificateManager) removeSecret(ctx context.Context) error {
+	return removeCertificateSecret(ctx, c.kcpClient, c.secretKey())
+}
+
+func (c *CertificateManager) secretKey() client.ObjectKey {
+	return client.ObjectKey{Name: c.secretName, Namespace: c.config.IstioNamespace}
+}
+
+func removeCertificateSecret(ctx context.Context, kcpClient client.Client, secretKey client.ObjectKey) error {
 	certSecret := &apicorev1.Secret{}
-	err := c.kcpClient.Get(ctx, client.ObjectKey{
-		Name:      c.secretName,
-		Namespace: c.config.IstioNamespace,
-	}, certSecret)
+	err := kcpClient.Get(ctx, secretKey, certSecret)
 	if err != nil && !util.IsNotFound(err) {
 		return fmt.Errorf("failed to get certificate secret: %w", err)
 	}
 
 	if err == nil {
-		if err = c.kcpClient.Delete(ctx, certSecret); err != nil {
+		if err = kcpClient.Delete(ctx, certSecret); err != nil {
 			return fmt.Errorf("failed to delete certificate secret: %w", err)
 		}
 	}
@@ -200,6 +205,10 @@ func (c *CertificateManager) patchCertificate(ctx context.Context,
 }
 
 func (c *CertificateManager) getSubjectAltNames(kyma *v1beta2.Kyma) (*SubjectAltName, error) {
+	return resolveSubjectAltNames(kyma, c.config)
+}
+
+func resolveSubjectAltNames(kyma *v1beta2.Kyma, config CertificateConfig) (*SubjectAltName, error) {
 	if domain, ok := kyma.Annotations[DomainAnnotation]; ok {
 		if domain == "" {
 			return nil, fmt.Errorf("%w (Kyma: %s)", ErrDomainAnnotationEmpty, kyma.Name)
@@ -207,12 +216,16 @@ func (c *CertificateManager) getSubjectAltNames(kyma *v1beta2.Kyma) (*SubjectAlt
 
 		svcSuffix := []string{"svc.cluster.local", "svc"}
 		dnsNames := []string{domain}
+		remoteNs := config.RemoteSyncNamespace
+		if tenantNamespace := kyma.GetTenantNamespace(); tenantNamespace != "" {
+			remoteNs = tenantNamespace
+		}
 
 		for _, suffix := range svcSuffix {
-			dnsNames = append(dnsNames, fmt.Sprintf("%s.%s.%s", SkrResourceName, c.config.RemoteSyncNamespace, suffix))
+			dnsNames = append(dnsNames, fmt.Sprintf("%s.%s.%s", SkrResourceName, remoteNs, suffix))
 		}
 
-		dnsNames = append(dnsNames, c.config.AdditionalDNSNames...)
+		dnsNames = append(dnsNames, config.AdditionalDNSNames...)
 
 		return &SubjectAltName{
 			DNSNames: dnsNames,
@@ -241,12 +254,13 @@ func (c *CertificateManager) getIssuer(ctx context.Context) (*certmanagerv1.Issu
 	return &issuerList.Items[0], nil
 }
 
-func (c *CertificateManager) getCertificateSecret(ctx context.Context) (*apicorev1.Secret, error) {
+func getCertificateSecret(ctx context.Context, kcpClient client.Client,
+	secretKey client.ObjectKey,
+) (*apicorev1.Secret, error) {
 	secret := &apicorev1.Secret{}
-	err := c.kcpClient.Get(ctx, client.ObjectKey{Name: c.secretName, Namespace: c.config.IstioNamespace},
-		secret)
+	err := kcpClient.Get(ctx, secretKey, secret)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get secret for certificate %s-%s: %w", c.secretName, c.config.IstioNamespace,
+		return nil, fmt.Errorf("failed to get secret for certificate %s-%s: %w", secretKey.Name, secretKey.Namespace,
 			err)
 	}
 
@@ -259,23 +273,37 @@ func (e *CertificateNotReadyError) Error() string {
 	return "Certificate-Secret does not exist"
 }
 
+// RemoveSecretAfterCARotated removes the certificate secret if it was issued before the last CA rotation,
+// so that cert-manager re-issues it with the rotated CA. The gateway keeps trusting the previous CA during
+// the rotation grace period, which keeps the SKR webhook working until the re-issued secret is pushed.
+// It reports whether the re-issued certificate secret is still pending.
 func (c *CertificateManager) RemoveSecretAfterCARotated(ctx context.Context, gatewaySecret *apicorev1.Secret,
 	kymaObjKey client.ObjectKey,
-) error {
-	watcherSecret, err := c.getCertificateSecret(ctx)
+) (bool, error) {
+	return removeSecretAfterCARotated(ctx, c.kcpClient, c.secretKey(), gatewaySecret, kymaObjKey)
+}
+
+func removeSecretAfterCARotated(ctx context.Context, kcpClient client.Client, secretKey client.ObjectKey,
+	gatewaySecret *apicorev1.Secret, kymaObjKey client.ObjectKey,
+) (bool, error) {
+	watcherSecret, err := getCertificateSecret(ctx, kcpClient, secretKey)
+	if util.IsNotFound(err) {
+		return true, nil
+	}
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	if watcherSecret != nil && SecretRequiresRotation(gatewaySecret, watcherSecret) {
+	if SecretRequiresRotation(gatewaySecret, watcherSecret) {
 		logf.FromContext(ctx).V(log.DebugLevel).Info("CA Certificate was rotated, removing certificate",
 			"kyma", kymaObjKey)
-		if err = c.removeSecret(ctx); err != nil {
-			return fmt.Errorf("error while removing certificate: %w", err)
+		if err = removeCertificateSecret(ctx, kcpClient, secretKey); err != nil {
+			return false, fmt.Errorf("error while removing certificate: %w", err)
 		}
+		return true, nil
 	}
 
-	return nil
+	return false, nil
 }
 
 func SecretRequiresRotation(gatewaySecret *apicorev1.Secret, watcherSecret *apicorev1.Secret) bool {
@@ -0,0 +1,71 @@
+package watcher
+
+import (
+	"context"
+	"time"
+
+	apicorev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// CertificateProvider issues the client certificates the SKR webhook uses for the mTLS connection to KCP.
+// Independent of the implementation, the certificate is stored in a Secret named by ResolveTLSCertName
+// in the Istio Namespace, containing the ca.crt, tls.crt and tls.key entries.
+type CertificateProvider interface {
+	// IssueCertificate ensures a certificate for the given Kyma is issued and returns its renewal information.
+	IssueCertificate(ctx context.Context, kyma *v1beta2.Kyma) (*CertificateInfo, error)
+	// RemoveCertificate removes the certificate of the given Kyma including its certificate secret.
+	RemoveCertificate(ctx context.Context, kymaName string) error
+	// RemoveSecretAfterCARotated removes the certificate secret if it was issued before the last CA rotation
+	// and reports whether the re-issued certificate secret is still pending.
+	RemoveSecretAfterCARotated(ctx context.Context, gatewaySecret *apicorev1.Secret,
+		kymaObjKey client.ObjectKey) (bool, error)
+}
+
+type CertificateInfo struct {
+	// RenewalTime is the time the certificate is expected to be renewed, nil if it is not known yet.
+	RenewalTime *time.Time
+}
+
+// CertManagerCertificateProvider issues the certificates by creating cert-manager Certificates.
+type CertManagerCertificateProvider struct {
+	kcpClient client.Client
+	config    CertificateConfig
+}
+
+func NewCertManagerCertificateProvider(kcpClient client.Client,
+	config CertificateConfig,
+) *CertManagerCertificateProvider {
+	return &CertManagerCertificateProvider{
+		kcpClient: kcpClient,
+		config:    config,
+	}
+}
+
+func (p *CertManagerCertificateProvider) IssueCertificate(ctx context.Context,
+	kyma *v1beta2.Kyma,
+) (*CertificateInfo, error) {
+	certificate, err := NewCertificateManager(p.kcpClient, kyma.Name, p.config).CreateSelfSignedCert(ctx, kyma)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &CertificateInfo{}
+	if certificate.Status.RenewalTime != nil {
+		info.RenewalTime = &certificate.Status.RenewalTime.Time
+	}
+	return info, nil
+}
+
+func (p *CertManagerCertificateProvider) RemoveCertificate(ctx context.Context, kymaName string) error {
+	return NewCertificateManager(p.kcpClient, kymaName, p.config).Remove(ctx)
+}
+
+func (p *CertManagerCertificateProvider) RemoveSecretAfterCARotated(ctx context.Context,
+	gatewaySecret *apicorev1.Secret, kymaObjKey client.ObjectKey,
+) (bool, error) {
+	return NewCertificateManager(p.kcpClient, kymaObjKey.Name, p.config).
+		RemoveSecretAfterCARotated(ctx, gatewaySecret, kymaObjKey)
+}
@@ -0,0 +1,37 @@
+package watcher
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// CertificateRenewer is a manager.Runnable which periodically renews the certificates
+// issued by the BuiltinCertificateProvider. It only runs on the elected leader.
+type CertificateRenewer struct {
+	provider *BuiltinCertificateProvider
+	interval time.Duration
+}
+
+func NewCertificateRenewer(provider *BuiltinCertificateProvider, interval time.Duration) *CertificateRenewer {
+	return &CertificateRenewer{
+		provider: provider,
+		interval: interval,
+	}
+}
+
+func (r *CertificateRenewer) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("certificate-renewer")
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.provider.RenewCertificates(ctx); err != nil {
+			logger.Error(err, "failed to renew certificates")
+		}
+	}, r.interval)
+	return nil
+}
+
+func (r *CertificateRenewer) NeedLeaderElection() bool {
+	return true
+}
@@ -2,15 +2,19 @@ package watcher
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"time"
 
-	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/go-logr/logr"
 	apicorev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -24,13 +28,14 @@ import (
 )
 
 type SKRWebhookManifestManager struct {
-	kcpClient         client.Client
-	skrContextFactory remote.SkrContextProvider
-	config            SkrWebhookManagerConfig
-	kcpAddr           string
-	baseResources     []*unstructured.Unstructured
-	WatcherMetrics    *metrics.WatcherMetrics
-	certificateConfig CertificateConfig
+	kcpClient           client.Client
+	skrContextFactory   remote.SkrContextProvider
+	config              SkrWebhookManagerConfig
+	kcpAddr             string
+	baseResources       []*unstructured.Unstructured
+	WatcherMetrics      *metrics.WatcherMetrics
+	certificateConfig   CertificateConfig
+	certificateProvider CertificateProvider
 }
 
 type SkrWebhookManagerConfig struct {
@@ -50,6 +55,7 @@ func NewSKRWebhookManifestManager(
 	skrContextFactory remote.SkrContextProvider,
 	managerConfig SkrWebhookManagerConfig,
 	certificateConfig CertificateConfig,
+	certificateProvider CertificateProvider,
 	resolvedKcpAddr string,
 ) (*SKRWebhookManifestManager, error) {
 	ctx, cancel := context.WithCancel(context.TODO())
@@ -66,13 +72,14 @@ func NewSKRWebhookManifestManager(
 	}
 
 	return &SKRWebhookManifestManager{
-		kcpClient:         kcpClient,
-		skrContextFactory: skrContextFactory,
-		config:            managerConfig,
-		certificateConfig: certificateConfig,
-		kcpAddr:           resolvedKcpAddr,
-		baseResources:     baseResources,
-		WatcherMetrics:    metrics.NewWatcherMetrics(),
+		kcpClient:           kcpClient,
+		skrContextFactory:   skrContextFactory,
+		config:              managerConfig,
+		certificateConfig:   certificateConfig,
+		certificateProvider: certificateProvider,
+		kcpAddr:             resolvedKcpAddr,
+		baseResources:       baseResources,
+		WatcherMetrics:      metrics.NewWatcherMetrics(),
 	}, nil
 }
 
@@ -89,31 +96,36 @@ func (m *SKRWebhookManifestManager) Install(ctx context.Context, kyma *v1beta2.K
 		return err
 	}
 
-	// Create CertificateCR which will be used for mTLS connection from SKR to KCP
-	certificateMgr := NewCertificateManager(m.kcpClient, kyma.Name,
-		m.certificateConfig)
-
-	certificate, err := certificateMgr.CreateSelfSignedCert(ctx, kyma)
+	// Issue the certificate which will be used for mTLS connection from SKR to KCP
+	certificate, err := m.certificateProvider.IssueCertificate(ctx, kyma)
 	if err != nil {
-		return fmt.Errorf("error while patching certificate: %w", err)
+		return fmt.Errorf("error while issuing certificate: %w", err)
 	}
 
-	m.updateCertNotRenewMetrics(certificate, kyma)
+	certNotRenewed := m.updateCertNotRenewMetrics(certificate, kyma)
 
-	if err = certificateMgr.RemoveSecretAfterCARotated(ctx, gatewaySecret, kymaObjKey); err != nil {
+	rotationPending, err := m.certificateProvider.RemoveSecretAfterCARotated(ctx, gatewaySecret, kymaObjKey)
+	if err != nil {
 		return fmt.Errorf("error verify CA cert rotation: %w", err)
 	}
+	m.WatcherMetrics.SetCertRotationPending(kyma.Name, rotationPending)
+	updateCertificateCondition(kyma, !certNotRenewed && !rotationPending)
 
 	logger.V(log.DebugLevel).Info("Successfully created Certificate", "kyma", kymaObjKey)
 
-	resources, err := m.getSKRClientObjectsForInstall(
-		ctx, kymaObjKey, m.config.RemoteSyncNamespace, gatewaySecret, logger)
+	remoteNs := m.remoteNamespace(skrContext)
+	resourcesConfig, err := m.getUnstructuredResourcesConfig(ctx, kymaObjKey, remoteNs,
+		skrContext.TenantNamespace(), gatewaySecret)
+	if err != nil {
+		return err
+	}
+	resources, err := m.getSKRClientObjectsForInstall(ctx, resourcesConfig, logger)
 	if err != nil {
 		return err
 	}
 	err = runResourceOperationWithGroupedErrors(ctx, skrContext.Client, resources,
 		func(ctx context.Context, clt client.Client, resource client.Object) error {
-			resource.SetNamespace(m.config.RemoteSyncNamespace)
+			resource.SetNamespace(remoteNs)
 			err := clt.Patch(ctx, resource, client.Apply, client.ForceOwnership, skrChartFieldOwner)
 			if err != nil {
 				return fmt.Errorf("failed to patch resource %s: %w", resource.GetName(), err)
@@ -123,20 +135,68 @@ func (m *SKRWebhookManifestManager) Install(ctx context.Context, kyma *v1beta2.K
 	if err != nil {
 		return fmt.Errorf("failed to apply webhook resources: %w", err)
 	}
+	if err = m.markCertificateSynced(ctx, kymaObjKey, resourcesConfig); err != nil {
+		return err
+	}
 	logger.V(log.DebugLevel).Info("successfully installed webhook resources",
 		"kyma", kymaObjKey.String())
 	return nil
 }
 
-func (m *SKRWebhookManifestManager) updateCertNotRenewMetrics(certificate *certmanagerv1.Certificate,
+// markCertificateSynced records the notBefore time of the certificate synced to the SKR cluster on the certificate
+// secret in KCP. The secret is only marked if it was not re-issued since it was read for the sync.
+func (m *SKRWebhookManifestManager) markCertificateSynced(ctx context.Context, kymaObjKey client.ObjectKey,
+	cfg *unstructuredResourcesConfig,
+) error {
+	certificate, err := parseCertificate(cfg.tlsCert)
+	if err != nil {
+		return fmt.Errorf("invalid certificate synced to the SKR cluster: %w", err)
+	}
+	notBefore := certificate.NotBefore.UTC().Format(time.RFC3339)
+	if cfg.syncedCertNotBefore == notBefore {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]any{"metadata": map[string]any{
+		"resourceVersion": cfg.secretResVer,
+		"annotations":     map[string]string{shared.SyncedCertificateNotBeforeAnnotation: notBefore},
+	}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal synced certificate patch: %w", err)
+	}
+	tlsSecret := &apicorev1.Secret{ObjectMeta: apimetav1.ObjectMeta{
+		Name:      ResolveTLSCertName(kymaObjKey.Name),
+		Namespace: m.certificateConfig.IstioNamespace,
+	}}
+	err = m.kcpClient.Patch(ctx, tlsSecret, client.RawPatch(types.MergePatchType, patch))
+	if apierrors.IsConflict(err) || util.IsNotFound(err) {
+		// the certificate was re-issued meanwhile, it is marked once it is synced
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to mark the certificate secret as synced: %w", err)
+	}
+	return nil
+}
+
+func (m *SKRWebhookManifestManager) updateCertNotRenewMetrics(certificate *CertificateInfo,
 	kyma *v1beta2.Kyma,
-) {
-	if certificate.Status.RenewalTime != nil &&
-		time.Now().Add(-m.certificateConfig.RenewBuffer).After(certificate.Status.RenewalTime.Time) {
+) bool {
+	if certificate.RenewalTime != nil &&
+		time.Now().Add(-m.certificateConfig.RenewBuffer).After(*certificate.RenewalTime) {
 		m.WatcherMetrics.SetCertNotRenew(kyma.Name)
-	} else {
-		m.WatcherMetrics.CleanupMetrics(kyma.Name)
+		return true
+	}
+	m.WatcherMetrics.CleanupMetrics(kyma.Name)
+	return false
+}
+
+func updateCertificateCondition(kyma *v1beta2.Kyma, certificateIsCurrent bool) {
+	if certificateIsCurrent {
+		kyma.UpdateCondition(v1beta2.ConditionTypeSKRWebhookCertificate, apimetav1.ConditionTrue)
+		return
 	}
+	kyma.UpdateCondition(v1beta2.ConditionTypeSKRWebhookCertificate, apimetav1.ConditionFalse)
 }
 
 func (m *SKRWebhookManifestManager) Remove(ctx context.Context, kyma *v1beta2.Kyma) error {
@@ -151,13 +211,22 @@ func (m *SKRWebhookManifestManager) Remove(ctx context.Context, kyma *v1beta2.Ky
 		return err
 	}
 
-	skrClientObjects := m.getBaseClientObjects()
+	remoteNs := m.remoteNamespace(skrContext)
+	tenantNs := skrContext.TenantNamespace()
+	skrClientObjects := m.getBaseClientObjects(tenantNs)
 	genClientObjects := getGeneratedClientObjects(&unstructuredResourcesConfig{}, []v1beta2.Watcher{},
-		m.config.RemoteSyncNamespace)
+		remoteNs, tenantNs)
 	skrClientObjects = append(skrClientObjects, genClientObjects...)
+	inUse, err := isSkrWebhookInUse(ctx, skrContext.Client, tenantResourceName(SkrResourceName, tenantNs))
+	if err != nil {
+		return err
+	}
+	if inUse {
+		skrClientObjects = slices.DeleteFunc(skrClientObjects, isSharedClusterResource)
+	}
 	err = runResourceOperationWithGroupedErrors(ctx, skrContext.Client, skrClientObjects,
 		func(ctx context.Context, clt client.Client, resource client.Object) error {
-			resource.SetNamespace(m.config.RemoteSyncNamespace)
+			resource.SetNamespace(remoteNs)
 			err = clt.Delete(ctx, resource)
 			if err != nil {
 				return fmt.Errorf("failed to delete resource %s: %w", resource.GetName(), err)
@@ -172,10 +241,17 @@ func (m *SKRWebhookManifestManager) Remove(ctx context.Context, kyma *v1beta2.Ky
 	return nil
 }
 
+// remoteNamespace returns the namespace of the webhook resources, which is the tenant namespace for tenants of
+// a shared SKR cluster.
+func (m *SKRWebhookManifestManager) remoteNamespace(skrContext *remote.SkrContext) string {
+	if tenantNs := skrContext.TenantNamespace(); tenantNs != "" {
+		return tenantNs
+	}
+	return m.config.RemoteSyncNamespace
+}
+
 func (m *SKRWebhookManifestManager) RemoveKCPCertificate(ctx context.Context, kymaName string) error {
-	certificate := NewCertificateManager(m.kcpClient, kymaName,
-		m.certificateConfig)
-	if err := certificate.Remove(ctx); err != nil {
+	if err := m.certificateProvider.RemoveCertificate(ctx, kymaName); err != nil {
 		return err
 	}
 
@@ -183,13 +259,9 @@ func (m *SKRWebhookManifestManager) RemoveKCPCertificate(ctx context.Context, ky
 }
 
 func (m *SKRWebhookManifestManager) getSKRClientObjectsForInstall(ctx context.Context,
-	kymaObjKey client.ObjectKey, remoteNs string, gatewaySecret *apicorev1.Secret, logger logr.Logger,
+	resourcesConfig *unstructuredResourcesConfig, logger logr.Logger,
 ) ([]client.Object, error) {
 	var skrClientObjects []client.Object
-	resourcesConfig, err := m.getUnstructuredResourcesConfig(ctx, kymaObjKey, remoteNs, gatewaySecret)
-	if err != nil {
-		return nil, err
-	}
 	resources, err := m.getRawManifestClientObjects(resourcesConfig)
 	if err != nil {
 		return nil, err
@@ -200,7 +272,8 @@ func (m *SKRWebhookManifestManager) getSKRClientObjectsForInstall(ctx context.Co
 		return nil, err
 	}
 	logger.V(log.DebugLevel).Info(fmt.Sprintf("using %d watchers to generate webhook configs", len(watchers)))
-	genClientObjects := getGeneratedClientObjects(resourcesConfig, watchers, remoteNs)
+	genClientObjects := getGeneratedClientObjects(resourcesConfig, watchers, resourcesConfig.remoteNs,
+		resourcesConfig.tenantNs)
 	return append(skrClientObjects, genClientObjects...), nil
 }
 
@@ -221,13 +294,16 @@ func (m *SKRWebhookManifestManager) getRawManifestClientObjects(cfg *unstructure
 		if err != nil {
 			return nil, fmt.Errorf("failed to configure %s resource: %w", resource.GetKind(), err)
 		}
+		if isTenantClusterResource(configuredResource) {
+			configuredResource.SetName(tenantResourceName(configuredResource.GetName(), cfg.tenantNs))
+		}
 		resources = append(resources, configuredResource)
 	}
 	return resources, nil
 }
 
 func (m *SKRWebhookManifestManager) getUnstructuredResourcesConfig(ctx context.Context,
-	kymaObjKey client.ObjectKey, remoteNs string, gatewaySecret *apicorev1.Secret,
+	kymaObjKey client.ObjectKey, remoteNs, tenantNs string, gatewaySecret *apicorev1.Secret,
 ) (*unstructuredResourcesConfig, error) {
 	tlsSecret := &apicorev1.Secret{}
 	certObjKey := client.ObjectKey{
@@ -243,26 +319,31 @@ func (m *SKRWebhookManifestManager) getUnstructuredResourcesConfig(ctx context.C
 	}
 
 	return &unstructuredResourcesConfig{
-		contractVersion: version,
-		kcpAddress:      m.kcpAddr,
-		secretResVer:    tlsSecret.ResourceVersion,
-		cpuResLimit:     m.config.SkrWebhookCPULimits,
-		memResLimit:     m.config.SkrWebhookMemoryLimits,
-		skrWatcherImage: m.config.SkrWatcherImage,
-		caCert:          gatewaySecret.Data[caCertKey],
-		tlsCert:         tlsSecret.Data[tlsCertKey],
-		tlsKey:          tlsSecret.Data[tlsPrivateKeyKey],
-		remoteNs:        remoteNs,
+		contractVersion:     version,
+		kcpAddress:          m.kcpAddr,
+		secretResVer:        tlsSecret.ResourceVersion,
+		syncedCertNotBefore: tlsSecret.Annotations[shared.SyncedCertificateNotBeforeAnnotation],
+		cpuResLimit:         m.config.SkrWebhookCPULimits,
+		memResLimit:         m.config.SkrWebhookMemoryLimits,
+		skrWatcherImage:     m.config.SkrWatcherImage,
+		caCert:              gatewaySecret.Data[caCertKey],
+		tlsCert:             tlsSecret.Data[tlsCertKey],
+		tlsKey:              tlsSecret.Data[tlsPrivateKeyKey],
+		remoteNs:            remoteNs,
+		tenantNs:            tenantNs,
 	}, nil
 }
 
-func (m *SKRWebhookManifestManager) getBaseClientObjects() []client.Object {
+func (m *SKRWebhookManifestManager) getBaseClientObjects(tenantNs string) []client.Object {
 	if len(m.baseResources) == 0 {
 		return nil
 	}
 	baseClientObjects := make([]client.Object, 0)
 	for _, res := range m.baseResources {
 		resCopy := res.DeepCopy()
+		if isTenantClusterResource(resCopy) {
+			resCopy.SetName(tenantResourceName(resCopy.GetName(), tenantNs))
+		}
 		baseClientObjects = append(baseClientObjects, resCopy)
 	}
 	return baseClientObjects
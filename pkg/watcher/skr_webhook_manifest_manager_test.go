@@ -0,0 +1,290 @@
+package watcher_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apiappsv1 "k8s.io/api/apps/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	apirbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/kyma-project/lifecycle-manager/api"
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/remote"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+	"github.com/kyma-project/lifecycle-manager/pkg/watcher"
+)
+
+var errSkrUnreachable = errors.New("SKR cluster is unreachable")
+
+// The manager registers its metrics globally, so it is created once and its clients are replaced by each test.
+var (
+	managerOnce        sync.Once
+	sharedManager      *watcher.SKRWebhookManifestManager
+	errManager         error
+	managerKcpClient   = &kcpClientDelegate{}
+	managerSkrContexts = &tenantSkrContextProvider{}
+)
+
+type kcpClientDelegate struct {
+	client.Client
+}
+
+func newSKRWebhookManifestManager(t *testing.T, kcpClient, skrClient client.Client,
+	kymas ...*v1beta2.Kyma,
+) *watcher.SKRWebhookManifestManager {
+	t.Helper()
+	managerOnce.Do(func() {
+		sharedManager, errManager = watcher.NewSKRWebhookManifestManager(managerKcpClient, managerSkrContexts,
+			watcher.SkrWebhookManagerConfig{
+				SKRWatcherPath:         "../../skr-webhook",
+				SkrWatcherImage:        "skr-watcher:latest",
+				SkrWebhookMemoryLimits: "200Mi",
+				SkrWebhookCPULimits:    "1",
+				RemoteSyncNamespace:    shared.DefaultRemoteNamespace,
+			},
+			watcher.CertificateConfig{IstioNamespace: testIstioNamespace},
+			&stubCertificateProvider{}, "kcp.example.com")
+	})
+	require.NoError(t, errManager)
+	managerKcpClient.Client = kcpClient
+	managerSkrContexts.client = skrClient
+	managerSkrContexts.kymas = kymas
+	return sharedManager
+}
+
+func TestSKRWebhookManifestManager_RemoveKeepsWebhookOfOtherTenant(t *testing.T) {
+	ctx := context.Background()
+	skrClient := newSkrClient(t)
+	kcpClient := fake.NewClientBuilder().WithScheme(newKcpScheme(t)).WithObjects(
+		&apicorev1.Secret{ObjectMeta: apimetav1.ObjectMeta{
+			Name: shared.GatewaySecretName, Namespace: shared.IstioNamespace,
+		}},
+		newTLSSecret(t, "kyma-a"),
+		newTLSSecret(t, "kyma-b"),
+		&v1beta2.Watcher{
+			ObjectMeta: apimetav1.ObjectMeta{Name: "kyma-watcher", Namespace: "kcp-system"},
+			Spec: v1beta2.WatcherSpec{
+				ResourceToWatch: v1beta2.WatchableGVR{
+					Group: shared.OperatorGroup, Version: v1beta2.GroupVersion.Version, Resource: "kymas",
+				},
+				Field: v1beta2.SpecField,
+			},
+		},
+	).Build()
+	kymaA := newTenantKyma("kyma-a", "tenant-a")
+	kymaB := newTenantKyma("kyma-b", "tenant-b")
+	manager := newSKRWebhookManifestManager(t, kcpClient, skrClient, kymaA, kymaB)
+
+	require.NoError(t, manager.Install(ctx, kymaA))
+	require.NoError(t, manager.Install(ctx, kymaB))
+	require.NoError(t, manager.Remove(ctx, kymaA))
+
+	assertNotFound(t, skrClient, &admissionregistrationv1.ValidatingWebhookConfiguration{},
+		client.ObjectKey{Name: "skr-webhook-tenant-a"})
+	assertNotFound(t, skrClient, &apiappsv1.Deployment{},
+		client.ObjectKey{Name: watcher.SkrResourceName, Namespace: "tenant-a"})
+	assertNotFound(t, skrClient, &apicorev1.Secret{},
+		client.ObjectKey{Name: watcher.SkrTLSName, Namespace: "tenant-a"})
+
+	webhookConfig := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	require.NoError(t, skrClient.Get(ctx, client.ObjectKey{Name: "skr-webhook-tenant-b"}, webhookConfig))
+	assert.Equal(t, watcher.SkrResourceName, webhookConfig.Webhooks[0].ClientConfig.Service.Name)
+	assert.Equal(t, "tenant-b", webhookConfig.Webhooks[0].ClientConfig.Service.Namespace)
+	assert.Equal(t, map[string]string{"kubernetes.io/metadata.name": "tenant-b"},
+		webhookConfig.Webhooks[0].NamespaceSelector.MatchLabels)
+	require.NoError(t, skrClient.Get(ctx,
+		client.ObjectKey{Name: watcher.SkrResourceName, Namespace: "tenant-b"}, &apiappsv1.Deployment{}))
+	require.NoError(t, skrClient.Get(ctx,
+		client.ObjectKey{Name: watcher.SkrTLSName, Namespace: "tenant-b"}, &apicorev1.Secret{}))
+	binding := &apirbacv1.ClusterRoleBinding{}
+	require.NoError(t, skrClient.Get(ctx, client.ObjectKey{Name: "read-kymas-tenant-b"}, binding))
+	assert.Equal(t, "tenant-b", binding.Subjects[0].Namespace)
+	require.NoError(t, skrClient.Get(ctx, client.ObjectKey{Name: "kyma-reader"}, &apirbacv1.ClusterRole{}))
+	require.NoError(t, skrClient.Get(ctx,
+		client.ObjectKey{Name: "skr-webhook-priority"}, &schedulingv1.PriorityClass{}))
+
+	require.NoError(t, manager.Remove(ctx, kymaB))
+
+	assertNotFound(t, skrClient, &apirbacv1.ClusterRole{}, client.ObjectKey{Name: "kyma-reader"})
+	assertNotFound(t, skrClient, &schedulingv1.PriorityClass{}, client.ObjectKey{Name: "skr-webhook-priority"})
+}
+
+func TestSKRWebhookManifestManager_Install_MarksCertificateSyncedOnlyOnceSKRSecretIsUpdated(t *testing.T) {
+	ctx := context.Background()
+	tlsSecret := newTLSSecret(t, "kyma-a")
+	kcpClient := fake.NewClientBuilder().WithScheme(newKcpScheme(t)).WithObjects(
+		&apicorev1.Secret{ObjectMeta: apimetav1.ObjectMeta{
+			Name: shared.GatewaySecretName, Namespace: shared.IstioNamespace,
+		}},
+		tlsSecret,
+	).Build()
+	skrClient := &unreachableSkrClient{Client: newSkrClient(t), unreachable: true}
+	kyma := newTenantKyma("kyma-a", "tenant-a")
+	manager := newSKRWebhookManifestManager(t, kcpClient, skrClient, kyma)
+
+	require.ErrorIs(t, manager.Install(ctx, kyma), errSkrUnreachable)
+
+	kcpTLSSecret := &apicorev1.Secret{}
+	require.NoError(t, kcpClient.Get(ctx, client.ObjectKeyFromObject(tlsSecret), kcpTLSSecret))
+	assert.NotContains(t, kcpTLSSecret.Annotations, shared.SyncedCertificateNotBeforeAnnotation)
+
+	skrClient.unreachable = false
+	require.NoError(t, manager.Install(ctx, kyma))
+
+	skrTLSSecret := &apicorev1.Secret{}
+	require.NoError(t, skrClient.Get(ctx,
+		client.ObjectKey{Name: watcher.SkrTLSName, Namespace: "tenant-a"}, skrTLSSecret))
+	assert.Equal(t, tlsSecret.Data["tls.crt"], skrTLSSecret.Data["tls.crt"])
+	require.NoError(t, kcpClient.Get(ctx, client.ObjectKeyFromObject(tlsSecret), kcpTLSSecret))
+	certificate := parseCertificate(t, skrTLSSecret.Data["tls.crt"])
+	assert.Equal(t, certificate.NotBefore.UTC().Format(time.RFC3339),
+		kcpTLSSecret.Annotations[shared.SyncedCertificateNotBeforeAnnotation])
+}
+
+func assertNotFound(t *testing.T, clnt client.Client, obj client.Object, key client.ObjectKey) {
+	t.Helper()
+	err := clnt.Get(context.Background(), key, obj)
+	assert.True(t, util.IsNotFound(err), "expected %s to be deleted, got %v", key, err)
+}
+
+func newTenantKyma(name, tenantNamespace string) *v1beta2.Kyma {
+	return &v1beta2.Kyma{ObjectMeta: apimetav1.ObjectMeta{
+		Name:      name,
+		Namespace: "kcp-system",
+		Labels:    map[string]string{shared.TenantNamespaceLabel: tenantNamespace},
+	}}
+}
+
+// newTLSSecret returns the certificate secret of the Kyma with a self-signed certificate.
+func newTLSSecret(t *testing.T, kymaName string) *apicorev1.Secret {
+	t.Helper()
+	_, secret := newCASecret(t)
+	secret.SetName(watcher.ResolveTLSCertName(kymaName))
+	return secret
+}
+
+func newKcpScheme(t *testing.T) *machineryruntime.Scheme {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, api.AddToScheme(scheme))
+	return scheme
+}
+
+// newSkrClient returns a fake SKR client which treats server-side apply patches as create or update and, like
+// the real client, ignores the namespace of cluster-scoped resources.
+func newSkrClient(t *testing.T) client.Client {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	for gvk := range scheme.AllKnownTypes() {
+		scope := meta.RESTScopeNamespace
+		switch gvk.Kind {
+		case "ClusterRole", "ClusterRoleBinding", "PriorityClass", "ValidatingWebhookConfiguration":
+			scope = meta.RESTScopeRoot
+		}
+		restMapper.Add(gvk, scope)
+	}
+	clearClusterNamespace := func(clnt client.WithWatch, obj client.Object) {
+		if namespaced, err := clnt.IsObjectNamespaced(obj); err == nil && !namespaced {
+			obj.SetNamespace("")
+		}
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(restMapper).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, clnt client.WithWatch, obj client.Object, _ client.Patch,
+				_ ...client.PatchOption,
+			) error {
+				clearClusterNamespace(clnt, obj)
+				obj.SetResourceVersion("")
+				if err := clnt.Create(ctx, obj); !apierrors.IsAlreadyExists(err) {
+					return err
+				}
+				existing, _ := obj.DeepCopyObject().(client.Object)
+				if err := clnt.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+					return err
+				}
+				obj.SetResourceVersion(existing.GetResourceVersion())
+				return clnt.Update(ctx, obj)
+			},
+			Delete: func(ctx context.Context, clnt client.WithWatch, obj client.Object,
+				opts ...client.DeleteOption,
+			) error {
+				clearClusterNamespace(clnt, obj)
+				return clnt.Delete(ctx, obj, opts...)
+			},
+		}).Build()
+}
+
+// unreachableSkrClient fails to apply resources while the SKR cluster is unreachable.
+type unreachableSkrClient struct {
+	client.Client
+
+	unreachable bool
+}
+
+func (c *unreachableSkrClient) Patch(ctx context.Context, obj client.Object, patch client.Patch,
+	opts ...client.PatchOption,
+) error {
+	if c.unreachable {
+		return errSkrUnreachable
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+type tenantSkrContextProvider struct {
+	client client.Client
+	kymas  []*v1beta2.Kyma
+}
+
+func (p *tenantSkrContextProvider) Get(kyma types.NamespacedName) (*remote.SkrContext, error) {
+	for _, tenantKyma := range p.kymas {
+		if tenantKyma.GetNamespacedName() == kyma {
+			return remote.NewTenantSkrContext(remote.NewClientWithConfig(p.client, nil), nil,
+				tenantKyma.GetTenantNamespace()), nil
+		}
+	}
+	return nil, remote.ErrSkrClientContextNotFound
+}
+
+func (p *tenantSkrContextProvider) Init(_ context.Context, _ types.NamespacedName) error {
+	return nil
+}
+
+func (p *tenantSkrContextProvider) InvalidateCache(_ types.NamespacedName) {}
+
+type stubCertificateProvider struct{}
+
+func (p *stubCertificateProvider) IssueCertificate(_ context.Context, _ *v1beta2.Kyma,
+) (*watcher.CertificateInfo, error) {
+	return &watcher.CertificateInfo{}, nil
+}
+
+func (p *stubCertificateProvider) RemoveCertificate(_ context.Context, _ string) error {
+	return nil
+}
+
+func (p *stubCertificateProvider) RemoveSecretAfterCARotated(_ context.Context, _ *apicorev1.Secret,
+	_ client.ObjectKey,
+) (bool, error) {
+	return false, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	apiappsv1 "k8s.io/api/apps/v1"
@@ -27,14 +28,17 @@ type unstructuredResourcesConfig struct {
 	contractVersion          string
 	kcpAddress               string
 	secretResVer             string
+	syncedCertNotBefore      string
 	cpuResLimit, memResLimit string
 	skrWatcherImage          string
 	caCert, tlsCert, tlsKey  []byte
 	remoteNs                 string
+	tenantNs                 string
 }
 
 const (
 	podRestartLabelKey      = shared.OperatorGroup + shared.Separator + "pod-restart-trigger"
+	namespaceNameLabelKey   = "kubernetes.io/metadata.name"
 	kcpAddressEnvName       = "KCP_ADDR"
 	SkrTLSName              = "skr-webhook-tls"
 	SkrResourceName         = "skr-webhook"
@@ -82,8 +86,12 @@ func ResolveWebhookRuleResources(resource string, fieldName v1beta2.FieldName) [
 }
 
 func generateValidatingWebhookConfigFromWatchers(webhookObjKey,
-	svcObjKey client.ObjectKey, caCert []byte, watchers []v1beta2.Watcher,
+	svcObjKey client.ObjectKey, caCert []byte, watchers []v1beta2.Watcher, tenantNs string,
 ) *admissionregistrationv1.ValidatingWebhookConfiguration {
+	var namespaceSelector *apimetav1.LabelSelector
+	if tenantNs != "" {
+		namespaceSelector = &apimetav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabelKey: tenantNs}}
+	}
 	webhooks := make([]admissionregistrationv1.ValidatingWebhook, 0)
 	for _, watcher := range watchers {
 		moduleName := watcher.GetModuleName()
@@ -97,6 +105,7 @@ func generateValidatingWebhookConfigFromWatchers(webhookObjKey,
 		webhook := admissionregistrationv1.ValidatingWebhook{
 			Name:                    webhookName,
 			ObjectSelector:          &apimetav1.LabelSelector{MatchLabels: watcher.Spec.LabelsToWatch},
+			NamespaceSelector:       namespaceSelector,
 			AdmissionReviewVersions: []string{version},
 			ClientConfig: admissionregistrationv1.WebhookClientConfig{
 				CABundle: caCert,
@@ -202,12 +211,12 @@ func configureDeployment(cfg *unstructuredResourcesConfig, obj *unstructured.Uns
 }
 
 func getGeneratedClientObjects(resourcesConfig *unstructuredResourcesConfig,
-	watchers []v1beta2.Watcher, remoteNs string,
+	watchers []v1beta2.Watcher, remoteNs, tenantNs string,
 ) []client.Object {
 	var genClientObjects []client.Object
 	webhookCfgObjKey := client.ObjectKey{
 		Namespace: remoteNs,
-		Name:      SkrResourceName,
+		Name:      tenantResourceName(SkrResourceName, tenantNs),
 	}
 	svcObjKey := client.ObjectKey{
 		Namespace: remoteNs,
@@ -215,7 +224,7 @@ func getGeneratedClientObjects(resourcesConfig *unstructuredResourcesConfig,
 	}
 
 	webhookConfig := generateValidatingWebhookConfigFromWatchers(webhookCfgObjKey, svcObjKey,
-		resourcesConfig.caCert, watchers)
+		resourcesConfig.caCert, watchers, tenantNs)
 	genClientObjects = append(genClientObjects, webhookConfig)
 	secretObjKey := client.ObjectKey{
 		Namespace: remoteNs,
@@ -245,6 +254,48 @@ func configureUnstructuredObject(cfg *unstructuredResourcesConfig, object *unstr
 	return object.DeepCopy(), nil
 }
 
+// tenantResourceName returns the name of a cluster-scoped webhook resource of a tenant in a shared SKR cluster,
+// so that the tenants do not overwrite each other's resources.
+func tenantResourceName(name, tenantNs string) string {
+	if tenantNs == "" {
+		return name
+	}
+	return name + "-" + tenantNs
+}
+
+// isTenantClusterResource returns true for the cluster-scoped webhook resources which differ per tenant.
+func isTenantClusterResource(obj client.Object) bool {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	return kind == "ClusterRoleBinding" || kind == "ValidatingWebhookConfiguration"
+}
+
+// isSharedClusterResource returns true for the cluster-scoped webhook resources which are the same for all tenants
+// and must be kept until the last webhook in the SKR cluster is removed.
+func isSharedClusterResource(obj client.Object) bool {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	return kind == "ClusterRole" || kind == "PriorityClass"
+}
+
+// isSkrWebhookInUse returns true if the SKR cluster has a webhook configuration other than the given one,
+// which is the case while further tenants of a shared SKR cluster are installed.
+func isSkrWebhookInUse(ctx context.Context, clnt client.Client, webhookConfigName string) (bool, error) {
+	webhookConfigs := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := clnt.List(ctx, webhookConfigs, client.MatchingLabels{
+		shared.ManagedBy: shared.ManagedByLabelValue,
+	}); err != nil {
+		return false, fmt.Errorf("failed to list webhook configurations: %w", err)
+	}
+	for _, webhookConfig := range webhookConfigs.Items {
+		if webhookConfig.Name == webhookConfigName {
+			continue
+		}
+		if webhookConfig.Name == SkrResourceName || strings.HasPrefix(webhookConfig.Name, SkrResourceName+"-") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func closeFileAndLogErr(ctx context.Context, closer io.Closer, path string) {
 	logger := logf.FromContext(ctx)
 	err := closer.Close()
@@ -115,9 +115,14 @@ func IstioGatewaySecretIsSyncedToRootCA(ctx context.Context,
 		return fmt.Errorf("failed to fetch istio gateway secret: %w", err)
 	}
 
-	err = verifySecretsHaveSameData(rootCASecret, gatewaySecret)
-	if err != nil {
-		return err
+	// during the CA rotation grace period, the gateway secret trusts the previous CA after the root CA
+	for key, data := range rootCASecret.Data {
+		if key == "ca.crt" && bytes.HasPrefix(gatewaySecret.Data[key], data) {
+			continue
+		}
+		if !bytes.Equal(data, gatewaySecret.Data[key]) {
+			return errNotSyncedSecret
+		}
 	}
 
 	return nil
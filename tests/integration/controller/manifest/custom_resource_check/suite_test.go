@@ -153,7 +153,8 @@ var _ = BeforeSuite(func() {
 		Busy:    1 * time.Second,
 		Error:   1 * time.Second,
 		Warning: 1 * time.Second,
-	}, metrics.NewManifestMetrics(metrics.NewSharedMetrics()), metrics.NewMandatoryModulesMetrics(),
+	}, metrics.NewManifestMetrics(metrics.NewSharedMetrics(metrics.CardinalityPerKyma)),
+		metrics.NewMandatoryModulesMetrics(),
 		manifestClient, manifest.NewSpecResolver(keyChainLookup, extractor), declarativev2.WithRemoteTargetCluster(
 			func(_ context.Context, _ declarativev2.Object) (*declarativev2.ClusterInfo, error) {
 				return &declarativev2.ClusterInfo{Config: authUser.Config()}, nil
@@ -10,6 +10,7 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -82,6 +83,13 @@ var _ = Describe("When kyma is not deleted within configured timeout", Ordered,
 				WithArguments(client.ObjectKeyFromObject(issuer2), kcpClient).
 				Should(BeEmpty())
 		})
+
+		By("Force-finalized resources should be recorded in the purge report", func() {
+			Eventually(getForceFinalizedInPurgeReports, Timeout, Interval).
+				WithContext(ctx).
+				WithArguments(kyma.GetName(), kcpClient).
+				Should(ContainElements(issuer1.GetName(), issuer2.GetName()))
+		})
 	})
 })
 
@@ -298,12 +306,29 @@ func createIssuerFor(kyma *v1beta2.Kyma, nameSuffix string) *unstructured.Unstru
 	return res
 }
 
+// getIssuerFinalizers returns no finalizers for purged Issuers, as they are deleted once their finalizers are dropped.
 func getIssuerFinalizers(ctx context.Context, key client.ObjectKey, cl client.Client) []string {
 	res := createIssuerObj()
-	Expect(cl.Get(ctx, key, res)).Should(Succeed())
+	err := cl.Get(ctx, key, res)
+	if util.IsNotFound(err) {
+		return nil
+	}
+	Expect(err).ShouldNot(HaveOccurred())
 	return res.GetFinalizers()
 }
 
+func getForceFinalizedInPurgeReports(ctx context.Context, kymaName string, cl client.Client) []string {
+	reports := &v1beta2.PurgeReportList{}
+	Expect(cl.List(ctx, reports, client.MatchingLabels{shared.KymaName: kymaName})).Should(Succeed())
+	var names []string
+	for _, report := range reports.Items {
+		for _, object := range report.Spec.ForceFinalized {
+			names = append(names, object.Name)
+		}
+	}
+	return names
+}
+
 func getDestinationRuleFinalizers(ctx context.Context, key client.ObjectKey, cl client.Client) []string {
 	res := createDestinationRuleObj()
 	Expect(cl.Get(ctx, key, res)).Should(Succeed())
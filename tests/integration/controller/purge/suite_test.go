@@ -113,8 +113,12 @@ var _ = BeforeSuite(func() {
 		SkrContextFactory:     testSkrContextFactory,
 		Event:                 testEventRec,
 		PurgeFinalizerTimeout: time.Second,
-		SkipCRDs:              matcher.CreateCRDMatcherFrom(skipFinalizerRemovalForCRDs),
-		Metrics:               metrics.NewPurgeMetrics(),
+		Policy: purge.Policy{
+			Include:       matcher.CreateCRDMatcherFrom("issuers.cert-manager.io,*.networking.istio.io"),
+			Skip:          matcher.CreateCRDMatcherFrom(skipFinalizerRemovalForCRDs),
+			ForceFinalize: matcher.CreateCRDMatcherFrom("*"),
+		},
+		Metrics: metrics.NewPurgeMetrics(),
 	}
 
 	err = reconciler.SetupWithManager(mgr, ctrlruntime.Options{})
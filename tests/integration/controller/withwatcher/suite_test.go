@@ -201,7 +201,8 @@ var _ = BeforeSuite(func() {
 	skrWebhookChartManager, err := watcher.NewSKRWebhookManifestManager(
 		kcpClient,
 		testSkrContextFactory,
-		skrChartCfg, certificateConfig, resolvedKcpAddr)
+		skrChartCfg, certificateConfig,
+		watcher.NewCertManagerCertificateProvider(kcpClient, certificateConfig), resolvedKcpAddr)
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&kyma.Reconciler{
@@ -214,7 +215,7 @@ var _ = BeforeSuite(func() {
 		SyncRemoteCrds:      remote.NewSyncCrdsUseCase(kcpClient, testSkrContextFactory, nil),
 		RemoteSyncNamespace: flags.DefaultRemoteSyncNamespace,
 		InKCPMode:           true,
-		Metrics:             metrics.NewKymaMetrics(metrics.NewSharedMetrics()),
+		Metrics:             metrics.NewKymaMetrics(metrics.NewSharedMetrics(metrics.CardinalityPerKyma)),
 		RemoteCatalog:       remote.NewRemoteCatalogFromKyma(kcpClient, testSkrContextFactory, flags.DefaultRemoteSyncNamespace),
 	}).SetupWithManager(mgr, ctrlruntime.Options{}, kyma.SetupOptions{ListenerAddr: listenerAddr})
 	Expect(err).ToNot(HaveOccurred())